@@ -14,7 +14,15 @@ type AggregateOutput struct {
 	Languages    map[string]int `json:"languages,omitempty"`    // Language file counts
 	Licenses     []string       `json:"licenses,omitempty"`     // Detected licenses
 	Dependencies [][]string     `json:"dependencies,omitempty"` // All dependencies [type, name, version]
+	Endpoints    []Endpoint     `json:"endpoints,omitempty"`    // Exposed ports of all components, public first
 	CodeStats    interface{}    `json:"code_stats,omitempty"`   // Code statistics (if enabled)
+	EOLSummary   interface{}    `json:"eol_summary,omitempty"`  // End-of-life summary (if enabled)
+}
+
+// Endpoint is an exposed port with the component it belongs to
+type Endpoint struct {
+	Component string `json:"component"`
+	types.Endpoint
 }
 
 // Aggregator handles aggregation of scan results
@@ -57,12 +65,19 @@ func (a *Aggregator) Aggregate(payload *types.Payload) *AggregateOutput {
 		output.Dependencies = a.collectDependencies(payload)
 	}
 
+	if a.fields["endpoints"] {
+		output.Endpoints = a.collectEndpoints(payload)
+	}
+
 	// Include metadata from the root payload
 	output.Metadata = payload.Metadata
 
 	// Include code stats if present
 	output.CodeStats = payload.CodeStats
 
+	// Include the end-of-life summary if present
+	output.EOLSummary = payload.EOLSummary
+
 	return output
 }
 
@@ -212,6 +227,59 @@ func (a *Aggregator) collectDependenciesRecursive(payload *types.Payload, depMap
 	}
 }
 
+// collectEndpoints recursively collects all unique endpoints with their component
+func (a *Aggregator) collectEndpoints(payload *types.Payload) []Endpoint {
+	endpointSet := make(map[Endpoint]bool)
+	a.collectEndpointsRecursive(payload, endpointSet)
+
+	endpoints := make([]Endpoint, 0, len(endpointSet))
+	for endpoint := range endpointSet {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	// Sort public endpoints first, then by port, protocol, component and file
+	sort.Slice(endpoints, func(i, j int) bool {
+		x, y := endpoints[i], endpoints[j]
+		if x.Exposure != y.Exposure {
+			return x.Exposure == types.EndpointPublic
+		}
+		if x.Port != y.Port {
+			return x.Port < y.Port
+		}
+		if x.Protocol != y.Protocol {
+			return x.Protocol < y.Protocol
+		}
+		if x.Component != y.Component {
+			return x.Component < y.Component
+		}
+		if x.File != y.File {
+			return x.File < y.File
+		}
+		if x.Service != y.Service {
+			return x.Service < y.Service
+		}
+		return x.PublishedPort < y.PublishedPort
+	})
+
+	return endpoints
+}
+
+// collectEndpointsRecursive helper function
+func (a *Aggregator) collectEndpointsRecursive(payload *types.Payload, endpointSet map[Endpoint]bool) {
+	// Add endpoints from current payload
+	entries, _ := payload.Properties["endpoints"].([]interface{})
+	for _, entry := range entries {
+		if endpoint, ok := entry.(types.Endpoint); ok {
+			endpointSet[Endpoint{Component: payload.Name, Endpoint: endpoint}] = true
+		}
+	}
+
+	// Recursively process children
+	for _, child := range payload.Childs {
+		a.collectEndpointsRecursive(child, endpointSet)
+	}
+}
+
 // sortStrings sorts a slice of strings in place and returns it
 func sortStrings(s []string) []string {
 	sort.Strings(s)
@@ -6,12 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/aggregator"
 	"github.com/petrarca/tech-stack-analyzer/internal/codestats"
 	"github.com/petrarca/tech-stack-analyzer/internal/config"
+	"github.com/petrarca/tech-stack-analyzer/internal/eol"
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/petrarca/tech-stack-analyzer/internal/vuln"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -31,8 +34,11 @@ Examples:
   stack-analyzer scan /path/to/pom.xml
   stack-analyzer scan --aggregate techs,languages /path/to/project
   stack-analyzer scan --aggregate all /path/to/project
+  stack-analyzer scan --aggregate endpoints /path/to/project
   stack-analyzer scan --exclude vendor,node_modules /path/to/project
-  stack-analyzer scan --exclude "**/__tests__/**" --exclude "*.log" /path/to/project`,
+  stack-analyzer scan --exclude "**/__tests__/**" --exclude "*.log" /path/to/project
+  stack-analyzer scan --vuln-db ./osv /path/to/project
+  stack-analyzer scan --scan-imports /path/to/project`,
 	Args: cobra.MaximumNArgs(1),
 	Run:  runScan,
 }
@@ -57,7 +63,7 @@ func init() {
 
 	// Set up flags with defaults from environment variables
 	scanCmd.Flags().StringVarP(&settings.OutputFile, "output", "o", outputFile, "Output file path (default: stack-analysis.json)")
-	scanCmd.Flags().StringVar(&settings.Aggregate, "aggregate", aggregate, "Aggregate fields: tech,techs,languages,licenses,dependencies,all")
+	scanCmd.Flags().StringVar(&settings.Aggregate, "aggregate", aggregate, "Aggregate fields: tech,techs,languages,licenses,dependencies,endpoints,all")
 	scanCmd.Flags().BoolVar(&settings.PrettyPrint, "pretty", prettyPrint, "Pretty print JSON output")
 	scanCmd.Flags().BoolVarP(&settings.Verbose, "verbose", "v", verbose, "Show progress with simple output")
 	scanCmd.Flags().BoolVarP(&settings.Debug, "debug", "d", debug, "Show progress with tree structure (cannot be used with --verbose)")
@@ -72,6 +78,13 @@ func init() {
 
 	// Code statistics flag (enabled by default)
 	scanCmd.Flags().BoolVar(&settings.NoCodeStats, "no-code-stats", settings.NoCodeStats, "Disable code statistics (lines of code, comments, blanks, complexity)")
+	scanCmd.Flags().BoolVar(&settings.NoEOL, "no-eol", settings.NoEOL, "Disable end-of-life evaluation of runtimes, base images and frameworks")
+
+	// Offline vulnerability matching (disabled unless a database is given)
+	scanCmd.Flags().StringVar(&settings.VulnDB, "vuln-db", settings.VulnDB, "OSV database export (all.zip or directory of exports) to match dependencies against")
+
+	// Source import scanning (disabled by default)
+	scanCmd.Flags().BoolVar(&settings.ScanImports, "scan-imports", settings.ScanImports, "Match import/require statements of Python, JS/TS, Go, Java/Kotlin, C# and Ruby sources against dependency rules")
 
 	// Logging flags - use defaults from environment variables
 	scanCmd.Flags().String("log-level", logLevel, "Log level: trace, debug, error, fatal")
@@ -169,12 +182,14 @@ func runScan(cmd *cobra.Command, args []string) {
 		"path":         scannerPath,
 		"exclude_dirs": settings.ExcludeDirs,
 		"code_stats":   !settings.NoCodeStats,
+		"eol":          !settings.NoEOL,
+		"scan_imports": settings.ScanImports,
 	}).Debug("Initializing scanner")
 
 	// Create code stats analyzer (enabled by default, disabled with --no-code-stats)
 	codeStatsAnalyzer := codestats.NewAnalyzer(!settings.NoCodeStats)
 
-	s, err := scanner.NewScannerWithOptions(scannerPath, settings.ExcludeDirs, settings.Verbose, settings.Debug, settings.TraceTimings, settings.TraceRules, codeStatsAnalyzer)
+	s, err := scanner.NewScannerWithOptions(scannerPath, settings.ExcludeDirs, settings.Verbose, settings.Debug, settings.TraceTimings, settings.TraceRules, codeStatsAnalyzer, settings.ScanImports)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to create scanner")
 	}
@@ -200,6 +215,30 @@ func runScan(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// Evaluate runtimes, base images and frameworks against the embedded end-of-life dataset
+	if !settings.NoEOL {
+		if p, ok := payload.(*types.Payload); ok {
+			dataset, err := eol.LoadEmbedded()
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to load end-of-life dataset")
+			}
+			p.EOLSummary = eol.NewEvaluator(dataset, time.Now()).Annotate(p)
+		}
+	}
+
+	// Match dependencies against the local OSV database export
+	if settings.VulnDB != "" {
+		if p, ok := payload.(*types.Payload); ok {
+			logger.WithField("vuln_db", settings.VulnDB).Debug("Loading vulnerability database")
+			db, err := vuln.LoadDatabase(settings.VulnDB)
+			if err != nil {
+				logger.WithError(err).Fatal("Failed to load vulnerability database")
+			}
+			count := vuln.NewMatcher(db).Annotate(p)
+			fmt.Fprintf(os.Stderr, "Vulnerabilities: %d found (%d advisories)\n", count, db.Advisories())
+		}
+	}
+
 	// Generate output (aggregated or full payload)
 	logger.WithFields(logrus.Fields{
 		"aggregate":    settings.Aggregate,
@@ -237,14 +276,14 @@ func generateOutput(payload interface{}, aggregateFields string, prettyPrint boo
 
 		// Handle "all" as special case - aggregate all available fields
 		if len(fields) == 1 && fields[0] == "all" {
-			fields = []string{"tech", "techs", "languages", "licenses", "dependencies"}
+			fields = []string{"tech", "techs", "languages", "licenses", "dependencies", "endpoints"}
 		}
 
 		// Validate fields
-		validFields := map[string]bool{"tech": true, "techs": true, "languages": true, "licenses": true, "dependencies": true}
+		validFields := map[string]bool{"tech": true, "techs": true, "languages": true, "licenses": true, "dependencies": true, "endpoints": true}
 		for _, field := range fields {
 			if !validFields[field] {
-				return nil, fmt.Errorf("invalid aggregate field: %s. Valid fields: tech, techs, languages, licenses, dependencies, all", field)
+				return nil, fmt.Errorf("invalid aggregate field: %s. Valid fields: tech, techs, languages, licenses, dependencies, endpoints, all", field)
 			}
 		}
 
@@ -16,11 +16,17 @@ var categoriesConfigData []byte
 //go:embed ignore.yaml
 var ignoreConfigData []byte
 
+//go:embed terraform_categories.yaml
+var terraformCategoriesConfigData []byte
+
 // ScanConfig represents the .stack-analyzer.yml configuration file
 type ScanConfig struct {
 	Properties map[string]interface{} `yaml:"properties,omitempty"`
 	Exclude    []string               `yaml:"exclude,omitempty"`
 	Techs      []ConfigTech           `yaml:"techs,omitempty"`
+
+	// TerraformCategories extends or overrides the embedded Terraform resource taxonomy
+	TerraformCategories map[string]TerraformCategory `yaml:"terraform_categories,omitempty"`
 }
 
 // ConfigTech represents a technology to add to the scan
@@ -117,3 +123,26 @@ func (c *IgnoreConfig) GetFlatIgnoreList() []string {
 	}
 	return flat
 }
+
+// TerraformCategory defines how Terraform resource types are assigned to a category
+type TerraformCategory struct {
+	Description string   `yaml:"description,omitempty"`
+	Resources   []string `yaml:"resources,omitempty"` // Exact resource type names
+	Patterns    []string `yaml:"patterns,omitempty"`  // Regular expressions
+	Prefixes    []string `yaml:"prefixes,omitempty"`  // Resource type prefixes (longest match wins)
+}
+
+// TerraformCategoriesConfig represents the terraform_categories.yaml configuration
+type TerraformCategoriesConfig struct {
+	Categories map[string]TerraformCategory `yaml:"categories"`
+}
+
+// LoadTerraformCategoriesConfig loads the Terraform resource taxonomy from terraform_categories.yaml
+func LoadTerraformCategoriesConfig() (*TerraformCategoriesConfig, error) {
+	var config TerraformCategoriesConfig
+	if err := yaml.Unmarshal(terraformCategoriesConfigData, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse terraform_categories.yaml: %w", err)
+	}
+
+	return &config, nil
+}
@@ -23,6 +23,9 @@ type Settings struct {
 	TraceRules   bool
 	FilterRules  []string // Only use these rules (for debugging)
 	NoCodeStats  bool     // Disable code statistics (enabled by default)
+	NoEOL        bool     // Disable end-of-life evaluation (enabled by default)
+	VulnDB       string   // OSV database export (all.zip or directory) to match dependencies against
+	ScanImports  bool     // Match source imports against dependency rules (disabled by default)
 
 	// Logging
 	LogLevel  logrus.Level
@@ -43,6 +46,9 @@ func DefaultSettings() *Settings {
 		TraceRules:   false,
 		FilterRules:  []string{},
 		NoCodeStats:  false,             // Code stats enabled by default
+		NoEOL:        false,             // EOL evaluation enabled by default
+		VulnDB:       "",                // No vulnerability matching by default
+		ScanImports:  false,             // Import scanning is opt-in
 		LogLevel:     logrus.ErrorLevel, // Changed from InfoLevel - only errors by default
 		LogFormat:    "text",
 		LogFile:      "", // Empty = stderr
@@ -104,6 +110,14 @@ func LoadSettings() *Settings {
 		settings.TraceRules = strings.ToLower(traceRules) == "true"
 	}
 
+	if vulnDB := os.Getenv("STACK_ANALYZER_VULN_DB"); vulnDB != "" {
+		settings.VulnDB = vulnDB
+	}
+
+	if scanImports := os.Getenv("STACK_ANALYZER_SCAN_IMPORTS"); scanImports != "" {
+		settings.ScanImports = strings.ToLower(scanImports) == "true"
+	}
+
 	if filterRules := os.Getenv("STACK_ANALYZER_FILTER_RULES"); filterRules != "" {
 		settings.FilterRules = strings.Split(filterRules, ",")
 		for i, rule := range settings.FilterRules {
@@ -46,6 +46,8 @@ func TestLoadSettings_WithEnvironmentVariables(t *testing.T) {
 	os.Setenv("STACK_ANALYZER_AGGREGATE", "tech,techs")
 	os.Setenv("STACK_ANALYZER_LOG_LEVEL", "debug")
 	os.Setenv("STACK_ANALYZER_LOG_FORMAT", "json")
+	os.Setenv("STACK_ANALYZER_VULN_DB", "/data/osv")
+	os.Setenv("STACK_ANALYZER_SCAN_IMPORTS", "true")
 
 	defer clearEnvVars()
 
@@ -57,6 +59,8 @@ func TestLoadSettings_WithEnvironmentVariables(t *testing.T) {
 	assert.Equal(t, "tech,techs", settings.Aggregate)
 	assert.Equal(t, logrus.DebugLevel, settings.LogLevel)
 	assert.Equal(t, "json", settings.LogFormat)
+	assert.Equal(t, "/data/osv", settings.VulnDB)
+	assert.True(t, settings.ScanImports)
 }
 
 func TestLoadSettings_WithPartialEnvironmentVariables(t *testing.T) {
@@ -172,6 +176,8 @@ func clearEnvVars() {
 		"STACK_ANALYZER_AGGREGATE",
 		"STACK_ANALYZER_LOG_LEVEL",
 		"STACK_ANALYZER_LOG_FORMAT",
+		"STACK_ANALYZER_VULN_DB",
+		"STACK_ANALYZER_SCAN_IMPORTS",
 	}
 
 	for _, envVar := range envVars {
@@ -0,0 +1,159 @@
+// Package eol evaluates runtimes, base images and frameworks against an embedded end-of-life dataset
+package eol
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:generate go run gen.go
+
+// The dataset is a snapshot of https://endoflife.date, refreshed with "go generate ./internal/eol" (task eol:update)
+//
+//go:embed data/eol.json
+var embeddedDataset []byte
+
+// Dataset holds the release cycles of each tracked product
+type Dataset struct {
+	Source    string             `json:"source"`
+	Generated string             `json:"generated"` // Date the snapshot was taken (YYYY-MM-DD)
+	Products  map[string][]Cycle `json:"products"`
+}
+
+// Cycle is a release cycle (major or minor version line) of a product
+type Cycle struct {
+	Cycle       string  `json:"cycle"`
+	ReleaseDate string  `json:"releaseDate,omitempty"`
+	EOL         EOLDate `json:"eol"`
+	Codename    string  `json:"codename,omitempty"`
+}
+
+// EOLDate is the end-of-life of a cycle, endoflife.date publishes either a date or a boolean
+type EOLDate struct {
+	Date    string // YYYY-MM-DD, empty when only the boolean is known
+	Reached bool   // Set when the dataset flags the cycle as end-of-life without a date
+}
+
+// UnmarshalJSON accepts "2026-04-30", true and false
+func (d *EOLDate) UnmarshalJSON(data []byte) error {
+	var reached bool
+	if err := json.Unmarshal(data, &reached); err == nil {
+		*d = EOLDate{Reached: reached}
+		return nil
+	}
+
+	var date string
+	if err := json.Unmarshal(data, &date); err != nil {
+		return fmt.Errorf("eol must be a date or a boolean: %w", err)
+	}
+	*d = EOLDate{Date: date}
+	return nil
+}
+
+// MarshalJSON writes the date or, when unknown, the boolean
+func (d EOLDate) MarshalJSON() ([]byte, error) {
+	if d.Date != "" {
+		return json.Marshal(d.Date)
+	}
+	return json.Marshal(d.Reached)
+}
+
+var (
+	// First numeric version in a version, constraint or tag: ">=3.11" -> 3.11, "20-alpine" -> 20, "temurin-21" -> 21
+	versionTokenRegex = regexp.MustCompile(`\d+(?:\.\d+)*`)
+	// Leading word of a codename tag or alias: "bookworm-slim" -> bookworm, "lts/iron" -> iron
+	codenameRegex = regexp.MustCompile(`^[a-z]+`)
+)
+
+// LoadEmbedded parses the dataset compiled into the binary
+func LoadEmbedded() (*Dataset, error) {
+	return Parse(embeddedDataset)
+}
+
+// Parse parses a dataset in the format of data/eol.json
+func Parse(content []byte) (*Dataset, error) {
+	var dataset Dataset
+	if err := json.Unmarshal(content, &dataset); err != nil {
+		return nil, fmt.Errorf("failed to parse eol dataset: %w", err)
+	}
+	return &dataset, nil
+}
+
+// Lookup returns the cycle of a product matching a version, constraint, tag or codename
+// The lower bound of constraints is used, and the most specific cycle wins ("3.12.2" matches 3.12, not 3)
+func (d *Dataset) Lookup(product, version string) (Cycle, bool) {
+	cycles := d.Products[product]
+	if len(cycles) == 0 {
+		return Cycle{}, false
+	}
+
+	// Codenames come first, tags such as jammy-20240111 carry a build date. Aliases such as lts/iron
+	// select the last path segment
+	alias := strings.ToLower(version[strings.LastIndex(version, "/")+1:])
+	if codename := codenameRegex.FindString(alias); codename != "" {
+		for _, cycle := range cycles {
+			if fields := strings.Fields(strings.ToLower(cycle.Codename)); len(fields) > 0 && fields[0] == codename {
+				return cycle, true
+			}
+		}
+	}
+
+	token := versionTokenRegex.FindString(version)
+	if token == "" {
+		return Cycle{}, false
+	}
+	var best Cycle
+	for _, cycle := range cycles {
+		if (token == cycle.Cycle || strings.HasPrefix(token, cycle.Cycle+".")) && len(cycle.Cycle) > len(best.Cycle) {
+			best = cycle
+		}
+	}
+	return best, best.Cycle != ""
+}
+
+// ProductNames returns the tracked products, sorted
+func (d *Dataset) ProductNames() []string {
+	names := make([]string, 0, len(d.Products))
+	for name := range d.Products {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Status values of a finding
+const (
+	StatusEOL         = "eol"         // End-of-life reached
+	StatusApproaching = "approaching" // End-of-life within ApproachingWindow
+	StatusSupported   = "supported"
+)
+
+// ApproachingWindow is how long before its end-of-life a cycle is reported as approaching
+const ApproachingWindow = 180 * 24 * time.Hour
+
+// Status returns the status of a cycle at the given time
+func (c Cycle) Status(now time.Time) string {
+	if c.EOL.Date == "" {
+		if c.EOL.Reached {
+			return StatusEOL
+		}
+		return StatusSupported
+	}
+
+	date, err := time.Parse("2006-01-02", c.EOL.Date)
+	if err != nil {
+		return StatusSupported
+	}
+	if !now.Before(date) {
+		return StatusEOL
+	}
+	if date.Sub(now) <= ApproachingWindow {
+		return StatusApproaching
+	}
+	return StatusSupported
+}
@@ -0,0 +1,166 @@
+package eol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)
+
+func TestLoadEmbedded(t *testing.T) {
+	dataset, err := LoadEmbedded()
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, dataset.Generated)
+	for _, product := range []string{"nodejs", "python", "go", "eclipse-temurin", "dotnet", "ruby", "alpine", "ubuntu", "debian", "postgresql", "django", "spring-boot"} {
+		assert.NotEmpty(t, dataset.Products[product], product)
+	}
+}
+
+func TestParse_EOLDateOrBoolean(t *testing.T) {
+	dataset, err := Parse([]byte(`{"generated": "2025-10-15", "products": {"go": [
+		{"cycle": "1.25", "eol": false},
+		{"cycle": "1.23", "eol": "2025-08-12"},
+		{"cycle": "1.0", "eol": true}
+	]}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Cycle{
+		{Cycle: "1.25", EOL: EOLDate{}},
+		{Cycle: "1.23", EOL: EOLDate{Date: "2025-08-12"}},
+		{Cycle: "1.0", EOL: EOLDate{Reached: true}},
+	}, dataset.Products["go"])
+
+	_, err = Parse([]byte(`{"products": {"go": [{"cycle": "1.25", "eol": 1}]}}`))
+	assert.Error(t, err)
+}
+
+func TestDataset_Lookup(t *testing.T) {
+	dataset, err := LoadEmbedded()
+	require.NoError(t, err)
+
+	tests := []struct {
+		product  string
+		version  string
+		expected string
+	}{
+		{"python", "3.12.2", "3.12"},
+		{"python", ">=3.11", "3.11"},
+		{"python", "3.1", ""},
+		{"nodejs", "v20.11.0", "20"},
+		{"nodejs", "^18 || ^20", "18"},
+		{"nodejs", "lts/iron", "20"},
+		{"nodejs", "lts/*", ""},
+		{"alpine", "3.20.3", "3.20"},
+		{"alpine", "3.2", ""},
+		{"debian", "bookworm-slim", "12"},
+		{"ubuntu", "jammy-20240111", "22.04"},
+		{"ubuntu", "22.04", "22.04"},
+		{"eclipse-temurin", "temurin-21", "21"},
+		{"unknown", "1.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.product+" "+tt.version, func(t *testing.T) {
+			cycle, found := dataset.Lookup(tt.product, tt.version)
+			assert.Equal(t, tt.expected != "", found)
+			assert.Equal(t, tt.expected, cycle.Cycle)
+		})
+	}
+}
+
+func TestCycle_Status(t *testing.T) {
+	assert.Equal(t, StatusEOL, Cycle{EOL: EOLDate{Date: "2025-04-30"}}.Status(testNow))
+	assert.Equal(t, StatusEOL, Cycle{EOL: EOLDate{Date: "2025-10-15"}}.Status(testNow))
+	assert.Equal(t, StatusApproaching, Cycle{EOL: EOLDate{Date: "2026-03-31"}}.Status(testNow))
+	assert.Equal(t, StatusSupported, Cycle{EOL: EOLDate{Date: "2027-04-30"}}.Status(testNow))
+	assert.Equal(t, StatusEOL, Cycle{EOL: EOLDate{Reached: true}}.Status(testNow))
+	assert.Equal(t, StatusSupported, Cycle{EOL: EOLDate{}}.Status(testNow))
+}
+
+func TestEvaluator_Annotate(t *testing.T) {
+	dataset, err := LoadEmbedded()
+	require.NoError(t, err)
+
+	root := types.NewPayloadWithPath("main", "/")
+	root.Properties["docker"] = []interface{}{&parsers.DockerfileInfo{
+		File:       "/Dockerfile",
+		BaseImages: []string{"node:18-alpine", "builder", "nginx:1.25", "docker.io/library/debian:bookworm-slim", "mcr.microsoft.com/dotnet/aspnet:8.0", "python:latest"},
+	}}
+
+	web := types.NewPayloadWithPath("web", "/web/package.json")
+	web.Properties["runtime"] = map[string]interface{}{
+		"node": parsers.RuntimeVersion{Version: "22", Source: ".nvmrc"},
+		"pnpm": parsers.RuntimeVersion{Version: "9.1.0", Source: "package.json#packageManager"},
+	}
+	web.Dependencies = []types.Dependency{
+		{Type: "npm", Name: "@angular/core", Example: "^17.3.0"},
+		{Type: "npm", Name: "vue", Example: "latest"},
+	}
+	root.AddChild(web)
+
+	api := types.NewPayloadWithPath("api", "/api/pom.xml")
+	api.Properties["runtime"] = map[string]interface{}{
+		"java": parsers.RuntimeVersion{Version: "1.8", Source: "pom.xml#java.version"},
+	}
+	api.Dependencies = []types.Dependency{
+		{Type: "maven", Name: "org.springframework.boot:spring-boot-starter-web", Example: "3.5.0"},
+		{Type: "maven", Name: "org.springframework.boot:spring-boot-starter-data-jpa", Example: "3.5.0"},
+	}
+	root.AddChild(api)
+
+	summary := NewEvaluator(dataset, testNow).Annotate(root)
+
+	assert.Equal(t, []Finding{
+		{Kind: KindImage, Product: "nodejs", Cycle: "18", Version: "18-alpine", Source: "/Dockerfile (node:18-alpine)", EOL: "2025-04-30", Status: StatusEOL},
+		{Kind: KindImage, Product: "debian", Cycle: "12", Version: "bookworm-slim", Source: "/Dockerfile (docker.io/library/debian:bookworm-slim)", EOL: "2026-06-10", Status: StatusSupported},
+		{Kind: KindImage, Product: "dotnet", Cycle: "8.0", Version: "8.0", Source: "/Dockerfile (mcr.microsoft.com/dotnet/aspnet:8.0)", EOL: "2026-11-10", Status: StatusSupported},
+	}, root.Properties["eol"])
+
+	assert.Equal(t, []Finding{
+		{Kind: KindRuntime, Product: "nodejs", Cycle: "22", Version: "22", Source: ".nvmrc", EOL: "2027-04-30", Status: StatusSupported},
+		{Kind: KindFramework, Product: "angular", Cycle: "17", Version: "^17.3.0", Source: "npm:@angular/core", EOL: "2025-05-15", Status: StatusEOL},
+	}, web.Properties["eol"])
+
+	assert.Equal(t, []Finding{
+		{Kind: KindRuntime, Product: "eclipse-temurin", Cycle: "8", Version: "8", Source: "pom.xml#java.version", EOL: "2030-12-31", Status: StatusSupported},
+		{Kind: KindFramework, Product: "spring-boot", Cycle: "3.5", Version: "3.5.0", Source: "maven:org.springframework.boot:spring-boot-starter-web", EOL: "2026-06-30", Status: StatusSupported},
+	}, api.Properties["eol"])
+
+	assert.Equal(t, "2025-10-15", summary.Dataset)
+	assert.Equal(t, "2025-10-15", summary.EvaluatedAt)
+	assert.Equal(t, 2, summary.EOL)
+	assert.Equal(t, 0, summary.Approaching)
+	assert.Equal(t, 5, summary.Supported)
+	require.Len(t, summary.Items, 2)
+	assert.Equal(t, "main", summary.Items[0].Component)
+	assert.Equal(t, "nodejs", summary.Items[0].Product)
+	assert.Equal(t, "web", summary.Items[1].Component)
+	assert.Equal(t, []string{"/web/package.json"}, summary.Items[1].Path)
+}
+
+func TestEvaluator_DotnetTargetFrameworks(t *testing.T) {
+	dataset, err := LoadEmbedded()
+	require.NoError(t, err)
+	evaluator := NewEvaluator(dataset, testNow)
+
+	for version, expected := range map[string]string{"net8.0": "8.0", "netcoreapp3.1": "3.1", "net6.0-windows": "6.0", "net48": "", "netstandard2.0": ""} {
+		payload := types.NewPayloadWithPath("app", "/app.csproj")
+		payload.Properties["runtime"] = map[string]interface{}{
+			"dotnet": parsers.RuntimeVersion{Version: version, Source: "app.csproj#TargetFramework"},
+		}
+
+		findings := evaluator.Evaluate(payload)
+		if expected == "" {
+			assert.Empty(t, findings, version)
+			continue
+		}
+		require.Len(t, findings, 1, version)
+		assert.Equal(t, expected, findings[0].Cycle, version)
+	}
+}
@@ -0,0 +1,251 @@
+package eol
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// runtimeProducts maps runtime names of the runtime property to dataset products
+var runtimeProducts = map[string]string{
+	"node":   "nodejs",
+	"python": "python",
+	"go":     "go",
+	"java":   "eclipse-temurin",
+	"dotnet": "dotnet",
+	"ruby":   "ruby",
+}
+
+// imageProducts maps base image names (without docker.io/ and library/) to dataset products
+var imageProducts = map[string]string{
+	"node":                             "nodejs",
+	"python":                           "python",
+	"golang":                           "go",
+	"eclipse-temurin":                  "eclipse-temurin",
+	"ruby":                             "ruby",
+	"alpine":                           "alpine",
+	"ubuntu":                           "ubuntu",
+	"debian":                           "debian",
+	"postgres":                         "postgresql",
+	"mysql":                            "mysql",
+	"mongo":                            "mongodb",
+	"mcr.microsoft.com/dotnet/aspnet":  "dotnet",
+	"mcr.microsoft.com/dotnet/runtime": "dotnet",
+	"mcr.microsoft.com/dotnet/sdk":     "dotnet",
+}
+
+// frameworkProducts maps framework dependencies (type and name) to dataset products
+var frameworkProducts = map[string]map[string]string{
+	"npm":    {"@angular/core": "angular", "vue": "vue"},
+	"python": {"django": "django"},
+	"ruby":   {"rails": "rails"},
+	"php":    {"laravel/framework": "laravel"},
+}
+
+// springBootGroup is the Maven group of the Spring Boot starters, any of them carries the Boot version
+const springBootGroup = "org.springframework.boot:"
+
+// Finding kinds
+const (
+	KindRuntime   = "runtime"
+	KindImage     = "image"
+	KindFramework = "framework"
+)
+
+// Finding is the end-of-life status of a runtime, base image or framework used by a component
+type Finding struct {
+	Kind    string `json:"kind"`
+	Product string `json:"product"`
+	Cycle   string `json:"cycle"`
+	Version string `json:"version"` // Version, constraint or tag as declared
+	Source  string `json:"source"`  // package.json#engines.node, Dockerfile (node:18-alpine), npm:@angular/core, ...
+	EOL     string `json:"eol,omitempty"`
+	Status  string `json:"status"`
+}
+
+// SummaryItem is a finding that reached or approaches its end-of-life, with the component using it
+type SummaryItem struct {
+	Finding
+	Component string   `json:"component"`
+	Path      []string `json:"path"`
+}
+
+// Summary is the scan level end-of-life report
+type Summary struct {
+	Dataset     string        `json:"dataset"` // Date of the dataset snapshot
+	EvaluatedAt string        `json:"evaluated_at"`
+	EOL         int           `json:"eol"`
+	Approaching int           `json:"approaching"`
+	Supported   int           `json:"supported"`
+	Items       []SummaryItem `json:"items,omitempty"`
+}
+
+// Evaluator annotates components with the end-of-life status of what they use
+type Evaluator struct {
+	dataset *Dataset
+	now     time.Time
+}
+
+// NewEvaluator creates an evaluator judging cycles at the given time
+func NewEvaluator(dataset *Dataset, now time.Time) *Evaluator {
+	return &Evaluator{dataset: dataset, now: now}
+}
+
+// Annotate sets the eol property of every component of the tree and returns the summary of the scan
+func (e *Evaluator) Annotate(root *types.Payload) *Summary {
+	summary := &Summary{
+		Dataset:     e.dataset.Generated,
+		EvaluatedAt: e.now.UTC().Format("2006-01-02"),
+	}
+	e.annotate(root, summary)
+	return summary
+}
+
+func (e *Evaluator) annotate(payload *types.Payload, summary *Summary) {
+	findings := e.Evaluate(payload)
+	if len(findings) > 0 {
+		if payload.Properties == nil {
+			payload.Properties = make(map[string]interface{})
+		}
+		payload.Properties["eol"] = findings
+	}
+
+	for _, finding := range findings {
+		switch finding.Status {
+		case StatusEOL:
+			summary.EOL++
+		case StatusApproaching:
+			summary.Approaching++
+		default:
+			summary.Supported++
+			continue
+		}
+		summary.Items = append(summary.Items, SummaryItem{Finding: finding, Component: payload.Name, Path: payload.Path})
+	}
+
+	for _, child := range payload.Childs {
+		e.annotate(child, summary)
+	}
+}
+
+// Evaluate returns the findings of a single component: its runtime property, the base images of its
+// Dockerfiles and its framework dependencies
+func (e *Evaluator) Evaluate(payload *types.Payload) []Finding {
+	var findings []Finding
+
+	if runtimes, ok := payload.Properties["runtime"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(runtimes) {
+			runtime, ok := runtimes[name].(parsers.RuntimeVersion)
+			product, tracked := runtimeProducts[name]
+			if !ok || !tracked {
+				continue
+			}
+			if finding, found := e.find(KindRuntime, product, runtimeVersion(product, runtime.Version), runtime.Source); found {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	if dockerfiles, ok := payload.Properties["docker"].([]interface{}); ok {
+		for _, item := range dockerfiles {
+			info, ok := item.(*parsers.DockerfileInfo)
+			if !ok || info == nil {
+				continue
+			}
+			for _, image := range info.BaseImages {
+				product, tag := imageProduct(image)
+				if product == "" {
+					continue
+				}
+				if finding, found := e.find(KindImage, product, tag, info.File+" ("+image+")"); found {
+					findings = append(findings, finding)
+				}
+			}
+		}
+	}
+
+	// A framework is reported once per component, Spring Boot is pulled in by many starters
+	seen := make(map[string]bool)
+	for _, dependency := range payload.Dependencies {
+		product := frameworkProduct(dependency)
+		if product == "" || seen[product] {
+			continue
+		}
+		if finding, found := e.find(KindFramework, product, dependency.Example, dependency.Type+":"+dependency.Name); found {
+			seen[product] = true
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings
+}
+
+func (e *Evaluator) find(kind, product, version, source string) (Finding, bool) {
+	cycle, found := e.dataset.Lookup(product, version)
+	if !found {
+		return Finding{}, false
+	}
+	return Finding{
+		Kind:    kind,
+		Product: product,
+		Cycle:   cycle.Cycle,
+		Version: version,
+		Source:  source,
+		EOL:     cycle.EOL.Date,
+		Status:  cycle.Status(e.now),
+	}, true
+}
+
+// runtimeVersion converts runtime versions to the cycles of the dataset
+// Java 1.8 is cycle 8, .NET target frameworks (net8.0, netcoreapp3.1) carry the cycle after the moniker
+func runtimeVersion(product, version string) string {
+	switch product {
+	case "eclipse-temurin":
+		return strings.TrimPrefix(version, "1.")
+	case "dotnet":
+		// .NET Standard and .NET Framework (net48) have no entry in the dotnet product
+		if strings.HasPrefix(version, "netstandard") || (strings.HasPrefix(version, "net") && !strings.Contains(version, ".")) {
+			return ""
+		}
+	}
+	return version
+}
+
+// imageProduct returns the product and the tag of a base image, or "" for untracked images and floating tags
+func imageProduct(image string) (string, string) {
+	image, _, _ = strings.Cut(image, "@")
+	name, tag := parsers.SplitImageReference(image)
+	if tag == "latest" || strings.Contains(tag, "$") {
+		return "", ""
+	}
+
+	if product, exists := imageProducts[name]; exists {
+		return product, tag
+	}
+	name = strings.TrimPrefix(name, "docker.io/")
+	name = strings.TrimPrefix(name, "library/")
+	return imageProducts[name], tag
+}
+
+// frameworkProduct returns the product of a framework dependency with a concrete version or constraint
+func frameworkProduct(dependency types.Dependency) string {
+	if !versionTokenRegex.MatchString(dependency.Example) {
+		return ""
+	}
+	if (dependency.Type == "maven" || dependency.Type == "gradle") && strings.HasPrefix(dependency.Name, springBootGroup) {
+		return "spring-boot"
+	}
+	return frameworkProducts[dependency.Type][dependency.Name]
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
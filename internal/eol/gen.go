@@ -0,0 +1,83 @@
+//go:build ignore
+
+// gen refreshes data/eol.json from the endoflife.date API
+// The products already present in the dataset are refreshed, add an empty array to track a new one
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/eol"
+)
+
+const (
+	datasetFile = "data/eol.json"
+	apiURL      = "https://endoflife.date/api/%s.json"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "eol: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	content, err := os.ReadFile(datasetFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", datasetFile, err)
+	}
+	dataset, err := eol.Parse(content)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	for _, product := range dataset.ProductNames() {
+		cycles, err := fetchCycles(client, product)
+		if err != nil {
+			return err
+		}
+		dataset.Products[product] = cycles
+		fmt.Fprintf(os.Stderr, "eol: %s: %d cycles\n", product, len(cycles))
+	}
+	dataset.Source = "https://endoflife.date"
+	dataset.Generated = time.Now().UTC().Format("2006-01-02")
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(dataset); err != nil {
+		return fmt.Errorf("failed to encode dataset: %w", err)
+	}
+	return os.WriteFile(datasetFile, out.Bytes(), 0644)
+}
+
+func fetchCycles(client *http.Client, product string) ([]eol.Cycle, error) {
+	resp, err := client.Get(fmt.Sprintf(apiURL, product))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", product, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", product, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", product, err)
+	}
+
+	// The API returns more fields (latest, lts, support, ...), only those of eol.Cycle are kept
+	var cycles []eol.Cycle
+	if err := json.Unmarshal(body, &cycles); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", product, err)
+	}
+	return cycles, nil
+}
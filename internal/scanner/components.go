@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
@@ -131,6 +132,10 @@ func (d *ComponentDetector) detectPyprojectToml(files []types.File, currentPath
 		d.processPythonDependencies(payload, dependencies, currentPath)
 		d.pythonParser.DetectLicense(string(content), payload)
 
+		// Python version from requires-python and .python-version
+		runtimeParser := parsers.NewRuntimeParser()
+		components.AddRuntimeVersions(payload, runtimeParser.ParsePyprojectRuntime(string(content)), files, currentPath, d.provider, "python")
+
 		payloads = append(payloads, payload)
 	}
 
@@ -157,6 +162,9 @@ func (d *ComponentDetector) detectRequirementsTxt(files []types.File, currentPat
 		dependencies := d.pythonParser.ParseRequirementsTxt(string(content))
 		d.processPythonDependencies(payload, dependencies, currentPath)
 
+		// Python version from .python-version
+		components.AddRuntimeVersions(payload, nil, files, currentPath, d.provider, "python")
+
 		payloads = append(payloads, payload)
 	}
 
@@ -0,0 +1,111 @@
+package ansible
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "ansible"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	// Task and handler files of a role live in roles/<role>/tasks and roles/<role>/handlers
+	role, roleDirKind := roleDirectory(currentPath)
+
+	for _, file := range files {
+		ext := strings.ToLower(filepath.Ext(file.Name))
+		if file.Name != "ansible.cfg" && ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil {
+			continue
+		}
+
+		ansibleParser := parsers.NewAnsibleParser()
+		var info *parsers.AnsibleInfo
+		switch {
+		case file.Name == "ansible.cfg":
+			info = ansibleParser.ParseConfig(string(content))
+		case file.Name == "requirements.yml" || file.Name == "requirements.yaml":
+			info = ansibleParser.ParseRequirements(string(content))
+		case file.Name == "galaxy.yml" || file.Name == "galaxy.yaml":
+			info = ansibleParser.ParseGalaxy(string(content))
+		case roleDirKind != "":
+			info = ansibleParser.ParseTasks(string(content))
+			if info != nil {
+				info.Kind = roleDirKind
+				info.Role = role
+			}
+		case ansibleParser.IsPlaybookCandidate(string(content)):
+			info = ansibleParser.ParsePlaybook(string(content))
+		}
+
+		if info != nil {
+			results = append(results, d.createPayload(info, file, currentPath, basePath, depDetector))
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) createPayload(info *parsers.AnsibleInfo, file types.File, currentPath, basePath string, depDetector components.DependencyDetector) *types.Payload {
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	info.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("ansible", "matched file: "+file.Name)
+	payload.Properties["ansible"] = []interface{}{info}
+
+	// Roles and collections are the dependencies of Ansible content
+	names := make([]string, 0, len(info.Requirements)+len(info.Modules))
+	for _, requirement := range info.Requirements {
+		payload.AddDependency(types.Dependency{
+			Type:    "ansible",
+			Name:    requirement.Name,
+			Example: requirement.Version,
+		})
+		names = append(names, requirement.Name)
+	}
+
+	// Collections and the modules used in tasks map onto techs (community.postgresql.* -> postgresql)
+	names = append(names, info.Modules...)
+	if len(names) > 0 {
+		matchedTechs := depDetector.MatchDependencies(names, "ansible")
+		for tech, reasons := range matchedTechs {
+			for _, reason := range reasons {
+				payload.AddTech(tech, reason)
+			}
+		}
+	}
+
+	return payload
+}
+
+// roleDirectory returns the role name and directory kind ("tasks" or "handlers") for roles/<role>/<kind>
+func roleDirectory(currentPath string) (string, string) {
+	kind := filepath.Base(currentPath)
+	if kind != "tasks" && kind != "handlers" {
+		return "", ""
+	}
+
+	roleDir := filepath.Dir(currentPath)
+	if filepath.Base(filepath.Dir(roleDir)) != "roles" {
+		return "", ""
+	}
+	return filepath.Base(roleDir), kind
+}
+
+func init() {
+	components.Register(&Detector{})
+}
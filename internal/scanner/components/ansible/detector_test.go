@@ -0,0 +1,150 @@
+package ansible
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var ansibleRules = &MockDependencyDetector{prefixes: map[string]string{
+	"community.postgresql": "postgresql",
+	"amazon.aws":           "aws",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "ansible", detector.Name())
+}
+
+func TestDetector_Detect_PlaybookRequirementsAndConfig(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/deploy/site.yml":           "- hosts: db\n  roles: [database]\n  tasks:\n    - amazon.aws.s3_bucket:\n        name: backups\n",
+		"/mock/deploy/requirements.yml":   "collections:\n  - name: community.postgresql\n    version: 3.4.0\nroles:\n  - geerlingguy.ntp\n",
+		"/mock/deploy/ansible.cfg":        "[defaults]\ninventory = hosts.ini\n",
+		"/mock/deploy/docker-compose.yml": "services:\n  web:\n    image: nginx\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "site.yml", Path: "/mock/deploy/site.yml"},
+		{Name: "requirements.yml", Path: "/mock/deploy/requirements.yml"},
+		{Name: "ansible.cfg", Path: "/mock/deploy/ansible.cfg"},
+		{Name: "docker-compose.yml", Path: "/mock/deploy/docker-compose.yml"},
+	}
+	results := detector.Detect(files, "/mock/deploy", "/mock", provider, ansibleRules)
+
+	require.Len(t, results, 3)
+	for _, payload := range results {
+		assert.Equal(t, "virtual", payload.Name)
+		assert.Contains(t, payload.Techs, "ansible")
+	}
+
+	playbook := results[0]
+	assert.Contains(t, playbook.Techs, "aws")
+	info := playbook.Properties["ansible"].([]interface{})[0].(*parsers.AnsibleInfo)
+	assert.Equal(t, "/deploy/site.yml", info.File)
+	assert.Equal(t, "playbook", info.Kind)
+	assert.Equal(t, []string{"database"}, info.Roles)
+
+	requirements := results[1]
+	assert.Contains(t, requirements.Techs, "postgresql")
+	assert.Equal(t, []types.Dependency{
+		{Type: "ansible", Name: "geerlingguy.ntp"},
+		{Type: "ansible", Name: "community.postgresql", Example: "3.4.0"},
+	}, requirements.Dependencies)
+
+	config := results[2].Properties["ansible"].([]interface{})[0].(*parsers.AnsibleInfo)
+	assert.Equal(t, "hosts.ini", config.Inventory)
+}
+
+func TestDetector_Detect_RoleTasks(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/roles/database/tasks/main.yml":    "- community.postgresql.postgresql_db:\n    name: app\n",
+		"/mock/roles/database/handlers/main.yml": "- name: restart\n  ansible.builtin.service:\n    name: postgresql\n",
+	}}
+
+	detector := &Detector{}
+
+	results := detector.Detect([]types.File{{Name: "main.yml"}}, "/mock/roles/database/tasks", "/mock", provider, ansibleRules)
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Techs, "postgresql")
+	info := results[0].Properties["ansible"].([]interface{})[0].(*parsers.AnsibleInfo)
+	assert.Equal(t, "tasks", info.Kind)
+	assert.Equal(t, "database", info.Role)
+
+	results = detector.Detect([]types.File{{Name: "main.yml"}}, "/mock/roles/database/handlers", "/mock", provider, ansibleRules)
+	require.Len(t, results, 1)
+	info = results[0].Properties["ansible"].([]interface{})[0].(*parsers.AnsibleInfo)
+	assert.Equal(t, "handlers", info.Kind)
+	assert.Equal(t, []string{"ansible.builtin.service"}, info.Modules)
+}
+
+func TestDetector_Detect_TasksOutsideRoles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.github/tasks/list.yml": "- name: x\n  run: echo\n",
+	}}
+
+	detector := &Detector{}
+	results := detector.Detect([]types.File{{Name: "list.yml"}}, "/mock/.github/tasks", "/mock", provider, ansibleRules)
+
+	assert.Empty(t, results)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "api"
+}
+
+// specExtensions lists the file extensions that may contain an OpenAPI, Swagger or AsyncAPI document
+var specExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+var graphQLExtensions = map[string]bool{
+	".graphql":  true,
+	".graphqls": true,
+	".gql":      true,
+}
+
+// contractTechs maps contract types to the tech added to the owning component
+var contractTechs = map[string]string{
+	parsers.APITypeOpenAPI:  "openapi_spec",
+	parsers.APITypeSwagger:  "openapi_spec",
+	parsers.APITypeAsyncAPI: "asyncapi",
+	parsers.APITypeGraphQL:  "graphql",
+	parsers.APITypeProtobuf: "protobuf",
+}
+
+// maxContractSize skips generated or bundled specifications larger than 10MB
+const maxContractSize = 10_000_000
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if payload := d.detectContract(file, currentPath, basePath, provider); payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectContract(file types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	extension := strings.ToLower(filepath.Ext(file.Name))
+	if !specExtensions[extension] && !graphQLExtensions[extension] && extension != ".proto" {
+		return nil
+	}
+
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil || len(content) > maxContractSize {
+		return nil
+	}
+
+	apiParser := parsers.NewAPIParser()
+	var contract *parsers.APIContract
+	switch {
+	case specExtensions[extension]:
+		if !apiParser.IsAPISpecCandidate(string(content)) {
+			return nil
+		}
+		contract = apiParser.ParseAPISpec(string(content))
+	case graphQLExtensions[extension]:
+		contract = apiParser.ParseGraphQLSchema(string(content))
+	default:
+		contract = apiParser.ParseProto(string(content))
+	}
+	if contract == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	contract.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech(contractTechs[contract.Type], "matched file: "+file.Name)
+	if len(contract.Services) > 0 {
+		payload.AddTech("grpc", "proto service: "+contract.Services[0])
+	}
+
+	// Add the contract to properties as array (Properties already initialized by NewPayloadWithPath)
+	payload.Properties["api"] = []interface{}{contract}
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "api", detector.Name())
+}
+
+func TestDetector_Detect_OpenAPI(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/services/orders/openapi.yaml": `openapi: 3.1.0
+info:
+  title: Orders
+  version: 2.0.0
+servers:
+  - url: https://orders.example.com
+paths:
+  /orders:
+    get: {}
+`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "openapi.yaml", Path: "/mock/services/orders/openapi.yaml"}}
+	results := detector.Detect(files, "/mock/services/orders", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, []string{"openapi_spec"}, payload.Techs)
+
+	apiProps, ok := payload.Properties["api"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, apiProps, 1)
+	contract, ok := apiProps[0].(*parsers.APIContract)
+	require.True(t, ok)
+	assert.Equal(t, "/services/orders/openapi.yaml", contract.File)
+	assert.Equal(t, parsers.APITypeOpenAPI, contract.Type)
+	assert.Equal(t, "Orders", contract.Title)
+	assert.Equal(t, []string{"https://orders.example.com"}, contract.Servers)
+	assert.Equal(t, 1, contract.Operations)
+}
+
+func TestDetector_Detect_ProtoService(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/proto/orders.proto": `syntax = "proto3";
+package orders.v1;
+service OrderService {
+  rpc GetOrder(GetOrderRequest) returns (Order);
+}
+`,
+		"/mock/proto/types.proto": "syntax = \"proto3\";\nmessage Order {}\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "orders.proto", Path: "/mock/proto/orders.proto"},
+		{Name: "types.proto", Path: "/mock/proto/types.proto"},
+	}
+	results := detector.Detect(files, "/mock/proto", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 2)
+	assert.ElementsMatch(t, []string{"protobuf", "grpc"}, results[0].Techs)
+	assert.Equal(t, []string{"protobuf"}, results[1].Techs)
+}
+
+func TestDetector_Detect_IgnoresOtherFiles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/deployment.yaml": "apiVersion: apps/v1\nkind: Deployment\n",
+		"/mock/package.json":    `{"name": "app", "description": "openapi client"}`,
+		"/mock/queries.graphql": "query Me { me { id } }\n",
+		"/mock/main.go":         "package main",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "deployment.yaml", Path: "/mock/deployment.yaml"},
+		{Name: "package.json", Path: "/mock/package.json"},
+		{Name: "queries.graphql", Path: "/mock/queries.graphql"},
+		{Name: "main.go", Path: "/mock/main.go"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, &MockDependencyDetector{})
+
+	assert.Empty(t, results)
+}
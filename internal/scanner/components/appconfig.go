@@ -0,0 +1,73 @@
+package components
+
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// maxAppConfigSize skips configuration files larger than 1MB
+const maxAppConfigSize = 1_000_000
+
+// AddAppConfig adds the techs wired in the Spring Boot and ASP.NET configuration files of a directory to a
+// component and records them in its app_config property. It returns whether any configuration was found
+func AddAppConfig(payload *types.Payload, files []types.File, currentPath, basePath string, provider types.Provider) bool {
+	found := false
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+		config := ParseAppConfigFile(file.Name, currentPath, provider)
+		if config == nil {
+			continue
+		}
+
+		config.File = RelativePath(basePath, currentPath, file.Name)
+		ApplyAppConfig(payload, file.Name, config)
+		found = true
+	}
+	return found
+}
+
+// ParseAppConfigFile parses a Spring Boot or ASP.NET configuration file, or returns nil for other files
+func ParseAppConfigFile(fileName, currentPath string, provider types.Provider) *parsers.AppConfig {
+	appConfigParser := parsers.NewAppConfigParser()
+
+	var parse func(string) *parsers.AppConfig
+	if _, ok := appConfigParser.IsSpringConfigFile(fileName); ok {
+		parse = func(content string) *parsers.AppConfig {
+			return appConfigParser.ParseSpringConfig(fileName, content)
+		}
+	} else if _, ok := appConfigParser.IsAspNetSettingsFile(fileName); ok {
+		parse = func(content string) *parsers.AppConfig {
+			return appConfigParser.ParseAspNetSettings(fileName, content)
+		}
+	} else if fileName == "launchSettings.json" {
+		parse = appConfigParser.ParseLaunchSettings
+	} else {
+		return nil
+	}
+
+	content, err := provider.ReadFile(filepath.Join(currentPath, fileName))
+	if err != nil || len(content) > maxAppConfigSize {
+		return nil
+	}
+	return parse(string(content))
+}
+
+// ApplyAppConfig adds the techs of a parsed configuration file to a payload with the key as evidence, the
+// services its values reference and the ports the application listens on
+func ApplyAppConfig(payload *types.Payload, fileName string, config *parsers.AppConfig) {
+	for _, connection := range config.Connections {
+		payload.AddTech(connection.Tech, "config "+connection.Key+": "+fileName)
+	}
+	for _, ref := range config.References {
+		payload.AddServiceRef(ref)
+	}
+	for _, endpoint := range config.Endpoints {
+		endpoint.File = config.File
+		payload.AddEndpoint(endpoint)
+	}
+	payload.MergeProperties(map[string]interface{}{"app_config": []interface{}{config}})
+}
@@ -0,0 +1,50 @@
+package appconfig
+
+import (
+	"regexp"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// Detector attaches Spring Boot and ASP.NET configuration files outside of project directories
+// (src/main/resources, Properties/launchSettings.json) to the owning component
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "appconfig"
+}
+
+// projectFileRegex matches the build files whose detectors analyze configuration files themselves
+var projectFileRegex = regexp.MustCompile(`^(?:pom\.xml|build\.gradle(?:\.kts)?|.+\.(?:csproj|fsproj|vbproj))$`)
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	for _, file := range files {
+		if projectFileRegex.MatchString(file.Name) {
+			return nil
+		}
+	}
+
+	var results []*types.Payload
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+		config := components.ParseAppConfigFile(file.Name, currentPath, provider)
+		if config == nil {
+			continue
+		}
+
+		relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+		config.File = relativeFilePath
+		payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+		components.ApplyAppConfig(payload, file.Name, config)
+		results = append(results, payload)
+	}
+
+	return results
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,128 @@
+package appconfig
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "appconfig", detector.Name())
+}
+
+func TestDetector_Detect_SpringResources(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/orders/src/main/resources/application.yml": `spring:
+  datasource:
+    url: jdbc:postgresql://db:5432/orders
+    password: secret
+  kafka:
+    bootstrap-servers: kafka:9092
+`,
+		"/mock/orders/src/main/resources/application-test.properties": "spring.datasource.url=jdbc:h2:mem:orders\n",
+		"/mock/orders/src/main/resources/logback.xml":                 "<configuration/>",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "application.yml", Path: "/mock/orders/src/main/resources/application.yml", Type: "file"},
+		{Name: "application-test.properties", Path: "/mock/orders/src/main/resources/application-test.properties", Type: "file"},
+		{Name: "logback.xml", Path: "/mock/orders/src/main/resources/logback.xml", Type: "file"},
+	}
+	results := detector.Detect(files, "/mock/orders/src/main/resources", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 2)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, []string{"postgresql", "apache_kafka"}, payload.Techs)
+	assert.Contains(t, payload.Reason, "config spring.datasource.url: application.yml")
+
+	configs, ok := payload.Properties["app_config"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, configs, 1)
+	config := configs[0].(*parsers.AppConfig)
+	assert.Equal(t, "/orders/src/main/resources/application.yml", config.File)
+	assert.NotContains(t, config.Connections[0].Key+config.Connections[0].Scheme, "secret", "values are never stored")
+
+	// Profile-only files are recorded without techs
+	assert.Empty(t, results[1].Techs)
+	profileConfig := results[1].Properties["app_config"].([]interface{})[0].(*parsers.AppConfig)
+	assert.Equal(t, []string{"test"}, profileConfig.Profiles)
+}
+
+func TestDetector_Detect_LaunchSettings(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/api/Properties/launchSettings.json": `{"profiles": {"api": {"environmentVariables": {"ASPNETCORE_ENVIRONMENT": "Development"}}}}`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "launchSettings.json", Path: "/mock/api/Properties/launchSettings.json", Type: "file"}}
+	results := detector.Detect(files, "/mock/api/Properties", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	config := results[0].Properties["app_config"].([]interface{})[0].(*parsers.AppConfig)
+	assert.Equal(t, parsers.AppConfigAspNet, config.Framework)
+	assert.Equal(t, []string{"Development"}, config.Environments)
+}
+
+func TestDetector_Detect_SkipsProjectDirectories(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/api/appsettings.json": `{"ConnectionStrings": {"Default": "Host=db;Database=api;Username=api"}}`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "Api.csproj", Path: "/mock/api/Api.csproj", Type: "file"},
+		{Name: "appsettings.json", Path: "/mock/api/appsettings.json", Type: "file"},
+	}
+	assert.Empty(t, detector.Detect(files, "/mock/api", "/mock", provider, &MockDependencyDetector{}),
+		"the dotnet detector attaches configuration next to the project file")
+}
@@ -0,0 +1,128 @@
+package azurepipelines
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "azurepipelines"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	// Templates are commonly kept below .azure-pipelines/ (e.g. .azure-pipelines/templates/build.yml)
+	inPipelinesDir := isInPipelinesDir(currentPath, basePath)
+
+	for _, file := range files {
+		ext := filepath.Ext(file.Name)
+		if ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+		// azure-pipelines.yml, azure-pipelines-release.yml, ...
+		if !strings.HasPrefix(file.Name, "azure-pipelines") && !inPipelinesDir {
+			continue
+		}
+
+		payload := d.detectPipeline(file, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectPipeline(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	azureParser := parsers.NewAzurePipelinesParser()
+	pipeline := azureParser.ParseAzurePipelines(string(content))
+	if pipeline == nil || pipeline.IsEmpty() {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	pipeline.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("azure.ci", "matched file: "+file.Name)
+	payload.Properties["azure_pipelines"] = []interface{}{pipeline}
+
+	// Tasks are referenced as Name@MajorVersion (e.g. Docker@2, AzureFunctionApp@2)
+	taskNames := make([]string, 0, len(pipeline.Tasks))
+	for _, task := range pipeline.Tasks {
+		payload.AddDependency(types.Dependency{
+			Type:    "azurePipelinesTask",
+			Name:    task.Name,
+			Example: task.Version,
+		})
+		taskNames = append(taskNames, task.Name)
+	}
+	components.AddMatchedTechs(payload, taskNames, "azurePipelinesTask", depDetector)
+
+	// Container resources and job containers are routed to the docker rules
+	var imageNames []string
+	for _, container := range pipeline.Containers {
+		// Skip images set through variables or template expressions
+		if strings.HasPrefix(container.Image, "$") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(container.Image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Repository resources hold shared templates
+	var repositoryNames []string
+	for _, repository := range pipeline.Repositories {
+		if repository.Name == "" {
+			continue
+		}
+		payload.AddDependency(types.Dependency{
+			Type:    "azurePipelinesRepository",
+			Name:    repository.Name,
+			Example: repository.Ref,
+		})
+		repositoryNames = append(repositoryNames, repository.Name)
+	}
+	components.AddMatchedTechs(payload, repositoryNames, "azurePipelinesRepository", depDetector)
+
+	// Tools invoked by script steps are matched against the command rules
+	components.AddCommandDependencies(payload, pipeline.Scripts, depDetector)
+
+	return payload
+}
+
+// isInPipelinesDir reports whether a directory is .azure-pipelines or below it
+func isInPipelinesDir(currentPath, basePath string) bool {
+	relativePath, err := filepath.Rel(basePath, currentPath)
+	if err != nil {
+		return false
+	}
+	for _, segment := range strings.Split(filepath.ToSlash(relativePath), "/") {
+		if segment == ".azure-pipelines" {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,139 @@
+package azurepipelines
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var azureRules = &MockDependencyDetector{prefixes: map[string]string{
+	"Docker":           "docker",
+	"AzureFunctionApp": "azure.functions",
+	"postgres":         "postgresql",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "azurepipelines", detector.Name())
+}
+
+func TestDetector_Detect_Pipeline(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/azure-pipelines.yml": `resources:
+  repositories:
+    - repository: templates
+      type: git
+      name: Platform/templates
+      ref: refs/heads/main
+  containers:
+    - container: db
+      image: postgres:16
+pool:
+  vmImage: ubuntu-latest
+steps:
+  - task: Docker@2
+  - task: AzureFunctionApp@2
+  - template: build.yml@templates
+`,
+		"/mock/settings.yml": "steps:\n  - task: Docker@2\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "azure-pipelines.yml", Path: "/mock/azure-pipelines.yml"},
+		{Name: "settings.yml", Path: "/mock/settings.yml"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, azureRules)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "azure.ci")
+	assert.Contains(t, payload.Techs, "docker")
+	assert.Contains(t, payload.Techs, "azure.functions")
+	assert.Contains(t, payload.Techs, "postgresql")
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "azurePipelinesTask", Name: "Docker", Example: "2"},
+		{Type: "azurePipelinesTask", Name: "AzureFunctionApp", Example: "2"},
+		{Type: "docker", Name: "postgres", Example: "16"},
+		{Type: "azurePipelinesRepository", Name: "Platform/templates", Example: "refs/heads/main"},
+	}, payload.Dependencies)
+
+	properties, ok := payload.Properties["azure_pipelines"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, properties, 1)
+}
+
+func TestDetector_Detect_TemplatesDirectory(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.azure-pipelines/templates/build.yml": "steps:\n  - task: Npm@1\n",
+		"/mock/.azure-pipelines/templates/vars.yml":  "variables:\n  a: b\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "build.yml"}, {Name: "vars.yml"}}
+	results := detector.Detect(files, "/mock/.azure-pipelines/templates", "/mock", provider, azureRules)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, "/.azure-pipelines/templates/build.yml", results[0].Path[0])
+	assert.Equal(t, []types.Dependency{{Type: "azurePipelinesTask", Name: "Npm", Example: "1"}}, results[0].Dependencies)
+}
@@ -0,0 +1,91 @@
+package bitbucketpipelines
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "bitbucketpipelines"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if file.Name != "bitbucket-pipelines.yml" && file.Name != "bitbucket-pipelines.yaml" {
+			continue
+		}
+
+		payload := d.detectPipeline(file, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectPipeline(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	bitbucketParser := parsers.NewBitbucketPipelinesParser()
+	pipeline := bitbucketParser.ParseBitbucketPipelines(string(content))
+	if pipeline == nil || pipeline.IsEmpty() {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	pipeline.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("atlassian.bitbucketpipelines", "matched file: "+file.Name)
+	payload.Properties["bitbucket_pipelines"] = []interface{}{pipeline}
+
+	// Pipes are docker based integrations referenced as owner/pipe:version
+	pipeNames := make([]string, 0, len(pipeline.Pipes))
+	for _, pipe := range pipeline.Pipes {
+		payload.AddDependency(types.Dependency{
+			Type:    "bitbucketPipe",
+			Name:    pipe.Name,
+			Example: pipe.Version,
+		})
+		pipeNames = append(pipeNames, pipe.Name)
+	}
+	components.AddMatchedTechs(payload, pipeNames, "bitbucketPipe", depDetector)
+
+	// Build and service images are routed to the docker rules
+	var imageNames []string
+	for _, image := range pipeline.AllImages() {
+		// Skip images set through repository or deployment variables
+		if strings.HasPrefix(image, "$") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Tools invoked by step scripts are matched against the command rules
+	components.AddCommandDependencies(payload, pipeline.Scripts, depDetector)
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
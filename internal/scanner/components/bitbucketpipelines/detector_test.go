@@ -0,0 +1,119 @@
+package bitbucketpipelines
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var bitbucketRules = &MockDependencyDetector{prefixes: map[string]string{
+	"atlassian/aws-s3-deploy": "aws.s3",
+	"postgres":                "postgresql",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "bitbucketpipelines", detector.Name())
+}
+
+func TestDetector_Detect_Pipeline(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/bitbucket-pipelines.yml": `image: $BUILD_IMAGE
+definitions:
+  services:
+    postgres:
+      image: postgres:16
+pipelines:
+  default:
+    - step:
+        image: python:3.12
+        services: [postgres]
+        script:
+          - pytest
+          - pipe: atlassian/aws-s3-deploy:1.1.0
+`,
+		"/mock/pipelines.yml": "pipelines:\n  default:\n    - step:\n        script: [make]\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "bitbucket-pipelines.yml", Path: "/mock/bitbucket-pipelines.yml"},
+		{Name: "pipelines.yml", Path: "/mock/pipelines.yml"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, bitbucketRules)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "atlassian.bitbucketpipelines")
+	assert.Contains(t, payload.Techs, "aws.s3")
+	assert.Contains(t, payload.Techs, "postgresql")
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "bitbucketPipe", Name: "atlassian/aws-s3-deploy", Example: "1.1.0"},
+		{Type: "docker", Name: "python", Example: "3.12"},
+		{Type: "docker", Name: "postgres", Example: "16"},
+	}, payload.Dependencies)
+
+	properties, ok := payload.Properties["bitbucket_pipelines"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, properties, 1)
+}
@@ -0,0 +1,98 @@
+package circleci
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "circleci"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	// The configuration lives in .circleci/config.yml
+	if filepath.Base(currentPath) != ".circleci" {
+		return nil
+	}
+
+	var results []*types.Payload
+	for _, file := range files {
+		if file.Name != "config.yml" && file.Name != "config.yaml" {
+			continue
+		}
+
+		payload := d.detectConfig(file, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectConfig(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	circleParser := parsers.NewCircleCIParser()
+	config := circleParser.ParseCircleCI(string(content))
+	if config == nil || config.IsEmpty() {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	config.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("circleci", "matched file: .circleci/"+file.Name)
+	payload.Properties["circleci"] = []interface{}{config}
+
+	// Orbs are registry packages referenced as namespace/orb@version
+	var orbNames []string
+	for _, orb := range config.Orbs {
+		if orb.Name == "" {
+			continue
+		}
+		payload.AddDependency(types.Dependency{
+			Type:    "circleciOrb",
+			Name:    orb.Name,
+			Example: orb.Version,
+		})
+		orbNames = append(orbNames, orb.Name)
+	}
+	components.AddMatchedTechs(payload, orbNames, "circleciOrb", depDetector)
+
+	// Docker executor images are routed to the docker rules
+	var imageNames []string
+	for _, image := range config.Images() {
+		// Skip images set through pipeline parameters or environment variables
+		if strings.HasPrefix(image, "$") || strings.HasPrefix(image, "<<") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Tools invoked by run steps are matched against the command rules
+	components.AddCommandDependencies(payload, config.Scripts, depDetector)
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,118 @@
+package circleci
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var circleRules = &MockDependencyDetector{prefixes: map[string]string{
+	"circleci/aws-ecr": "aws.ecr",
+	"cimg/postgres":    "postgresql",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "circleci", detector.Name())
+}
+
+func TestDetector_Detect_Config(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.circleci/config.yml": `version: 2.1
+orbs:
+  aws-ecr: circleci/aws-ecr@9.0.2
+jobs:
+  test:
+    docker:
+      - image: cimg/python:3.12
+      - image: cimg/postgres:16.1
+      - image: << parameters.image >>
+    steps:
+      - checkout
+`,
+		"/mock/config.yml": "jobs:\n  test:\n    docker:\n      - image: cimg/python:3.12\n",
+	}}
+
+	detector := &Detector{}
+
+	results := detector.Detect([]types.File{{Name: "config.yml"}}, "/mock/.circleci", "/mock", provider, circleRules)
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, "/.circleci/config.yml", payload.Path[0])
+	assert.Contains(t, payload.Techs, "circleci")
+	assert.Contains(t, payload.Techs, "aws.ecr")
+	assert.Contains(t, payload.Techs, "postgresql")
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "circleciOrb", Name: "circleci/aws-ecr", Example: "9.0.2"},
+		{Type: "docker", Name: "cimg/python", Example: "3.12"},
+		{Type: "docker", Name: "cimg/postgres", Example: "16.1"},
+	}, payload.Dependencies)
+
+	properties, ok := payload.Properties["circleci"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, properties, 1)
+
+	// config.yml outside .circleci is not a CircleCI configuration
+	results = detector.Detect([]types.File{{Name: "config.yml"}}, "/mock", "/mock", provider, circleRules)
+	assert.Empty(t, results)
+}
@@ -0,0 +1,158 @@
+package cloudformation
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "cloudformation"
+}
+
+// templateExtensions lists the file extensions that may contain a CloudFormation template
+var templateExtensions = map[string]bool{
+	".yaml":     true,
+	".yml":      true,
+	".json":     true,
+	".template": true,
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if file.Name == "cdk.json" {
+			if payload := d.detectCDKApp(file, currentPath, basePath, provider); payload != nil {
+				results = append(results, payload)
+			}
+			continue
+		}
+
+		if templateExtensions[strings.ToLower(filepath.Ext(file.Name))] {
+			if payload := d.detectTemplate(file, currentPath, basePath, provider, depDetector); payload != nil {
+				results = append(results, payload)
+			}
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectCDKApp(file types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	cfnParser := parsers.NewCloudFormationParser()
+	app := cfnParser.ParseCDKJSON(string(content))
+	if app == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	app.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("aws.cdk", "matched file: cdk.json")
+	payload.Properties["cdk"] = app
+
+	return payload
+}
+
+func (d *Detector) detectTemplate(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	// Skip files larger than 1MB (CloudFormation limits templates to 1MB)
+	if len(content) > 1_000_000 {
+		return nil
+	}
+
+	cfnParser := parsers.NewCloudFormationParserWithCategorizer(components.TerraformCategorizer(depDetector))
+	if !cfnParser.IsCloudFormationCandidate(string(content)) {
+		return nil
+	}
+
+	template := cfnParser.ParseTemplate(string(content))
+	if template == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("aws.cloudformation", "matched file: "+file.Name)
+	if template.IsSAM() {
+		payload.AddTech("aws.sam", "transform: AWS::Serverless")
+	}
+
+	// Add CloudFormation info to properties as array (Properties already initialized by NewPayloadWithPath)
+	info := cfnParser.AggregateTemplate(template)
+	info.File = relativeFilePath
+	payload.Properties["cloudformation"] = []interface{}{info}
+
+	// Collect all dependencies for the parent payload (pre-allocate with known capacity)
+	dependencies := make([]types.Dependency, 0, len(template.Resources))
+
+	// Create child components for each resource
+	for _, resource := range template.Resources {
+		dependencies = append(dependencies, types.Dependency{
+			Type:    "cloudformation.resource",
+			Name:    resource.Type,      // e.g., "AWS::Lambda::Function"
+			Example: resource.LogicalID, // e.g., "ProcessorFunction"
+		})
+
+		// Match resource type against dependency rules
+		matchedTechs := depDetector.MatchDependencies([]string{resource.Type}, "cloudformation.resource")
+
+		// Determine tech and reasons
+		var tech string
+		var reasons []string
+		for t, r := range matchedTechs {
+			tech = t
+			reasons = r
+			break // Take first match
+		}
+
+		if tech == "" {
+			continue // Skip resources that don't match known techs
+		}
+
+		if len(reasons) == 0 {
+			reasons = []string{"matched: " + resource.Type}
+		}
+
+		// Create child component with resource type as the component name
+		childPayload := types.NewPayloadWithPath(resource.Type, relativeFilePath)
+		childPayload.AddPrimaryTech(tech)
+		childPayload.Dependencies = []types.Dependency{
+			{
+				Type:    "cloudformation.resource",
+				Name:    resource.Type,
+				Example: resource.LogicalID,
+			},
+		}
+
+		for _, reason := range reasons {
+			childPayload.AddTech(tech, reason)
+		}
+
+		payload.AddChild(childPayload)
+	}
+
+	payload.Dependencies = dependencies
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
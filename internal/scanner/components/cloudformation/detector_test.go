@@ -0,0 +1,157 @@
+package cloudformation
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Resource types are matched by exact name
+type MockDependencyDetector struct {
+	techs map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		if tech, exists := m.techs[dep]; exists {
+			result[tech] = []string{"matched: " + dep}
+		}
+	}
+	return result
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "cloudformation", detector.Name())
+}
+
+func TestDetector_Detect_SAMTemplate(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/project/template.yaml": `AWSTemplateFormatVersion: "2010-09-09"
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Orders:
+    Type: AWS::SQS::Queue
+  Handler:
+    Type: AWS::Serverless::Function
+    Properties:
+      Events:
+        Queue:
+          Type: SQS
+          Properties:
+            Queue: !GetAtt Orders.Arn
+  Alarm:
+    Type: AWS::CloudWatch::Alarm
+`,
+	}}
+	depDetector := &MockDependencyDetector{techs: map[string]string{
+		"AWS::SQS::Queue":           "aws.sqs",
+		"AWS::Serverless::Function": "aws.lambda",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "template.yaml", Path: "/mock/project/template.yaml"}}
+	results := detector.Detect(files, "/mock/project", "/mock", provider, depDetector)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "aws.cloudformation")
+	assert.Contains(t, payload.Techs, "aws.sam")
+
+	// All resources are listed as dependencies, matched ones become child components
+	require.Len(t, payload.Dependencies, 3)
+	assert.Equal(t, types.Dependency{Type: "cloudformation.resource", Name: "AWS::SQS::Queue", Example: "Orders"}, payload.Dependencies[0])
+	require.Len(t, payload.Childs, 2)
+	assert.Equal(t, "AWS::SQS::Queue", payload.Childs[0].Name)
+	assert.Equal(t, []string{"aws.sqs"}, payload.Childs[0].Techs)
+	assert.Equal(t, "AWS::Serverless::Function", payload.Childs[1].Name)
+	assert.Equal(t, []string{"aws.lambda"}, payload.Childs[1].Techs)
+
+	cfnProps, ok := payload.Properties["cloudformation"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, cfnProps, 1)
+	info, ok := cfnProps[0].(*parsers.CloudFormationInfo)
+	require.True(t, ok)
+	assert.Equal(t, "/project/template.yaml", info.File)
+	assert.Equal(t, "sam", info.Format)
+	assert.Equal(t, 3, info.TotalResources)
+	assert.Equal(t, map[string]int{"messaging": 1, "serverless": 1, "observability": 1}, info.ResourcesByCategory)
+}
+
+func TestDetector_Detect_CDKApp(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/infra/cdk.json": `{"app": "npx ts-node --prefer-ts-exts bin/infra.ts"}`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "cdk.json", Path: "/mock/infra/cdk.json"}}
+	results := detector.Detect(files, "/mock/infra", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Techs, "aws.cdk")
+	app, ok := results[0].Properties["cdk"].(*parsers.CDKApp)
+	require.True(t, ok)
+	assert.Equal(t, "/infra/cdk.json", app.File)
+	assert.Equal(t, "typescript", app.Language)
+}
+
+func TestDetector_Detect_IgnoresOtherFiles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/deployment.yaml": "apiVersion: apps/v1\nkind: Deployment\n",
+		"/mock/package.json":    `{"name": "app"}`,
+		"/mock/main.go":         "package main",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "deployment.yaml", Path: "/mock/deployment.yaml"},
+		{Name: "package.json", Path: "/mock/package.json"},
+		{Name: "main.go", Path: "/mock/main.go"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, &MockDependencyDetector{})
+
+	assert.Empty(t, results)
+}
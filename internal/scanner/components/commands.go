@@ -0,0 +1,66 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// AddCommandDependencies matches the commands invoked by shell scripts against "command" rules
+// Only matched tools are added as dependencies, the most specific form wins ("docker buildx" over "docker")
+// Returns true if at least one command matched
+func AddCommandDependencies(payload *types.Payload, scripts []string, depDetector DependencyDetector) bool {
+	if len(scripts) == 0 {
+		return false
+	}
+
+	shellParser := parsers.NewShellParser()
+	commands := shellParser.ExtractCommands(strings.Join(scripts, "\n"))
+
+	found := false
+	seen := make(map[string]bool)
+	for _, command := range commands {
+		candidates := []string{command.Name}
+		if command.Subcommand != "" {
+			candidates = []string{command.Name + " " + command.Subcommand, command.Name}
+		}
+
+		for _, name := range candidates {
+			matchedTechs := depDetector.MatchDependencies([]string{name}, "command")
+			if len(matchedTechs) == 0 {
+				continue
+			}
+			if !seen[name] {
+				seen[name] = true
+				payload.AddDependency(types.Dependency{
+					Type: "command",
+					Name: name,
+				})
+			}
+			for tech, reasons := range matchedTechs {
+				for _, reason := range reasons {
+					payload.AddTech(tech, reason)
+				}
+			}
+			found = true
+			break
+		}
+	}
+
+	return found
+}
+
+// AddMatchedTechs adds the techs matched by names of the given dependency type (CI images, actions, orbs, ...)
+// without recording the names as dependencies
+func AddMatchedTechs(payload *types.Payload, names []string, depType string, depDetector DependencyDetector) {
+	if len(names) == 0 {
+		return
+	}
+	matchedTechs := depDetector.MatchDependencies(names, depType)
+	for tech, reasons := range matchedTechs {
+		for _, reason := range reasons {
+			payload.AddTech(tech, reason)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package datastore
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "datastore"
+}
+
+// sourceTechs maps datastore sources to the ORM tech given as evidence
+var sourceTechs = map[string]string{
+	parsers.DatastoreSourcePrisma:     "prisma",
+	parsers.DatastoreSourceTypeORM:    "typeorm",
+	parsers.DatastoreSourceDjango:     "django",
+	parsers.DatastoreSourceSQLAlchemy: "sqlalchemy",
+}
+
+// typeORMSourceExtensions lists the source file extensions that may declare a TypeORM DataSource
+var typeORMSourceExtensions = map[string]bool{
+	".ts":  true,
+	".mts": true,
+	".cts": true,
+	".js":  true,
+	".mjs": true,
+	".cjs": true,
+}
+
+// sourceCandidateRegex matches the base names (without extension) of source files conventionally holding
+// the database setup: TypeORM data sources and NestJS modules, SQLAlchemy engines and Flask configurations
+var sourceCandidateRegex = regexp.MustCompile(`^(?:data[-_.]?source|typeorm[-_.]?config|app\.module|database\.module|database|db|config|session)$`)
+
+// componentManifests mark the root directory of a Node.js or Python component, whose source files are all
+// candidates
+var componentManifests = []string{"package.json", "pyproject.toml", "requirements.txt", "setup.py", "Pipfile"}
+
+// maxConfigSize skips generated or bundled files larger than 1MB
+const maxConfigSize = 1_000_000
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	componentRoot := isComponentRoot(files)
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+		if payload := d.detectDatastores(file, currentPath, basePath, provider, componentRoot); payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectDatastores(file types.File, currentPath, basePath string, provider types.Provider, componentRoot bool) *types.Payload {
+	parse := d.fileParser(file.Name, currentPath, componentRoot)
+	if parse == nil {
+		return nil
+	}
+
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil || len(content) > maxConfigSize {
+		return nil
+	}
+
+	datastores := parse(string(content))
+	if len(datastores) == 0 {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+
+	entries := make([]interface{}, 0, len(datastores))
+	for _, datastore := range datastores {
+		datastore.File = relativeFilePath
+		if tech, exists := sourceTechs[datastore.Source]; exists {
+			payload.AddTech(tech, "matched file: "+file.Name)
+		}
+		if datastore.Database != "" {
+			payload.AddTech(datastore.Database, datastore.Source+" datasource: "+file.Name)
+		}
+		entries = append(entries, datastore)
+	}
+
+	// Add the datastores to properties as array (Properties already initialized by NewPayloadWithPath)
+	payload.Properties["datastores"] = entries
+
+	return payload
+}
+
+// fileParser returns the parse function for a file that may configure a datastore, or nil
+// Source files are only parsed when they are candidates: Django settings, conventional database setup
+// names, or any source file at the root of a Node.js or Python component
+func (d *Detector) fileParser(fileName, currentPath string, componentRoot bool) func(string) []*parsers.Datastore {
+	datastoreParser := parsers.NewDatastoreParser()
+	extension := strings.ToLower(filepath.Ext(fileName))
+
+	switch {
+	case extension == ".prisma":
+		return func(content string) []*parsers.Datastore {
+			if datastore := datastoreParser.ParsePrismaSchema(content); datastore != nil {
+				return []*parsers.Datastore{datastore}
+			}
+			return nil
+		}
+	case strings.HasPrefix(fileName, "ormconfig."):
+		return func(content string) []*parsers.Datastore {
+			return datastoreParser.ParseTypeORMConfig(fileName, content)
+		}
+	case fileName == ".env" || strings.HasPrefix(fileName, ".env."):
+		return datastoreParser.ParseDatabaseURLs
+	case fileName == "alembic.ini":
+		return datastoreParser.ParseSQLAlchemySource
+	case extension == ".py":
+		isSettings := fileName == "settings.py" || filepath.Base(currentPath) == "settings"
+		if !isSettings && !componentRoot && !isSourceCandidate(fileName) {
+			return nil
+		}
+		return func(content string) []*parsers.Datastore {
+			var datastores []*parsers.Datastore
+			if isSettings {
+				datastores = datastoreParser.ParseDjangoSettings(content)
+			}
+			return append(datastores, datastoreParser.ParseSQLAlchemySource(content)...)
+		}
+	case typeORMSourceExtensions[extension] && !strings.HasSuffix(fileName, ".d.ts"):
+		if !componentRoot && !isSourceCandidate(fileName) {
+			return nil
+		}
+		return func(content string) []*parsers.Datastore {
+			if !strings.Contains(content, "typeorm") {
+				return nil
+			}
+			return datastoreParser.ParseTypeORMSource(content)
+		}
+	}
+	return nil
+}
+
+// isSourceCandidate reports whether a source file name is conventionally used for the database setup
+func isSourceCandidate(fileName string) bool {
+	return sourceCandidateRegex.MatchString(strings.ToLower(strings.TrimSuffix(fileName, filepath.Ext(fileName))))
+}
+
+// isComponentRoot reports whether a directory holds a Node.js or Python manifest
+func isComponentRoot(files []types.File) bool {
+	for _, file := range files {
+		for _, manifest := range componentManifests {
+			if file.Name == manifest && file.Type != "dir" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func init() {
+	components.Register(&Detector{})
+}
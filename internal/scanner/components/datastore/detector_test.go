@@ -0,0 +1,187 @@
+package datastore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "datastore", detector.Name())
+}
+
+func TestDetector_Detect_PrismaSchema(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/web/prisma/schema.prisma": `datasource db {
+  provider = "mysql"
+  url      = env("DATABASE_URL")
+}
+
+model User {
+  id Int @id
+}
+`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "schema.prisma", Path: "/mock/web/prisma/schema.prisma", Type: "file"}}
+	results := detector.Detect(files, "/mock/web/prisma", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, []string{"prisma", "mysql"}, payload.Techs)
+	assert.Contains(t, payload.Reason, "prisma datasource: schema.prisma")
+
+	datastores, ok := payload.Properties["datastores"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{&parsers.Datastore{
+		File:     "/web/prisma/schema.prisma",
+		Source:   parsers.DatastoreSourcePrisma,
+		Provider: "mysql",
+		Database: "mysql",
+		Models:   1,
+	}}, datastores)
+}
+
+func TestDetector_Detect_DjangoAndEnv(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/shop/settings.py": `DATABASES = {"default": {"ENGINE": "django.db.backends.postgresql"}}`,
+		"/mock/shop/.env":        "DATABASE_URL=postgres://shop:secret@db/shop\n",
+		"/mock/shop/views.py":    "from django.shortcuts import render\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "settings.py", Path: "/mock/shop/settings.py", Type: "file"},
+		{Name: ".env", Path: "/mock/shop/.env", Type: "file"},
+		{Name: "views.py", Path: "/mock/shop/views.py", Type: "file"},
+	}
+	results := detector.Detect(files, "/mock/shop", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 2)
+	assert.Equal(t, []string{"django", "postgresql"}, results[0].Techs)
+	assert.Equal(t, []string{"postgresql"}, results[1].Techs)
+
+	datastores := results[1].Properties["datastores"].([]interface{})
+	require.Len(t, datastores, 1)
+	datastore := datastores[0].(*parsers.Datastore)
+	assert.Equal(t, parsers.DatastoreSourceDatabaseURL, datastore.Source)
+	assert.Equal(t, "postgres", datastore.Provider, "only the URL scheme is recorded")
+}
+
+func TestDetector_Detect_TypeORMDataSource(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/api/src/data-source.ts": "import { DataSource } from \"typeorm\"\nexport const ds = new DataSource({ type: \"postgres\" })\n",
+		"/mock/api/src/types.d.ts":     "import { DataSource } from \"typeorm\"\ndeclare const ds: DataSource\n",
+		"/mock/api/src/client.ts":      "const conn = createConnection({ type: \"mysql\" })\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "data-source.ts", Path: "/mock/api/src/data-source.ts", Type: "file"},
+		{Name: "types.d.ts", Path: "/mock/api/src/types.d.ts", Type: "file"},
+		{Name: "client.ts", Path: "/mock/api/src/client.ts", Type: "file"},
+	}
+	results := detector.Detect(files, "/mock/api/src", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1, "files without typeorm import are skipped")
+	assert.Equal(t, []string{"typeorm", "postgresql"}, results[0].Techs)
+}
+
+func TestDetector_Detect_IgnoresOtherFiles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/package.json": `{"name": "app", "dependencies": {"pg": "8.0.0"}}`,
+		"/mock/app.py":       "print('postgres://not-sqlalchemy')\n",
+		"/mock/.envrc":       "export DATABASE_URL=postgres://localhost/app\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "package.json", Path: "/mock/package.json", Type: "file"},
+		{Name: "app.py", Path: "/mock/app.py", Type: "file"},
+		{Name: ".envrc", Path: "/mock/.envrc", Type: "file"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, &MockDependencyDetector{})
+
+	assert.Empty(t, results)
+}
+
+func TestDetector_Detect_SourceCandidates(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/api/src/user.service.ts": "import { DataSource } from \"typeorm\"\nconst ds = new DataSource({ type: \"mysql\" })\n",
+		"/mock/api/src/db.py":           "engine = create_engine(\"postgresql://localhost/app\")\n",
+		"/mock/api/src/worker.py":       "engine = create_engine(\"mysql://localhost/app\")\n",
+		"/mock/svc/app.py":              "engine = create_engine(\"sqlite:///app.db\")\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "user.service.ts", Path: "/mock/api/src/user.service.ts", Type: "file"},
+		{Name: "db.py", Path: "/mock/api/src/db.py", Type: "file"},
+		{Name: "worker.py", Path: "/mock/api/src/worker.py", Type: "file"},
+	}
+
+	// Only conventional database setup names are parsed outside component roots
+	results := detector.Detect(files, "/mock/api/src", "/mock", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"/api/src/db.py"}, results[0].Path)
+	assert.Equal(t, []string{"sqlalchemy", "postgresql"}, results[0].Techs)
+
+	// Any source file at the root of a Python component is parsed
+	files = []types.File{
+		{Name: "requirements.txt", Path: "/mock/svc/requirements.txt", Type: "file"},
+		{Name: "app.py", Path: "/mock/svc/app.py", Type: "file"},
+	}
+	results = detector.Detect(files, "/mock/svc", "/mock", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"sqlalchemy", "sqlite"}, results[0].Techs)
+}
@@ -1,6 +1,7 @@
 package delphi
 
 import (
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
+// maxSourceSize skips generated Delphi sources larger than 1MB
+const maxSourceSize = 1_000_000
+
 type Detector struct{}
 
 func (d *Detector) Name() string {
@@ -18,23 +22,86 @@ func (d *Detector) Name() string {
 func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
 	var results []*types.Payload
 
-	// Check for .dproj files
+	// Check for .dproj files, remembering which project compiles each source
+	owners := make(map[string]*types.Payload)
 	for _, file := range files {
 		if strings.HasSuffix(strings.ToLower(file.Name), ".dproj") {
-			payload := d.detectDelphiProject(file, currentPath, basePath, provider, depDetector)
+			payload, sources := d.detectDelphiProject(file, files, currentPath, basePath, provider, depDetector)
+			if payload != nil {
+				results = append(results, payload)
+				for _, source := range sources {
+					if _, exists := owners[strings.ToLower(source)]; !exists {
+						owners[strings.ToLower(source)] = payload
+					}
+				}
+			}
+		}
+	}
+
+	// Check for .dpk package sources without a .dproj project
+	for _, file := range files {
+		if strings.EqualFold(filepath.Ext(file.Name), ".dpk") && findSibling(files, file.Name, ".dproj") == "" {
+			payload := d.detectDelphiPackage(file, currentPath, basePath, provider, depDetector)
+			if payload != nil {
+				results = append(results, payload)
+			}
+		}
+	}
+
+	// Check for .groupproj project groups
+	for _, file := range files {
+		if strings.EqualFold(filepath.Ext(file.Name), ".groupproj") {
+			payload := d.detectProjectGroup(file, currentPath, basePath, provider)
 			if payload != nil {
 				results = append(results, payload)
 			}
 		}
 	}
 
-	return results
+	// Units used by a source belong to the project compiling it (MainSource, DCCReference). Sources no project
+	// lists belong to the only component of the directory, otherwise to the enclosing component
+	var defaultOwner *types.Payload
+	if len(results) == 1 {
+		defaultOwner = results[0]
+	}
+	var units []*types.Payload
+	for _, file := range files {
+		if !isDelphiSource(file.Name) {
+			continue
+		}
+		owner, listed := owners[strings.ToLower(file.Name)]
+		if !listed {
+			owner = defaultOwner
+		}
+		if owner != nil {
+			addUnitDependencies(owner, file.Name, currentPath, provider, depDetector)
+			continue
+		}
+		payload := types.NewPayloadWithPath("virtual", components.RelativePath(basePath, currentPath, file.Name))
+		if addUnitDependencies(payload, file.Name, currentPath, provider, depDetector) {
+			units = append(units, payload)
+		}
+	}
+
+	// Components sharing a directory (a project group next to its projects) are returned as children of a
+	// virtual payload, the scanner merges the named components of a directory otherwise
+	if len(results) > 1 {
+		container := types.NewPayloadWithPath("virtual", components.RelativePath(basePath, currentPath, ""))
+		for _, result := range results {
+			container.AddChild(result)
+		}
+		results = []*types.Payload{container}
+	}
+
+	return append(results, units...)
 }
 
-func (d *Detector) detectDelphiProject(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+// detectDelphiProject creates a component for a .dproj project and returns it with the sources the project
+// compiles from its directory
+func (d *Detector) detectDelphiProject(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) (*types.Payload, []string) {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
-		return nil
+		return nil, nil
 	}
 
 	// Parse .dproj file
@@ -42,18 +109,11 @@ func (d *Detector) detectDelphiProject(file types.File, currentPath, basePath st
 	project := delphiParser.ParseDproj(string(content), file.Name)
 
 	if project.Name == "" {
-		return nil
+		return nil, nil
 	}
 
 	// Create component payload
-	relativeFilePath, _ := filepath.Rel(basePath, filepath.Join(currentPath, file.Name))
-	if relativeFilePath == "." {
-		relativeFilePath = "/"
-	} else {
-		relativeFilePath = "/" + relativeFilePath
-	}
-
-	payload := types.NewPayloadWithPath(project.Name, relativeFilePath)
+	payload := types.NewPayloadWithPath(project.Name, components.RelativePath(basePath, currentPath, file.Name))
 
 	// Set tech to delphi
 	payload.AddPrimaryTech("delphi")
@@ -90,9 +150,151 @@ func (d *Detector) detectDelphiProject(file types.File, currentPath, basePath st
 		}
 	}
 
+	// Packages required and units contained by the package source of a package project
+	if dpk := findSibling(files, file.Name, ".dpk"); dpk != "" {
+		if content, err := provider.ReadFile(filepath.Join(currentPath, dpk)); err == nil {
+			pkg := delphiParser.ParseDpk(string(content))
+			addRequiredPackages(payload, pkg.Requires, depDetector)
+			addUnits(payload, pkg.Contains, depDetector)
+		}
+	}
+
+	// Sources in the project directory, sources elsewhere are attributed when their directory is scanned
+	var sources []string
+	for _, source := range project.Sources {
+		if source = path.Clean(source); !strings.Contains(source, "/") {
+			sources = append(sources, source)
+		}
+	}
+
+	return payload, sources
+}
+
+// detectDelphiPackage creates a component for a .dpk package source without a .dproj project
+func (d *Detector) detectDelphiPackage(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	pkg := parsers.NewDelphiParser().ParseDpk(string(content))
+	if pkg.Name == "" {
+		return nil
+	}
+
+	payload := types.NewPayloadWithPath(pkg.Name, components.RelativePath(basePath, currentPath, file.Name))
+	payload.AddPrimaryTech("delphi")
+	payload.AddTech("delphi", "matched file: "+file.Name)
+	addRequiredPackages(payload, pkg.Requires, depDetector)
+	// Units compiled into the package, like those of uses clauses
+	addUnits(payload, pkg.Contains, depDetector)
+
 	return payload
 }
 
+// detectProjectGroup creates a component for a .groupproj project group with an edge to each of its projects
+func (d *Detector) detectProjectGroup(file types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	projects := parsers.NewDelphiParser().ParseGroupProj(string(content))
+	if len(projects) == 0 {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	payload := types.NewPayloadWithPath(strings.TrimSuffix(file.Name, filepath.Ext(file.Name)), relativeFilePath)
+	payload.AddPrimaryTech("delphi")
+	payload.AddTech("delphi", "matched file: "+file.Name)
+
+	// Projects are referenced relative to the group file, their components (indexed by project file) are
+	// resolved once the tree is scanned
+	for _, project := range projects {
+		payload.AddEdgeRef(path.Join(path.Dir(relativeFilePath), project))
+	}
+
+	return payload
+}
+
+// addRequiredPackages adds the packages of a requires clause as delphi dependencies
+func addRequiredPackages(payload *types.Payload, packages []string, depDetector components.DependencyDetector) {
+	var added []string
+	for _, pkg := range packages {
+		if hasDependency(payload, "delphi", pkg) {
+			continue
+		}
+		payload.AddDependency(types.Dependency{Type: "delphi", Name: pkg})
+		added = append(added, pkg)
+	}
+	if len(added) == 0 {
+		return
+	}
+	for tech, reasons := range depDetector.MatchDependencies(added, "delphi") {
+		for _, reason := range reasons {
+			payload.AddTech(tech, reason)
+		}
+	}
+}
+
+// addUnitDependencies matches the units of the uses clauses of a source file against delphi.unit rules.
+// Only units of known libraries are added as dependencies, the project's own units are skipped
+func addUnitDependencies(payload *types.Payload, fileName, currentPath string, provider types.Provider, depDetector components.DependencyDetector) bool {
+	content, err := provider.ReadFile(filepath.Join(currentPath, fileName))
+	if err != nil || len(content) > maxSourceSize {
+		return false
+	}
+
+	return addUnits(payload, parsers.NewDelphiParser().ParseUses(string(content)), depDetector)
+}
+
+// addUnits matches units against delphi.unit rules and adds the matched ones as dependencies
+func addUnits(payload *types.Payload, units []string, depDetector components.DependencyDetector) bool {
+	matched := false
+	for _, unit := range units {
+		techs := depDetector.MatchDependencies([]string{unit}, "delphi.unit")
+		if len(techs) == 0 {
+			continue
+		}
+		matched = true
+		if !hasDependency(payload, "delphi.unit", unit) {
+			payload.AddDependency(types.Dependency{Type: "delphi.unit", Name: unit})
+		}
+		for tech := range techs {
+			payload.AddTech(tech, tech+" matched unit: "+unit)
+		}
+	}
+	return matched
+}
+
+// isDelphiSource reports whether a file is a Delphi program or unit source
+func isDelphiSource(fileName string) bool {
+	extension := strings.ToLower(filepath.Ext(fileName))
+	return extension == ".pas" || extension == ".dpr"
+}
+
+// findSibling returns the file with the same base name as fileName and the given extension, or an empty string
+func findSibling(files []types.File, fileName, extension string) string {
+	base := strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	for _, file := range files {
+		if strings.EqualFold(file.Name, base+extension) {
+			return file.Name
+		}
+	}
+	return ""
+}
+
+// hasDependency reports whether a dependency of the given type and name is already recorded
+func hasDependency(payload *types.Payload, depType, name string) bool {
+	for _, dep := range payload.Dependencies {
+		if dep.Type == depType && strings.EqualFold(dep.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
 func init() {
 	components.Register(&Detector{})
 }
@@ -0,0 +1,198 @@
+package delphi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing, matching names by dependency type
+type MockDependencyDetector struct {
+	techs map[string]map[string]string // dependency type -> name -> tech
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	matched := map[string][]string{}
+	for _, dep := range dependencies {
+		if tech, exists := m.techs[depType][dep]; exists {
+			matched[tech] = append(matched[tech], tech+" matched: "+dep)
+		}
+	}
+	return matched
+}
+
+func newMockDependencyDetector() *MockDependencyDetector {
+	return &MockDependencyDetector{techs: map[string]map[string]string{
+		"delphi":      {"IndyCore": "indy", "FireDAC": "firedac"},
+		"delphi.unit": {"FireDAC.Phys.PG": "postgresql", "FireDAC.Comp.Client": "firedac", "IdHTTP": "indy"},
+	}}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "delphi", detector.Name())
+}
+
+func TestDetector_Detect_ProjectWithPackageAndUnits(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/core/OrdersCore.dproj": `<Project><PropertyGroup><DCC_UsePackage>rtl;FireDAC</DCC_UsePackage></PropertyGroup></Project>`,
+		"/project/core/OrdersCore.dpk":   "package OrdersCore;\nrequires rtl, FireDAC, IndyCore;\ncontains Orders.Data in 'Orders.Data.pas';\nend.\n",
+		"/project/core/Orders.Data.pas":  "unit Orders.Data;\ninterface\nuses System.SysUtils, FireDAC.Comp.Client, FireDAC.Phys.PG;\nimplementation\nend.\n",
+	}}
+	files := []types.File{
+		{Name: "OrdersCore.dpk", Type: "file"},
+		{Name: "OrdersCore.dproj", Type: "file"},
+		{Name: "Orders.Data.pas", Type: "file"},
+	}
+
+	results := (&Detector{}).Detect(files, "/project/core", "/project", provider, newMockDependencyDetector())
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "OrdersCore", payload.Name)
+	assert.Equal(t, []string{"delphi"}, payload.Tech)
+	assert.Contains(t, payload.Techs, "firedac")
+	assert.Contains(t, payload.Techs, "indy")
+	assert.Contains(t, payload.Techs, "postgresql")
+	assert.Contains(t, payload.Reason, "postgresql matched unit: FireDAC.Phys.PG")
+	assert.Equal(t, []types.Dependency{
+		{Type: "delphi", Name: "rtl"},
+		{Type: "delphi", Name: "FireDAC"},
+		{Type: "delphi", Name: "IndyCore"},
+		{Type: "delphi.unit", Name: "FireDAC.Comp.Client"},
+		{Type: "delphi.unit", Name: "FireDAC.Phys.PG"},
+	}, payload.Dependencies)
+}
+
+func TestDetector_Detect_StandalonePackage(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/pkg/NetUtils.dpk": "package NetUtils;\n{$R *.res}\nrequires\n  rtl,\n  IndyCore;\ncontains\n  IdHTTP in '..\\lib\\IdHTTP.pas',\n  NetUtils.Http in 'NetUtils.Http.pas';\nend.\n",
+	}}
+	files := []types.File{{Name: "NetUtils.dpk", Type: "file"}}
+
+	results := (&Detector{}).Detect(files, "/project/pkg", "/project", provider, newMockDependencyDetector())
+	require.Len(t, results, 1)
+	assert.Equal(t, "NetUtils", results[0].Name)
+	assert.Equal(t, []string{"/pkg/NetUtils.dpk"}, results[0].Path)
+	assert.Contains(t, results[0].Techs, "indy")
+	assert.Contains(t, results[0].Reason, "indy matched unit: IdHTTP")
+	assert.Equal(t, []types.Dependency{
+		{Type: "delphi", Name: "rtl"},
+		{Type: "delphi", Name: "IndyCore"},
+		{Type: "delphi.unit", Name: "IdHTTP"},
+	}, results[0].Dependencies)
+}
+
+func TestDetector_Detect_ProjectGroup(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/Orders.groupproj": `<Project><ItemGroup>
+  <Projects Include="Server\OrdersServer.dproj"/>
+  <Projects Include="Client\OrdersClient.dproj"/>
+</ItemGroup></Project>`,
+	}}
+	files := []types.File{{Name: "Orders.groupproj", Type: "file"}, {Name: "Server", Type: "dir"}}
+
+	results := (&Detector{}).Detect(files, "/project", "/project", provider, newMockDependencyDetector())
+	require.Len(t, results, 1)
+	assert.Equal(t, "Orders", results[0].Name)
+	assert.Equal(t, []string{"delphi"}, results[0].Tech)
+	assert.Equal(t, []string{"/Server/OrdersServer.dproj", "/Client/OrdersClient.dproj"}, results[0].EdgeRefs)
+}
+
+func TestDetector_Detect_ProjectGroupWithProjects(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/Orders.groupproj": `<Project><ItemGroup>
+  <Projects Include="OrdersServer.dproj"/>
+  <Projects Include="OrdersClient.dproj"/>
+</ItemGroup></Project>`,
+		"/project/OrdersServer.dproj": `<Project><PropertyGroup><MainSource>OrdersServer.dpr</MainSource></PropertyGroup>
+<ItemGroup><DCCReference Include="Server.Data.pas"/></ItemGroup></Project>`,
+		"/project/OrdersClient.dproj": `<Project><PropertyGroup><MainSource>OrdersClient.dpr</MainSource></PropertyGroup>
+<ItemGroup><DCCReference Include="Client.Http.pas"/></ItemGroup></Project>`,
+		"/project/OrdersServer.dpr": "program OrdersServer;\nuses Server.Data in 'Server.Data.pas';\nbegin\nend.\n",
+		"/project/OrdersClient.dpr": "program OrdersClient;\nuses Client.Http in 'Client.Http.pas';\nbegin\nend.\n",
+		"/project/Server.Data.pas":  "unit Server.Data;\ninterface\nuses FireDAC.Phys.PG;\nimplementation\nend.\n",
+		"/project/Client.Http.pas":  "unit Client.Http;\ninterface\nuses IdHTTP;\nimplementation\nend.\n",
+		"/project/Shared.pas":       "unit Shared;\ninterface\nuses FireDAC.Comp.Client;\nimplementation\nend.\n",
+	}}
+	var files []types.File
+	for _, name := range []string{"Orders.groupproj", "OrdersServer.dproj", "OrdersClient.dproj", "OrdersServer.dpr",
+		"OrdersClient.dpr", "Server.Data.pas", "Client.Http.pas", "Shared.pas"} {
+		files = append(files, types.File{Name: name, Type: "file"})
+	}
+
+	results := (&Detector{}).Detect(files, "/project", "/project", provider, newMockDependencyDetector())
+	require.Len(t, results, 2)
+
+	// The projects and the group stay separate components
+	container := results[0]
+	assert.Equal(t, "virtual", container.Name)
+	components := make(map[string]*types.Payload)
+	for _, child := range container.Childs {
+		components[child.Name] = child
+	}
+	require.Len(t, components, 3)
+
+	assert.Equal(t, []string{"/OrdersServer.dproj", "/OrdersClient.dproj"}, components["Orders"].EdgeRefs)
+	assert.Equal(t, []string{"/OrdersServer.dproj"}, components["OrdersServer"].Path)
+
+	// Units belong to the project listing their source, unlisted sources to the enclosing component
+	assert.Equal(t, []types.Dependency{{Type: "delphi.unit", Name: "FireDAC.Phys.PG"}}, components["OrdersServer"].Dependencies)
+	assert.Equal(t, []types.Dependency{{Type: "delphi.unit", Name: "IdHTTP"}}, components["OrdersClient"].Dependencies)
+	assert.Equal(t, "virtual", results[1].Name)
+	assert.Equal(t, []string{"/Shared.pas"}, results[1].Path)
+	assert.Equal(t, []types.Dependency{{Type: "delphi.unit", Name: "FireDAC.Comp.Client"}}, results[1].Dependencies)
+}
+
+func TestDetector_Detect_UnitsOutsideProject(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/src/Http.Client.pas": "unit Http.Client;\ninterface\nuses IdHTTP, IdSSLOpenSSL;\nimplementation\nend.\n",
+		"/project/src/Model.pas":       "unit Model;\ninterface\nuses System.Classes;\nimplementation\nend.\n",
+	}}
+	files := []types.File{{Name: "Http.Client.pas", Type: "file"}, {Name: "Model.pas", Type: "file"}}
+
+	results := (&Detector{}).Detect(files, "/project/src", "/project", provider, newMockDependencyDetector())
+	require.Len(t, results, 1, "only sources using known libraries are reported")
+	assert.Equal(t, "virtual", results[0].Name)
+	assert.Equal(t, []string{"/src/Http.Client.pas"}, results[0].Path)
+	assert.Equal(t, []types.Dependency{{Type: "delphi.unit", Name: "IdHTTP"}}, results[0].Dependencies)
+}
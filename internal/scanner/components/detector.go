@@ -1,6 +1,11 @@
 package components
 
-import "github.com/petrarca/tech-stack-analyzer/internal/types"
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
 
 // Detector is the interface that all component detectors must implement
 type Detector interface {
@@ -16,3 +21,29 @@ type Detector interface {
 type DependencyDetector interface {
 	MatchDependencies(dependencies []string, depType string) map[string][]string
 }
+
+// terraformCategorizerSource is implemented by dependency detectors carrying the Terraform resource taxonomy
+// of their scan (the embedded taxonomy extended by terraform_categories from .stack-analyzer.yml)
+type terraformCategorizerSource interface {
+	TerraformCategorizer() *parsers.TerraformCategorizer
+}
+
+// TerraformCategorizer returns the Terraform resource categorizer of the scan, or the categorizer of the
+// embedded taxonomy if the dependency detector carries none
+func TerraformCategorizer(depDetector DependencyDetector) *parsers.TerraformCategorizer {
+	if source, ok := depDetector.(terraformCategorizerSource); ok {
+		if categorizer := source.TerraformCategorizer(); categorizer != nil {
+			return categorizer
+		}
+	}
+	return parsers.DefaultTerraformCategorizer()
+}
+
+// RelativePath returns the path of a file relative to the scan root in "/"-prefixed, slash-separated form
+func RelativePath(basePath, currentPath, fileName string) string {
+	relativeFilePath, _ := filepath.Rel(basePath, filepath.Join(currentPath, fileName))
+	if relativeFilePath == "." {
+		return "/"
+	}
+	return "/" + filepath.ToSlash(relativeFilePath)
+}
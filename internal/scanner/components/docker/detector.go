@@ -1,6 +1,7 @@
 package docker
 
 import (
+	"path"
 	"path/filepath"
 	"regexp"
 
@@ -67,6 +68,7 @@ func (d *Detector) detectDockerCompose(file types.File, currentPath, basePath st
 		relativeFilePath = "/" + relativeFilePath
 	}
 	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	environments := dockerParser.ParseComposeEnvironment(string(content))
 
 	// Create child components for each service
 	for _, service := range services {
@@ -126,9 +128,104 @@ func (d *Detector) detectDockerCompose(file types.File, currentPath, basePath st
 		payload.AddChild(childPayload)
 	}
 
+	d.addServiceRefs(payload, services, environments, relativeFilePath)
+	d.addEndpoints(payload, services, dockerParser.ParseComposeEndpoints(string(content)), relativeFilePath)
+
 	return payload
 }
 
+// addEndpoints records the ports of each compose service on its component. Ports of services without component
+// (built from a directory) are recorded on the component of the compose file
+func (d *Detector) addEndpoints(payload *types.Payload, services []parsers.DockerService, endpoints []types.Endpoint, relativeFilePath string) {
+	children := make(map[string]*types.Payload)
+	for _, child := range payload.Childs {
+		children[child.Name] = child
+	}
+	holders := make(map[string]*types.Payload)
+	for _, service := range services {
+		name := service.ContainerName
+		if name == "" {
+			name = service.Name
+		}
+		if child, exists := children[name]; exists && service.Image != "" {
+			holders[service.Name] = child
+		}
+	}
+
+	for _, endpoint := range endpoints {
+		endpoint.File = relativeFilePath
+		holder, exists := holders[endpoint.Service]
+		if !exists {
+			holder = payload
+		}
+		holder.AddEndpoint(endpoint)
+	}
+}
+
+// addServiceRefs records the services referenced by the environment of each compose service (DATABASE_URL=
+// postgres://db:5432/app, REDIS_HOST=redis). References of image services are held by their component, those of
+// services built from a directory by the component of that directory
+func (d *Detector) addServiceRefs(payload *types.Payload, services []parsers.DockerService, environments []parsers.ComposeServiceEnvironment, relativeFilePath string) {
+	children := make(map[string]*types.Payload)
+	for _, child := range payload.Childs {
+		children[child.Name] = child
+	}
+	// Components of services with a container_name are named after it
+	componentNames := make(map[string]string)
+	for _, service := range services {
+		if service.ContainerName != "" {
+			componentNames[service.Name] = service.ContainerName
+		}
+	}
+
+	for _, environment := range environments {
+		var holder *types.Payload
+		names := []string{environment.Name}
+		for _, service := range services {
+			if service.Name != environment.Name {
+				continue
+			}
+			if service.ContainerName != "" {
+				names = append(names, service.ContainerName)
+			}
+			if service.Image != "" {
+				holder = children[names[len(names)-1]]
+			}
+		}
+		from := ""
+		if holder == nil {
+			if environment.Build == "" {
+				continue
+			}
+			holder = payload
+			from = path.Join(path.Dir(filepath.ToSlash(relativeFilePath)), environment.Build)
+		}
+
+		for _, variable := range environment.Environment {
+			for _, ref := range parsers.ParseServiceRefs(variable.Name, variable.Value) {
+				if containsName(names, ref.Host) {
+					continue
+				}
+				if name, exists := componentNames[ref.Host]; exists {
+					ref.Host = name
+				}
+				ref.From = from
+				holder.AddServiceRef(ref)
+			}
+		}
+	}
+}
+
+// containsName reports whether a service is known by the given host name
+func containsName(names []string, host string) bool {
+	for _, name := range names {
+		if name == host {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *Detector) detectDockerfile(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
@@ -154,6 +251,10 @@ func (d *Detector) detectDockerfile(file types.File, currentPath, basePath strin
 
 	// Set the file path in dockerfileInfo
 	dockerfileInfo.File = relativeFilePath
+	for _, endpoint := range dockerfileInfo.Endpoints {
+		endpoint.File = relativeFilePath
+		payload.AddEndpoint(endpoint)
+	}
 
 	// Add base images as dependencies
 	dependencies := make([]types.Dependency, 0, len(dockerfileInfo.BaseImages))
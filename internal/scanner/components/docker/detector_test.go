@@ -409,6 +409,56 @@ services:
 	assert.Equal(t, "my-nginx-server", child.Name, "Should use container_name instead of service name")
 }
 
+func TestDetector_Detect_Endpoints(t *testing.T) {
+	detector := &Detector{}
+
+	provider := &MockDockerProvider{
+		files: map[string]string{
+			"/project/docker-compose.yml": `services:
+  web:
+    image: nginx:1.25
+    container_name: proxy
+    ports:
+      - "443:8443"
+  db:
+    image: postgres:16
+    ports:
+      - "127.0.0.1:5432:5432"
+  api:
+    build: ./api
+    expose:
+      - "9090"
+`,
+			"/project/Dockerfile": "FROM alpine:3.19\nEXPOSE 8080 53/udp\n",
+		},
+	}
+	files := []types.File{
+		{Name: "docker-compose.yml", Path: "/project/docker-compose.yml"},
+		{Name: "Dockerfile", Path: "/project/Dockerfile"},
+	}
+
+	results := detector.Detect(files, "/project", "/project", provider, &MockDependencyDetector{matchedTechs: map[string][]string{}})
+	require.Len(t, results, 2)
+
+	compose := results[0]
+	require.Len(t, compose.Childs, 2)
+	assert.Equal(t, []interface{}{
+		types.Endpoint{Port: 8443, Protocol: "tcp", Exposure: types.EndpointPublic, Source: "compose", File: "/docker-compose.yml", Service: "web", PublishedPort: 443},
+	}, compose.Childs[0].Properties["endpoints"])
+	assert.Equal(t, []interface{}{
+		types.Endpoint{Port: 5432, Protocol: "tcp", Exposure: types.EndpointInternal, Source: "compose", File: "/docker-compose.yml", Service: "db", PublishedPort: 5432},
+	}, compose.Childs[1].Properties["endpoints"])
+	assert.Equal(t, []interface{}{
+		types.Endpoint{Port: 9090, Protocol: "tcp", Exposure: types.EndpointInternal, Source: "compose", File: "/docker-compose.yml", Service: "api"},
+	}, compose.Properties["endpoints"], "Services built from a directory are recorded on the compose file's component")
+
+	dockerfile := results[1]
+	assert.Equal(t, []interface{}{
+		types.Endpoint{Port: 8080, Protocol: "tcp", Exposure: types.EndpointInternal, Source: "dockerfile", File: "/Dockerfile"},
+		types.Endpoint{Port: 53, Protocol: "udp", Exposure: types.EndpointInternal, Source: "dockerfile", File: "/Dockerfile"},
+	}, dockerfile.Properties["endpoints"])
+}
+
 func TestDetector_Detect_RelativePathHandling(t *testing.T) {
 	detector := &Detector{}
 
@@ -23,7 +23,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	dotnetRegex := regexp.MustCompile(`\.(csproj|vbproj|fsproj)$`)
 	for _, file := range files {
 		if dotnetRegex.MatchString(file.Name) {
-			payload := d.detectDotNetProject(file, currentPath, basePath, provider, depDetector)
+			payload := d.detectDotNetProject(file, files, currentPath, basePath, provider, depDetector)
 			if payload != nil {
 				results = append(results, payload)
 			}
@@ -33,7 +33,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	return results
 }
 
-func (d *Detector) detectDotNetProject(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectDotNetProject(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -133,6 +133,14 @@ func (d *Detector) detectDotNetProject(file types.File, currentPath, basePath st
 	// Set dependencies on parent
 	payload.Dependencies = dependencies
 
+	// Target framework of the project, SDK pinned by global.json
+	var declared map[string]parsers.RuntimeVersion
+	if project.Framework != "" {
+		declared = map[string]parsers.RuntimeVersion{"dotnet": {Version: project.Framework, Source: file.Name + "#TargetFramework"}}
+	}
+	components.AddRuntimeVersions(payload, declared, files, currentPath, provider, "dotnet", "dotnet-sdk")
+	components.AddAppConfig(payload, files, currentPath, basePath, provider)
+
 	return payload
 }
 
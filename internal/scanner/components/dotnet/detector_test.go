@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -476,3 +477,48 @@ func TestDetector_Detect_NoMatchingDependencies(t *testing.T) {
 	assert.Len(t, payload.Dependencies, 2, "Should have 2 dependencies")
 	assert.Empty(t, payload.Childs, "Should have no child components when no matches")
 }
+
+func TestDetector_Detect_RuntimeVersions(t *testing.T) {
+	detector := &Detector{}
+
+	provider := &MockProvider{
+		files: map[string]string{
+			"/project/Api.csproj":  `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`,
+			"/project/global.json": `{"sdk": {"version": "8.0.204"}}`,
+		},
+	}
+	files := []types.File{{Name: "Api.csproj"}, {Name: "global.json"}}
+
+	results := detector.Detect(files, "/project", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	// The SDK pinned by global.json does not replace the target framework
+	assert.Equal(t, map[string]interface{}{
+		"dotnet":     parsers.RuntimeVersion{Version: "net8.0", Source: "Api.csproj#TargetFramework"},
+		"dotnet-sdk": parsers.RuntimeVersion{Version: "8.0.204", Source: "global.json"},
+	}, results[0].Properties["runtime"])
+}
+
+func TestDetector_Detect_AppSettings(t *testing.T) {
+	detector := &Detector{}
+
+	provider := &MockProvider{
+		files: map[string]string{
+			"/project/Api.csproj":                   `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`,
+			"/project/appsettings.json":             `{"ConnectionStrings": {"Default": "Host=db;Database=api;Username=api;Password=secret"}}`,
+			"/project/appsettings.Development.json": `{"Redis": {"Configuration": "localhost"}}`,
+		},
+	}
+	files := []types.File{{Name: "Api.csproj"}, {Name: "appsettings.json"}, {Name: "appsettings.Development.json"}}
+
+	results := detector.Detect(files, "/project", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	assert.Contains(t, results[0].Techs, "postgresql")
+	assert.Contains(t, results[0].Techs, "redis")
+	assert.Contains(t, results[0].Reason, "config ConnectionStrings:Default: appsettings.json")
+
+	configs := results[0].Properties["app_config"].([]interface{})
+	require.Len(t, configs, 2)
+	assert.Equal(t, []string{"Development"}, configs[1].(*parsers.AppConfig).Environments)
+}
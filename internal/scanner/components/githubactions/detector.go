@@ -0,0 +1,147 @@
+package githubactions
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "githubactions"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	// Workflows live in .github/workflows, composite and docker actions in .github/actions/<name>
+	location := githubLocation(currentPath, basePath)
+	if location == "" {
+		return nil
+	}
+
+	var results []*types.Payload
+	for _, file := range files {
+		ext := filepath.Ext(file.Name)
+		if ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+		if location == "actions" && strings.TrimSuffix(file.Name, ext) != "action" {
+			continue
+		}
+
+		payload := d.detectWorkflow(file, location, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectWorkflow(file types.File, location, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	githubParser := parsers.NewGitHubActionsParser()
+	var workflow *parsers.GitHubActionsWorkflow
+	if location == "workflows" {
+		workflow = githubParser.ParseWorkflow(string(content))
+	} else {
+		workflow = githubParser.ParseAction(string(content))
+	}
+	if workflow == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	workflow.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("github.actions", "matched file: "+file.Name)
+	payload.Properties["github_actions"] = []interface{}{workflow}
+
+	var actionNames, imageNames []string
+	for _, action := range workflow.Actions {
+		switch action.Kind {
+		case "action":
+			version := action.Ref
+			if version == "" {
+				version = "latest"
+			}
+			payload.AddDependency(types.Dependency{
+				Type:    "githubAction",
+				Name:    action.Name,
+				Example: version,
+			})
+			actionNames = append(actionNames, action.Name)
+		case "docker":
+			payload.AddDependency(types.Dependency{
+				Type:    "docker",
+				Name:    action.Name,
+				Example: action.Ref,
+			})
+			imageNames = append(imageNames, action.Name)
+		}
+	}
+	components.AddMatchedTechs(payload, actionNames, "githubAction", depDetector)
+
+	// Reusable workflows of other repositories are shared pipeline dependencies
+	var workflowNames []string
+	for _, reusable := range workflow.ReusableWorkflows {
+		if reusable.Kind != "action" {
+			continue
+		}
+		payload.AddDependency(types.Dependency{
+			Type:    "githubWorkflow",
+			Name:    reusable.Name,
+			Example: reusable.Ref,
+		})
+		workflowNames = append(workflowNames, reusable.Name)
+	}
+	components.AddMatchedTechs(payload, workflowNames, "githubWorkflow", depDetector)
+
+	// Job containers and service containers are routed to the docker rules
+	for _, image := range workflow.Images() {
+		// Skip images set through expressions
+		if strings.HasPrefix(image, "${{") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Tools invoked by run steps are matched against the command rules
+	components.AddCommandDependencies(payload, workflow.Scripts, depDetector)
+
+	return payload
+}
+
+// githubLocation returns "workflows" or "actions" when a directory is below .github/workflows or .github/actions
+func githubLocation(currentPath, basePath string) string {
+	relativePath, err := filepath.Rel(basePath, currentPath)
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(filepath.ToSlash(relativePath), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == ".github" && (segments[i+1] == "workflows" || segments[i+1] == "actions") {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+func init() {
+	components.Register(&Detector{})
+}
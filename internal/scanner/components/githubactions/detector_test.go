@@ -0,0 +1,157 @@
+package githubactions
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var githubRules = &MockDependencyDetector{prefixes: map[string]string{
+	"aws-actions/":    "aws",
+	"hashicorp/setup": "terraform",
+	"postgres":        "postgresql",
+	"psql":            "postgresql",
+	"octo-org/shared": "shared.pipelines",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "githubactions", detector.Name())
+}
+
+func TestDetector_Detect_AllWorkflows(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.github/workflows/ci.yml": `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    services:
+      db:
+        image: postgres:16
+    steps:
+      - uses: actions/checkout@v4
+      - uses: aws-actions/configure-aws-credentials@e3dd6a429d7300a6a4c196c26e071d42e0343502
+      - run: psql "$DATABASE_URL" -f seed.sql
+`,
+		"/mock/.github/workflows/deploy.yaml": `on: workflow_dispatch
+jobs:
+  deploy:
+    uses: octo-org/shared/.github/workflows/deploy.yml@v1
+  local:
+    uses: ./.github/workflows/release.yml
+`,
+		"/mock/.github/workflows/README.md": "# Workflows\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "ci.yml"}, {Name: "deploy.yaml"}, {Name: "README.md"}}
+	results := detector.Detect(files, "/mock/.github/workflows", "/mock", provider, githubRules)
+
+	require.Len(t, results, 2)
+	for _, payload := range results {
+		assert.Equal(t, "virtual", payload.Name)
+		assert.Contains(t, payload.Techs, "github.actions")
+	}
+
+	ci := results[0]
+	assert.Contains(t, ci.Techs, "aws")
+	assert.Contains(t, ci.Techs, "postgresql")
+	assert.Equal(t, []types.Dependency{
+		{Type: "githubAction", Name: "actions/checkout", Example: "v4"},
+		{Type: "githubAction", Name: "aws-actions/configure-aws-credentials", Example: "e3dd6a429d7300a6a4c196c26e071d42e0343502"},
+		{Type: "docker", Name: "postgres", Example: "16"},
+		{Type: "command", Name: "psql"},
+	}, ci.Dependencies)
+
+	workflow := ci.Properties["github_actions"].([]interface{})[0].(*parsers.GitHubActionsWorkflow)
+	assert.Equal(t, "/.github/workflows/ci.yml", workflow.File)
+	assert.Equal(t, []string{"ubuntu-latest"}, workflow.Runners)
+	assert.False(t, workflow.Actions[0].Pinned)
+	assert.True(t, workflow.Actions[1].Pinned)
+
+	deploy := results[1]
+	assert.Contains(t, deploy.Techs, "shared.pipelines")
+	assert.Equal(t, []types.Dependency{
+		{Type: "githubWorkflow", Name: "octo-org/shared/.github/workflows/deploy.yml", Example: "v1"},
+	}, deploy.Dependencies)
+}
+
+func TestDetector_Detect_CompositeAction(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.github/actions/setup/action.yml": "runs:\n  using: composite\n  steps:\n    - uses: hashicorp/setup-terraform@v3\n",
+		"/mock/.github/actions/setup/config.yml": "runs:\n  using: composite\n",
+		"/mock/config/action.yml":                "runs:\n  using: composite\n",
+	}}
+
+	detector := &Detector{}
+
+	results := detector.Detect([]types.File{{Name: "action.yml"}, {Name: "config.yml"}}, "/mock/.github/actions/setup", "/mock", provider, githubRules)
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Techs, "terraform")
+	action := results[0].Properties["github_actions"].([]interface{})[0].(*parsers.GitHubActionsWorkflow)
+	assert.Equal(t, "action", action.Kind)
+	assert.Equal(t, "composite", action.Using)
+
+	// action.yml outside .github/actions is not picked up
+	results = detector.Detect([]types.File{{Name: "action.yml"}}, "/mock/config", "/mock", provider, githubRules)
+	assert.Empty(t, results)
+}
@@ -0,0 +1,113 @@
+package gitlabci
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "gitlabci"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	// Files included with include:local usually live below .gitlab/ (e.g. .gitlab/ci/build.yml)
+	inGitLabDir := isInGitLabDir(currentPath, basePath)
+
+	for _, file := range files {
+		isPipelineFile := file.Name == ".gitlab-ci.yml" || file.Name == ".gitlab-ci.yaml"
+		ext := filepath.Ext(file.Name)
+		if !isPipelineFile && !(inGitLabDir && (ext == ".yml" || ext == ".yaml")) {
+			continue
+		}
+
+		payload := d.detectPipeline(file, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectPipeline(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	gitlabParser := parsers.NewGitLabCIParser()
+	pipeline := gitlabParser.ParseGitLabCI(string(content))
+	if pipeline == nil || pipeline.IsEmpty() {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	pipeline.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("gitlab.ci", "matched file: "+file.Name)
+	payload.Properties["gitlab_ci"] = []interface{}{pipeline}
+
+	// Job images and services are routed to the docker rules
+	var imageNames []string
+	for _, image := range pipeline.Images() {
+		// Skip images set through CI/CD variables
+		if strings.HasPrefix(image, "$") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Templates, components and project includes are shared pipeline dependencies
+	var includeNames []string
+	for _, include := range pipeline.Includes {
+		if include.Type == "local" {
+			continue
+		}
+		payload.AddDependency(types.Dependency{
+			Type:    "gitlabInclude",
+			Name:    include.Name,
+			Example: include.Ref,
+		})
+		includeNames = append(includeNames, include.Name)
+	}
+	components.AddMatchedTechs(payload, includeNames, "gitlabInclude", depDetector)
+
+	// Tools invoked by script lines are matched against the command rules
+	components.AddCommandDependencies(payload, pipeline.Scripts, depDetector)
+
+	return payload
+}
+
+// isInGitLabDir reports whether a directory is .gitlab or below it
+func isInGitLabDir(currentPath, basePath string) bool {
+	relativePath, err := filepath.Rel(basePath, currentPath)
+	if err != nil {
+		return false
+	}
+	for _, segment := range strings.Split(filepath.ToSlash(relativePath), "/") {
+		if segment == ".gitlab" {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,146 @@
+package gitlabci
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var gitlabRules = &MockDependencyDetector{prefixes: map[string]string{
+	"postgres":              "postgresql",
+	"redis":                 "redis",
+	"Security/SAST":         "gitlab.sast",
+	"platform/ci-templates": "ci.templates",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "gitlabci", detector.Name())
+}
+
+func TestDetector_Detect_Pipeline(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.gitlab-ci.yml": `include:
+  - local: .gitlab/ci/deploy.yml
+  - template: Security/SAST.gitlab-ci.yml
+  - project: platform/ci-templates
+    ref: v2
+    file: /docker.yml
+test:
+  image: node:20
+  services:
+    - postgres:16
+    - redis
+  script: npm test
+release:
+  image: $RELEASE_IMAGE
+  script: ./release.sh
+`,
+		"/mock/docker-compose.yml": "services:\n  web:\n    image: nginx\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: ".gitlab-ci.yml", Path: "/mock/.gitlab-ci.yml"},
+		{Name: "docker-compose.yml", Path: "/mock/docker-compose.yml"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, gitlabRules)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "gitlab.ci")
+	assert.Contains(t, payload.Techs, "postgresql")
+	assert.Contains(t, payload.Techs, "redis")
+	assert.Contains(t, payload.Techs, "gitlab.sast")
+	assert.Contains(t, payload.Techs, "ci.templates")
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "docker", Name: "node", Example: "20"},
+		{Type: "docker", Name: "postgres", Example: "16"},
+		{Type: "docker", Name: "redis", Example: "latest"},
+		{Type: "gitlabInclude", Name: "Security/SAST.gitlab-ci.yml"},
+		{Type: "gitlabInclude", Name: "platform/ci-templates", Example: "v2"},
+	}, payload.Dependencies)
+
+	properties, ok := payload.Properties["gitlab_ci"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, properties, 1)
+}
+
+func TestDetector_Detect_GitLabDirectory(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/.gitlab/ci/deploy.yml": "deploy:\n  image: alpine:3.20\n  script: ./deploy.sh\n",
+		"/mock/.gitlab/ci/empty.yml":  "variables:\n  A: b\n",
+		"/mock/config/settings.yml":   "deploy:\n  image: alpine\n",
+	}}
+
+	detector := &Detector{}
+
+	results := detector.Detect([]types.File{{Name: "deploy.yml"}, {Name: "empty.yml"}}, "/mock/.gitlab/ci", "/mock", provider, gitlabRules)
+	require.Len(t, results, 1)
+	assert.Equal(t, "/.gitlab/ci/deploy.yml", results[0].Path[0])
+	assert.Equal(t, []types.Dependency{{Type: "docker", Name: "alpine", Example: "3.20"}}, results[0].Dependencies)
+
+	// YAML files outside .gitlab are not pipeline files
+	results = detector.Detect([]types.File{{Name: "settings.yml"}}, "/mock/config", "/mock", provider, gitlabRules)
+	assert.Empty(t, results)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
@@ -21,7 +22,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	// Check for go.mod (component - creates named payload)
 	for _, file := range files {
 		if file.Name == "go.mod" {
-			payload := d.detectGoMod(file, currentPath, basePath, provider, depDetector)
+			payload := d.detectGoMod(file, files, currentPath, basePath, provider, depDetector)
 			if payload != nil {
 				results = append(results, payload)
 			}
@@ -49,7 +50,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	return results
 }
 
-func (d *Detector) detectGoMod(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectGoMod(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -126,9 +127,46 @@ func (d *Detector) detectGoMod(file types.File, currentPath, basePath string, pr
 		}
 	}
 
+	// Go version from the go directive and .tool-versions
+	runtimeParser := parsers.NewRuntimeParser()
+	components.AddRuntimeVersions(payload, runtimeParser.ParseGoModRuntime(string(content)), files, currentPath, provider, "go")
+
+	// Exact versions of the modules copied by go mod vendor
+	addVendoredModules(payload, files, currentPath, basePath, provider)
+
 	return payload
 }
 
+// addVendoredModules records the modules listed in vendor/modules.txt next to go.mod
+func addVendoredModules(payload *types.Payload, files []types.File, currentPath, basePath string, provider types.Provider) {
+	hasVendor := false
+	for _, file := range files {
+		if file.Name == "vendor" && file.Type == "dir" {
+			hasVendor = true
+			break
+		}
+	}
+	if !hasVendor {
+		return
+	}
+
+	modulesFile := filepath.Join(currentPath, "vendor", "modules.txt")
+	content, err := provider.ReadFile(modulesFile)
+	if err != nil {
+		return
+	}
+	relativeFilePath := components.RelativePath(basePath, currentPath, "vendor/modules.txt")
+
+	var vendored []interface{}
+	for _, module := range parsers.NewVendorParser().ParseGoVendorModules(string(content)) {
+		module.File = relativeFilePath
+		vendored = append(vendored, module)
+	}
+	if len(vendored) > 0 {
+		payload.MergeProperties(map[string]interface{}{"vendored": vendored})
+	}
+}
+
 func init() {
 	components.Register(&Detector{})
 }
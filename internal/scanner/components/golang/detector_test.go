@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -125,6 +126,51 @@ require (
 	assert.True(t, depNames["gorm.io/gorm@v1.25.4"], "Should have gorm dependency")
 }
 
+func TestDetector_Detect_GoModRuntime(t *testing.T) {
+	detector := &Detector{}
+	depDetector := &MockDependencyDetector{}
+	files := []types.File{{Name: "go.mod"}, {Name: ".tool-versions"}}
+
+	provider := &MockProvider{files: map[string]string{
+		"/project/go.mod":         "module example.com/app\n\ngo 1.22\n",
+		"/project/.tool-versions": "golang 1.22.4\n",
+	}}
+	results := detector.Detect(files, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.Equal(t, map[string]interface{}{
+		"go": parsers.RuntimeVersion{Version: "1.22.4", Source: ".tool-versions"},
+	}, results[0].Properties["runtime"], "Pinned toolchain wins over the go directive")
+
+	provider.files["/project/.tool-versions"] = "nodejs 20.11.0\n"
+	results = detector.Detect(files, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.Equal(t, map[string]interface{}{
+		"go": parsers.RuntimeVersion{Version: "1.22", Source: "go.mod#go"},
+	}, results[0].Properties["runtime"])
+}
+
+func TestDetector_Detect_VendoredModules(t *testing.T) {
+	detector := &Detector{}
+	depDetector := &MockDependencyDetector{}
+	files := []types.File{{Name: "go.mod", Type: "file"}, {Name: "vendor", Type: "dir"}}
+
+	provider := &MockProvider{files: map[string]string{
+		"/project/go.mod":             "module example.com/app\n\ngo 1.22\n\nrequire (\n\tgithub.com/redis/go-redis/v9 v9.5.1\n)\n",
+		"/project/vendor/modules.txt": "# github.com/redis/go-redis/v9 v9.5.1\n## explicit; go 1.18\ngithub.com/redis/go-redis/v9\n# github.com/cespare/xxhash/v2 v2.2.0\ngithub.com/cespare/xxhash/v2\n",
+	}}
+	results := detector.Detect(files, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.Equal(t, []interface{}{
+		parsers.VendoredDependency{File: "/vendor/modules.txt", Type: "golang", Name: "github.com/redis/go-redis/v9", Version: "v9.5.1"},
+		parsers.VendoredDependency{File: "/vendor/modules.txt", Type: "golang", Name: "github.com/cespare/xxhash/v2", Version: "v2.2.0", Indirect: true},
+	}, results[0].Properties["vendored"])
+
+	// Without a vendor directory, modules.txt is not read
+	results = detector.Detect(files[:1], "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.NotContains(t, results[0].Properties, "vendored")
+}
+
 func TestDetector_Detect_MainGo(t *testing.T) {
 	detector := &Detector{}
 
@@ -24,7 +24,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	// Check for pom.xml (Maven) first
 	for _, file := range files {
 		if file.Name == "pom.xml" {
-			payload = d.detectPomXML(file, currentPath, basePath, provider, depDetector)
+			payload = d.detectPomXML(file, files, currentPath, basePath, provider, depDetector)
 			break
 		}
 	}
@@ -34,7 +34,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 		gradleRegex := regexp.MustCompile(`^build\.gradle(\.kts)?$`)
 		for _, file := range files {
 			if gradleRegex.MatchString(file.Name) {
-				payload = d.detectGradle(file, currentPath, basePath, provider, depDetector)
+				payload = d.detectGradle(file, files, currentPath, basePath, provider, depDetector)
 				break
 			}
 		}
@@ -55,13 +55,15 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	}
 
 	if payload != nil {
+		// Spring configuration next to the build file (src/main/resources is handled by the appconfig detector)
+		components.AddAppConfig(payload, files, currentPath, basePath, provider)
 		results = append(results, payload)
 	}
 
 	return results
 }
 
-func (d *Detector) detectPomXML(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectPomXML(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -110,10 +112,14 @@ func (d *Detector) detectPomXML(file types.File, currentPath, basePath string, p
 		payload.Dependencies = dependencies
 	}
 
+	// Java release from the compiler properties and .tool-versions
+	runtimeParser := parsers.NewRuntimeParser()
+	components.AddRuntimeVersions(payload, runtimeParser.ParsePomRuntime(string(content)), files, currentPath, provider, "java")
+
 	return payload
 }
 
-func (d *Detector) detectGradle(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectGradle(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -162,6 +168,10 @@ func (d *Detector) detectGradle(file types.File, currentPath, basePath string, p
 		payload.Dependencies = dependencies
 	}
 
+	// Java version from the toolchain or source compatibility and .tool-versions
+	runtimeParser := parsers.NewRuntimeParser()
+	components.AddRuntimeVersions(payload, runtimeParser.ParseGradleRuntime(file.Name, string(content)), files, currentPath, provider, "java")
+
 	return payload
 }
 
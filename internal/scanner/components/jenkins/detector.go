@@ -0,0 +1,108 @@
+package jenkins
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "jenkins"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if !isJenkinsfile(file.Name) {
+			continue
+		}
+
+		payload := d.detectPipeline(file, currentPath, basePath, provider, depDetector)
+		if payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectPipeline(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	jenkinsParser := parsers.NewJenkinsfileParser()
+	pipeline := jenkinsParser.ParseJenkinsfile(string(content))
+	if pipeline.IsEmpty() {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	pipeline.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+	payload.AddTech("jenkins", "matched file: "+file.Name)
+	payload.Properties["jenkins"] = []interface{}{pipeline}
+
+	// Shared libraries are referenced as name@version
+	libraryNames := make([]string, 0, len(pipeline.Libraries))
+	for _, library := range pipeline.Libraries {
+		payload.AddDependency(types.Dependency{
+			Type:    "jenkinsLibrary",
+			Name:    library.Name,
+			Example: library.Version,
+		})
+		libraryNames = append(libraryNames, library.Name)
+	}
+	components.AddMatchedTechs(payload, libraryNames, "jenkinsLibrary", depDetector)
+
+	// Tools are matched by type (maven, gradle, nodejs), the installation name is site specific
+	toolTypes := make([]string, 0, len(pipeline.Tools))
+	for _, tool := range pipeline.Tools {
+		payload.AddDependency(types.Dependency{
+			Type:    "jenkinsTool",
+			Name:    tool.Type,
+			Example: tool.Name,
+		})
+		toolTypes = append(toolTypes, tool.Type)
+	}
+	components.AddMatchedTechs(payload, toolTypes, "jenkinsTool", depDetector)
+
+	// Docker agent images are routed to the docker rules
+	var imageNames []string
+	for _, image := range pipeline.Images {
+		// Skip images set through Groovy interpolation
+		if strings.Contains(image, "$") {
+			continue
+		}
+		name, version := parsers.SplitImageReference(image)
+		payload.AddDependency(types.Dependency{
+			Type:    "docker",
+			Name:    name,
+			Example: version,
+		})
+		imageNames = append(imageNames, name)
+	}
+	components.AddMatchedTechs(payload, imageNames, "docker", depDetector)
+
+	// Tools invoked by sh steps are matched against the command rules
+	components.AddCommandDependencies(payload, pipeline.Scripts, depDetector)
+
+	return payload
+}
+
+// isJenkinsfile reports whether a file is a Jenkinsfile (Jenkinsfile, Jenkinsfile.release, deploy.jenkinsfile)
+func isJenkinsfile(name string) bool {
+	return name == "Jenkinsfile" || strings.HasPrefix(name, "Jenkinsfile.") || strings.HasSuffix(strings.ToLower(name), ".jenkinsfile")
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,124 @@
+package jenkins
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by prefix
+type MockDependencyDetector struct {
+	prefixes map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		for prefix, tech := range m.prefixes {
+			if strings.HasPrefix(dep, prefix) {
+				result[tech] = append(result[tech], "matched: "+dep)
+			}
+		}
+	}
+	return result
+}
+
+var jenkinsRules = &MockDependencyDetector{prefixes: map[string]string{
+	"maven": "maven",
+	"redis": "redis",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "jenkins", detector.Name())
+}
+
+func TestDetector_Detect_Jenkinsfile(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/Jenkinsfile": `@Library('pipeline-utils@v2') _
+pipeline {
+    agent { docker { image "maven:3.9" } }
+    tools { maven 'Maven 3.9' }
+    stages {
+        stage('Test') {
+            steps {
+                script {
+                    docker.image('redis:7').withRun { c -> sh 'mvn verify' }
+                    docker.image("app:${env.BUILD_ID}").push()
+                }
+            }
+        }
+    }
+}
+`,
+		"/mock/deploy.jenkinsfile": "println 'nothing to see'\n",
+		"/mock/build.groovy":       "pipeline { }\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "Jenkinsfile", Path: "/mock/Jenkinsfile"},
+		{Name: "deploy.jenkinsfile", Path: "/mock/deploy.jenkinsfile"},
+		{Name: "build.groovy", Path: "/mock/build.groovy"},
+	}
+	results := detector.Detect(files, "/mock", "/mock", provider, jenkinsRules)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "jenkins")
+	assert.Contains(t, payload.Techs, "maven")
+	assert.Contains(t, payload.Techs, "redis")
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "jenkinsLibrary", Name: "pipeline-utils", Example: "v2"},
+		{Type: "jenkinsTool", Name: "maven", Example: "Maven 3.9"},
+		{Type: "docker", Name: "maven", Example: "3.9"},
+		{Type: "docker", Name: "redis", Example: "7"},
+	}, payload.Dependencies)
+
+	properties, ok := payload.Properties["jenkins"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, properties, 1)
+}
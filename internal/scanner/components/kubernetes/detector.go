@@ -0,0 +1,54 @@
+package kubernetes
+
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// maxManifestSize skips generated manifests larger than 1MB
+const maxManifestSize = 1_000_000
+
+// Detector records the container ports and Service ports declared by Kubernetes manifests as endpoints of the
+// component owning the manifest directory
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "kubernetes"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	kubernetesParser := parsers.NewKubernetesParser()
+
+	var results []*types.Payload
+	for _, file := range files {
+		extension := filepath.Ext(file.Name)
+		if file.Type == "dir" || (extension != ".yml" && extension != ".yaml") {
+			continue
+		}
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil || len(content) > maxManifestSize || !parsers.IsKubernetesManifest(string(content)) {
+			continue
+		}
+		endpoints := kubernetesParser.ParseEndpoints(string(content))
+		if len(endpoints) == 0 {
+			continue
+		}
+
+		relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+		payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+		for _, endpoint := range endpoints {
+			endpoint.File = relativeFilePath
+			payload.AddEndpoint(endpoint)
+		}
+		results = append(results, payload)
+	}
+
+	return results
+}
+
+func init() {
+	components.Register(&Detector{})
+}
@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "kubernetes", detector.Name())
+}
+
+func TestDetector_Detect_Manifests(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/deploy/orders.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: orders
+spec:
+  type: LoadBalancer
+  ports:
+    - port: 443
+      targetPort: 8080
+`,
+		"/mock/deploy/kustomization.yaml": "resources:\n  - orders.yaml\n",
+		"/mock/deploy/values.yml":         "service:\n  port: 8080\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "orders.yaml", Path: "/mock/deploy/orders.yaml", Type: "file"},
+		{Name: "kustomization.yaml", Path: "/mock/deploy/kustomization.yaml", Type: "file"},
+		{Name: "values.yml", Path: "/mock/deploy/values.yml", Type: "file"},
+	}
+	results := detector.Detect(files, "/mock/deploy", "/mock", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, []string{"/deploy/orders.yaml"}, payload.Path)
+	assert.Equal(t, []interface{}{
+		types.Endpoint{Port: 8080, Protocol: "tcp", Exposure: types.EndpointPublic, Source: "kubernetes", File: "/deploy/orders.yaml", Service: "orders", PublishedPort: 443},
+	}, payload.Properties["endpoints"])
+}
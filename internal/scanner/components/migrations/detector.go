@@ -0,0 +1,364 @@
+package migrations
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "migrations"
+}
+
+// toolTechs maps migration tools to the tech added to the owning component
+var toolTechs = map[string]string{
+	parsers.MigrationToolFlyway:        "flyway",
+	parsers.MigrationToolLiquibase:     "liquibase",
+	parsers.MigrationToolAlembic:       "alembic",
+	parsers.MigrationToolPrisma:        "prisma",
+	parsers.MigrationToolRails:         "rails",
+	parsers.MigrationToolEFCore:        "entityframework",
+	parsers.MigrationToolGolangMigrate: "golang-migrate",
+}
+
+// liquibaseExtensions lists the changelog formats supported by Liquibase
+var liquibaseExtensions = map[string]bool{
+	".xml":  true,
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+	".sql":  true,
+}
+
+// maxMigrationSize skips reading migrations larger than 1MB (typically data dumps)
+const maxMigrationSize = 1_000_000
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	migrationParser := parsers.NewMigrationParser()
+
+	// Sort by name so that the latest migration and changelog order do not depend on the provider
+	sorted := make([]types.File, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	sets := []*parsers.MigrationSet{
+		d.detectFlyway(migrationParser, sorted, currentPath, provider),
+		d.detectGolangMigrate(migrationParser, sorted, currentPath, provider),
+		d.detectLiquibase(migrationParser, sorted, currentPath, provider),
+		d.detectAlembic(migrationParser, sorted, currentPath, provider),
+		d.detectPrisma(migrationParser, sorted, currentPath, provider),
+		d.detectRails(migrationParser, sorted, currentPath, provider),
+		d.detectEFCore(migrationParser, sorted, currentPath, provider),
+	}
+
+	var results []*types.Payload
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+
+		relativeDir := components.RelativePath(basePath, currentPath, "")
+		set.Directory = relativeDir
+		set.Database = parsers.DatabaseTech(set.Dialect)
+
+		payload := types.NewPayloadWithPath("virtual", relativeDir)
+		payload.AddTech(toolTechs[set.Tool], "migration directory: "+relativeDir)
+		if set.Database != "" {
+			payload.AddTech(set.Database, "migration dialect: "+set.Dialect)
+		}
+
+		// Add the migration set to properties as array (Properties already initialized by NewPayloadWithPath)
+		payload.Properties["migrations"] = []interface{}{set}
+		results = append(results, payload)
+	}
+
+	return results
+}
+
+// detectFlyway collects versioned Flyway migrations (V<version>__<description>.sql)
+func (d *Detector) detectFlyway(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolFlyway}
+	var latestVersion []int
+	var contents []string
+
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+		version, ok := migrationParser.FlywayVersion(file.Name)
+		if !ok {
+			continue
+		}
+		set.Count++
+		if set.Latest == "" || parsers.CompareVersionParts(version, latestVersion) > 0 {
+			set.Latest = strings.TrimSuffix(file.Name, ".sql")
+			latestVersion = version
+		}
+		if content, ok := readMigration(provider, currentPath, file.Name); ok {
+			contents = append(contents, content)
+		}
+	}
+
+	if set.Count == 0 {
+		return nil
+	}
+	set.Dialect = migrationParser.InferSQLDialect(contents...)
+	return set
+}
+
+// detectGolangMigrate collects golang-migrate migrations (<version>_<title>.up.sql and .down.sql pairs)
+func (d *Detector) detectGolangMigrate(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolGolangMigrate}
+	versions := make(map[uint64]bool)
+	var latestVersion uint64
+	var contents []string
+
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+		version, direction, ok := migrationParser.GolangMigrateVersion(file.Name)
+		if !ok {
+			continue
+		}
+		if !versions[version] {
+			versions[version] = true
+			if set.Latest == "" || version > latestVersion {
+				set.Latest = strings.TrimSuffix(file.Name, "."+direction+".sql")
+				latestVersion = version
+			}
+		}
+		if direction != "up" {
+			continue
+		}
+		if content, ok := readMigration(provider, currentPath, file.Name); ok {
+			contents = append(contents, content)
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+	set.Count = len(versions)
+	set.Dialect = migrationParser.InferSQLDialect(contents...)
+	return set
+}
+
+// detectLiquibase collects changeSets of the Liquibase changelogs in changelog directories
+func (d *Detector) detectLiquibase(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	lowerPath := strings.ToLower(filepath.ToSlash(currentPath))
+	inChangelogDir := strings.Contains(lowerPath, "changelog") || strings.Contains(lowerPath, "liquibase")
+
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolLiquibase}
+	dialects := make(map[string]bool)
+	var sqlContents []string
+
+	for _, file := range files {
+		extension := strings.ToLower(filepath.Ext(file.Name))
+		if file.Type == "dir" || !liquibaseExtensions[extension] {
+			continue
+		}
+		if !inChangelogDir && !strings.Contains(strings.ToLower(file.Name), "changelog") {
+			continue
+		}
+		content, ok := readMigration(provider, currentPath, file.Name)
+		if !ok || !migrationParser.IsLiquibaseChangelog(content) {
+			continue
+		}
+
+		ids, dialect := migrationParser.ParseLiquibaseChangelog(file.Name, content)
+		if len(ids) == 0 {
+			continue // Master changelogs only include other changelogs
+		}
+		set.Count += len(ids)
+		set.Latest = ids[len(ids)-1]
+		if dialect != "" {
+			dialects[dialect] = true
+		}
+		if extension == ".sql" {
+			sqlContents = append(sqlContents, content)
+		}
+	}
+
+	if set.Count == 0 {
+		return nil
+	}
+	if len(dialects) == 1 {
+		for dialect := range dialects {
+			set.Dialect = dialect
+		}
+	} else if len(dialects) == 0 {
+		set.Dialect = migrationParser.InferSQLDialect(sqlContents...)
+	}
+	return set
+}
+
+// detectAlembic collects Alembic revisions of a versions directory, the latest being the head revision
+func (d *Detector) detectAlembic(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	if filepath.Base(currentPath) != "versions" {
+		return nil
+	}
+
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolAlembic}
+	var revisions []string
+	downRevisions := make(map[string]bool)
+
+	for _, file := range files {
+		if file.Type == "dir" || filepath.Ext(file.Name) != ".py" {
+			continue
+		}
+		content, ok := readMigration(provider, currentPath, file.Name)
+		if !ok {
+			continue
+		}
+		revision, down, ok := migrationParser.ParseAlembicRevision(content)
+		if !ok {
+			continue
+		}
+		revisions = append(revisions, revision)
+		for _, parent := range down {
+			downRevisions[parent] = true
+		}
+		if set.Dialect == "" {
+			set.Dialect = migrationParser.InferAlembicDialect(content)
+		}
+	}
+
+	if len(revisions) == 0 {
+		return nil
+	}
+	set.Count = len(revisions)
+
+	// The head is the revision no other revision builds upon, branches without merge have several heads
+	var heads []string
+	for _, revision := range revisions {
+		if !downRevisions[revision] {
+			heads = append(heads, revision)
+		}
+	}
+	if len(heads) == 1 {
+		set.Latest = heads[0]
+	}
+	return set
+}
+
+// detectPrisma collects Prisma migrations of a migrations directory with migration_lock.toml
+func (d *Detector) detectPrisma(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	lock, ok := readMigration(provider, currentPath, "migration_lock.toml")
+	if !ok {
+		return nil
+	}
+
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolPrisma, Dialect: migrationParser.ParsePrismaLock(lock)}
+	var contents []string
+	for _, file := range files {
+		if file.Type != "dir" || !migrationParser.IsPrismaMigration(file.Name) {
+			continue
+		}
+		set.Count++
+		set.Latest = file.Name
+		if set.Dialect != "" {
+			continue
+		}
+		if content, ok := readMigration(provider, filepath.Join(currentPath, file.Name), "migration.sql"); ok {
+			contents = append(contents, content)
+		}
+	}
+
+	if set.Count == 0 {
+		return nil
+	}
+	if set.Dialect == "" {
+		set.Dialect = migrationParser.InferSQLDialect(contents...)
+	}
+	return set
+}
+
+// detectRails collects Active Record migrations of a db/migrate directory
+func (d *Detector) detectRails(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	if !strings.HasSuffix(filepath.ToSlash(currentPath), "/db/migrate") {
+		return nil
+	}
+
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolRails}
+	var contents []string
+	for _, file := range files {
+		if file.Type == "dir" || !migrationParser.IsRailsMigration(file.Name) {
+			continue
+		}
+		set.Count++
+		set.Latest = strings.TrimSuffix(file.Name, ".rb")
+		if content, ok := readMigration(provider, currentPath, file.Name); ok {
+			contents = append(contents, content)
+		}
+	}
+
+	if set.Count == 0 {
+		return nil
+	}
+	set.Dialect = migrationParser.InferSQLDialect(contents...)
+	return set
+}
+
+// detectEFCore collects EF Core migrations of a Migrations directory
+func (d *Detector) detectEFCore(migrationParser *parsers.MigrationParser, files []types.File, currentPath string, provider types.Provider) *parsers.MigrationSet {
+	if !strings.EqualFold(filepath.Base(currentPath), "Migrations") {
+		return nil
+	}
+
+	set := &parsers.MigrationSet{Tool: parsers.MigrationToolEFCore}
+	ids := make(map[string]bool)
+	for _, file := range files {
+		if file.Type == "dir" || filepath.Ext(file.Name) != ".cs" {
+			continue
+		}
+		content, ok := readMigration(provider, currentPath, file.Name)
+		if !ok {
+			continue
+		}
+		// Model snapshots carry provider annotations as well
+		if set.Dialect == "" {
+			set.Dialect = migrationParser.InferEFDialect(content)
+		}
+
+		id, ok := migrationParser.ParseEFMigration(content)
+		if !ok {
+			continue
+		}
+		if id == "" {
+			// Migration class without attribute, named like its designer file
+			id = strings.TrimSuffix(file.Name, ".cs")
+		}
+		ids[id] = true
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+	set.Count = len(ids)
+	for id := range ids {
+		if id > set.Latest {
+			set.Latest = id
+		}
+	}
+	return set
+}
+
+// readMigration reads a migration file, skipping unreadable and oversized files
+func readMigration(provider types.Provider, dir, fileName string) (string, bool) {
+	content, err := provider.ReadFile(filepath.Join(dir, fileName))
+	if err != nil || len(content) > maxMigrationSize {
+		return "", false
+	}
+	return string(content), true
+}
+
+func init() {
+	components.Register(&Detector{})
+}
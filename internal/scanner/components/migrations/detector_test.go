@@ -0,0 +1,252 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+// migrationFiles returns the file list of a directory from the mock provider
+func migrationFiles(provider *MockProvider, dir string, subdirs ...string) []types.File {
+	var files []types.File
+	for path := range provider.files {
+		if filepath.Dir(path) == dir {
+			files = append(files, types.File{Name: filepath.Base(path), Path: path, Type: "file"})
+		}
+	}
+	for _, subdir := range subdirs {
+		files = append(files, types.File{Name: subdir, Path: filepath.Join(dir, subdir), Type: "dir"})
+	}
+	return files
+}
+
+// migrationSet returns the single migration set of a detected payload
+func migrationSet(t *testing.T, payload *types.Payload) *parsers.MigrationSet {
+	sets, ok := payload.Properties["migrations"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, sets, 1)
+	set, ok := sets[0].(*parsers.MigrationSet)
+	require.True(t, ok)
+	return set
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "migrations", detector.Name())
+}
+
+func TestDetector_Detect_Flyway(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/src/main/resources/db/migration/V1__init.sql":         "CREATE TABLE users (id BIGSERIAL PRIMARY KEY);",
+		"/mock/src/main/resources/db/migration/V2__add_orders.sql":   "CREATE TABLE orders (id BIGSERIAL, data JSONB);",
+		"/mock/src/main/resources/db/migration/V10__add_index.sql":   "CREATE INDEX idx_orders ON orders (id);",
+		"/mock/src/main/resources/db/migration/R__refresh_views.sql": "CREATE OR REPLACE VIEW v AS SELECT 1;",
+		"/mock/src/main/resources/db/migration/README.md":            "# Migrations",
+	}}
+
+	detector := &Detector{}
+	dir := "/mock/src/main/resources/db/migration"
+	results := detector.Detect(migrationFiles(provider, dir), dir, "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Equal(t, []string{"flyway", "postgresql"}, payload.Techs)
+	assert.Equal(t, &parsers.MigrationSet{
+		Directory: "/src/main/resources/db/migration",
+		Tool:      parsers.MigrationToolFlyway,
+		Count:     3,
+		Latest:    "V10__add_index",
+		Dialect:   parsers.SQLDialectPostgreSQL,
+		Database:  "postgresql",
+	}, migrationSet(t, payload))
+}
+
+func TestDetector_Detect_GolangMigrate(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/migrations/000001_init.up.sql":        "CREATE TABLE `users` (`id` INT AUTO_INCREMENT) ENGINE=InnoDB;",
+		"/mock/migrations/000001_init.down.sql":      "DROP TABLE `users`;",
+		"/mock/migrations/000002_add_email.up.sql":   "ALTER TABLE users ADD email VARCHAR(255);",
+		"/mock/migrations/000002_add_email.down.sql": "ALTER TABLE users DROP email;",
+	}}
+
+	detector := &Detector{}
+	results := detector.Detect(migrationFiles(provider, "/mock/migrations"), "/mock/migrations", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	set := migrationSet(t, results[0])
+	assert.Equal(t, parsers.MigrationToolGolangMigrate, set.Tool)
+	assert.Equal(t, 2, set.Count)
+	assert.Equal(t, "000002_add_email", set.Latest)
+	assert.Equal(t, "mysql", set.Database)
+	assert.Contains(t, results[0].Techs, "golang-migrate")
+}
+
+func TestDetector_Detect_Liquibase(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/db/changelog/db.changelog-master.yaml": "databaseChangeLog:\n  - includeAll:\n      path: changes/\n",
+		"/mock/db/changelog/001-users.xml": `<databaseChangeLog>
+  <changeSet id="001" author="alice" dbms="oracle"><createTable tableName="users"/></changeSet>
+</databaseChangeLog>`,
+		"/mock/db/changelog/002-orders.xml": `<databaseChangeLog>
+  <changeSet id="002" author="alice"><createTable tableName="orders"/></changeSet>
+  <changeSet id="003" author="bob"><addColumn tableName="orders"/></changeSet>
+</databaseChangeLog>`,
+	}}
+
+	detector := &Detector{}
+	results := detector.Detect(migrationFiles(provider, "/mock/db/changelog"), "/mock/db/changelog", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, &parsers.MigrationSet{
+		Directory: "/db/changelog",
+		Tool:      parsers.MigrationToolLiquibase,
+		Count:     3,
+		Latest:    "003",
+		Dialect:   parsers.SQLDialectOracle,
+		Database:  "oracle",
+	}, migrationSet(t, results[0]))
+}
+
+func TestDetector_Detect_Alembic(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/alembic/versions/a1_init.py":   "revision = 'a1'\ndown_revision = None\n",
+		"/mock/alembic/versions/b2_orders.py": "from sqlalchemy.dialects import mysql\nrevision = 'b2'\ndown_revision = 'a1'\n",
+		"/mock/alembic/versions/c3_index.py":  "revision = 'c3'\ndown_revision = 'b2'\n",
+		"/mock/alembic/versions/__init__.py":  "",
+	}}
+
+	detector := &Detector{}
+	dir := "/mock/alembic/versions"
+	results := detector.Detect(migrationFiles(provider, dir), dir, "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	set := migrationSet(t, results[0])
+	assert.Equal(t, parsers.MigrationToolAlembic, set.Tool)
+	assert.Equal(t, 3, set.Count)
+	assert.Equal(t, "c3", set.Latest)
+	assert.Equal(t, "mysql", set.Database)
+}
+
+func TestDetector_Detect_Prisma(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/prisma/migrations/migration_lock.toml":                 "provider = \"postgresql\"\n",
+		"/mock/prisma/migrations/20240101000000_init/migration.sql":   "CREATE TABLE \"User\" (\"id\" SERIAL);",
+		"/mock/prisma/migrations/20240201000000_orders/migration.sql": "CREATE TABLE \"Order\" (\"id\" SERIAL);",
+	}}
+
+	detector := &Detector{}
+	dir := "/mock/prisma/migrations"
+	files := migrationFiles(provider, dir, "20240101000000_init", "20240201000000_orders")
+	results := detector.Detect(files, dir, "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, &parsers.MigrationSet{
+		Directory: "/prisma/migrations",
+		Tool:      parsers.MigrationToolPrisma,
+		Count:     2,
+		Latest:    "20240201000000_orders",
+		Dialect:   parsers.SQLDialectPostgreSQL,
+		Database:  "postgresql",
+	}, migrationSet(t, results[0]))
+}
+
+func TestDetector_Detect_Rails(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/db/migrate/20240101000000_create_users.rb":  "class CreateUsers < ActiveRecord::Migration[7.1]\n  def change\n    create_table :users do |t|\n      t.jsonb :settings\n    end\n  end\nend\n",
+		"/mock/db/migrate/20240201000000_create_orders.rb": "class CreateOrders < ActiveRecord::Migration[7.1]\nend\n",
+	}}
+
+	detector := &Detector{}
+	results := detector.Detect(migrationFiles(provider, "/mock/db/migrate"), "/mock/db/migrate", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	set := migrationSet(t, results[0])
+	assert.Equal(t, parsers.MigrationToolRails, set.Tool)
+	assert.Equal(t, 2, set.Count)
+	assert.Equal(t, "20240201000000_create_orders", set.Latest)
+	assert.Equal(t, "postgresql", set.Database)
+}
+
+func TestDetector_Detect_EFCore(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/src/Shop/Migrations/20240101000000_Init.cs":               "public partial class Init : Migration { }",
+		"/mock/src/Shop/Migrations/20240101000000_Init.Designer.cs":      "[Migration(\"20240101000000_Init\")]\npartial class Init { }",
+		"/mock/src/Shop/Migrations/20240201000000_AddOrders.cs":          "public partial class AddOrders : Migration { }",
+		"/mock/src/Shop/Migrations/20240201000000_AddOrders.Designer.cs": "[Migration(\"20240201000000_AddOrders\")]\npartial class AddOrders { }",
+		"/mock/src/Shop/Migrations/ShopContextModelSnapshot.cs":          "modelBuilder.HasAnnotation(\"Npgsql:ValueGenerationStrategy\", 1);",
+	}}
+
+	detector := &Detector{}
+	dir := "/mock/src/Shop/Migrations"
+	results := detector.Detect(migrationFiles(provider, dir), dir, "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"entityframework", "postgresql"}, results[0].Techs)
+	set := migrationSet(t, results[0])
+	assert.Equal(t, parsers.MigrationToolEFCore, set.Tool)
+	assert.Equal(t, 2, set.Count)
+	assert.Equal(t, "20240201000000_AddOrders", set.Latest)
+}
+
+func TestDetector_Detect_IgnoresOtherFiles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/sql/schema.sql":    "CREATE TABLE users (id SERIAL);",
+		"/mock/sql/changelog.md":  "# Changelog",
+		"/mock/sql/versions.py":   "revision = 'a1'\ndown_revision = None\n",
+		"/mock/sql/Migrations.cs": "public class Migrations { }",
+	}}
+
+	detector := &Detector{}
+	results := detector.Detect(migrationFiles(provider, "/mock/sql"), "/mock/sql", "/mock", provider, &MockDependencyDetector{})
+
+	assert.Empty(t, results)
+}
@@ -0,0 +1,358 @@
+package monorepo
+
+import (
+	"encoding/json"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// toolTechs maps monorepo orchestrators to their techs
+var toolTechs = map[string]string{
+	parsers.MonorepoNx:        "nxjs",
+	parsers.MonorepoTurborepo: "turborepo",
+	parsers.MonorepoLerna:     "lerna",
+	parsers.MonorepoRush:      "rush",
+}
+
+// workspaceConfigFiles are the root configurations of the orchestrators whose projects are package.json packages
+var workspaceConfigFiles = []string{"rush.json", "lerna.json", "turbo.json"}
+
+// Detector tags the workspace root with its monorepo orchestrator (nx.json, turbo.json, lerna.json, rush.json)
+// and records the projects of the workspace (Nx project.json, Rush projects, Lerna packages, Turborepo workspaces)
+// as components named as the tool names them. Dependencies between projects become edges.
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "monorepo"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	monorepoParser := parsers.NewMonorepoParser()
+
+	var results []*types.Payload
+	for _, file := range files {
+		if file.Type == "dir" {
+			continue
+		}
+
+		switch file.Name {
+		case "project.json":
+			if payload := detectNxProject(monorepoParser, files, currentPath, basePath, provider); payload != nil {
+				results = append(results, payload)
+			}
+			continue
+		case "package.json":
+			if payload := detectWorkspaceProject(monorepoParser, currentPath, basePath, provider); payload != nil {
+				results = append(results, payload)
+			}
+			continue
+		}
+		parse := configParser(monorepoParser, file.Name)
+		if parse == nil {
+			continue
+		}
+
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil {
+			continue
+		}
+		config := parse(content)
+		if config == nil {
+			continue
+		}
+
+		relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+		config.File = relativeFilePath
+
+		// Virtual payloads merge into the directory's component, which tags the workspace root
+		payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+		payload.AddTech(toolTechs[config.Tool], "matched file: "+file.Name)
+		payload.Properties["monorepo"] = []interface{}{config}
+		results = append(results, payload)
+	}
+
+	return results
+}
+
+// configParser returns the parser of the root configuration of an orchestrator, or nil for other files
+func configParser(monorepoParser *parsers.MonorepoParser, fileName string) func([]byte) *parsers.MonorepoConfig {
+	switch fileName {
+	case "nx.json":
+		return monorepoParser.ParseNxJSON
+	case "turbo.json":
+		return monorepoParser.ParseTurboJSON
+	case "lerna.json":
+		return monorepoParser.ParseLernaJSON
+	case "rush.json":
+		return monorepoParser.ParseRushJSON
+	}
+	return nil
+}
+
+// detectNxProject creates a component for an Nx project.json, named after the project. Projects without a
+// name are named after their package.json, then after their directory, as Nx does
+func detectNxProject(monorepoParser *parsers.MonorepoParser, files []types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, "project.json"))
+	if err != nil {
+		return nil
+	}
+	project := monorepoParser.ParseNxProject(content)
+	if project == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, "project.json")
+	project.File = relativeFilePath
+	if project.Name == "" {
+		project.Name = packageName(files, currentPath, provider)
+	}
+	if project.Name == "" {
+		project.Name = filepath.Base(currentPath)
+	}
+
+	payload := types.NewPayloadWithPath(project.Name, relativeFilePath)
+	payload.AddPrimaryTech("nxjs")
+	payload.Properties["monorepo_project"] = project
+	for _, dependency := range project.ImplicitDependencies {
+		payload.AddComponentRef(dependency)
+	}
+	return payload
+}
+
+// detectWorkspaceProject creates a component for a package.json that is a project of the enclosing Rush, Lerna or
+// Turborepo workspace, named as the tool names it. Dependencies on other projects of the workspace and Turborepo
+// dependsOn entries on the task of another project ("api#build") are resolved into edges
+func detectWorkspaceProject(monorepoParser *parsers.MonorepoParser, currentPath, basePath string, provider types.Provider) *types.Payload {
+	root, configs := findWorkspace(monorepoParser, currentPath, basePath, provider)
+	if len(configs) == 0 {
+		return nil
+	}
+	content, err := provider.ReadFile(filepath.Join(currentPath, "package.json"))
+	if err != nil {
+		return nil
+	}
+	pkg := monorepoParser.ParseWorkspacePackage(content)
+	if pkg == nil {
+		return nil
+	}
+	folder, err := filepath.Rel(root, currentPath)
+	if err != nil {
+		return nil
+	}
+
+	// The first configuration found (rush.json, lerna.json, turbo.json) declares the projects
+	config := configs[0]
+	projects := workspaceProjects(monorepoParser, config, root, provider)
+	name := projectName(projects, filepath.ToSlash(folder))
+	if name == "" {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, "package.json")
+	project := &parsers.WorkspaceProject{File: relativeFilePath, Tool: config.Tool, Name: name}
+	payload := types.NewPayloadWithPath(name, relativeFilePath)
+	payload.AddPrimaryTech(toolTechs[config.Tool])
+	for _, dependency := range pkg.Dependencies {
+		if dependency != name && hasProject(projects, dependency) {
+			project.Dependencies = append(project.Dependencies, dependency)
+			payload.AddComponentRef(dependency)
+		}
+	}
+
+	// Tasks of this project ("web#build") or of every project ("build") depending on the task of another project
+	for _, turbo := range configs {
+		if turbo.Tool != parsers.MonorepoTurborepo {
+			continue
+		}
+		for _, task := range turbo.Tasks {
+			if owner, _, scoped := strings.Cut(task.Name, "#"); scoped && owner != name {
+				continue
+			}
+			for _, dependency := range task.DependsOn {
+				if target, _, found := strings.Cut(dependency, "#"); found && target != name && hasProject(projects, target) {
+					payload.AddComponentRef(target)
+				}
+			}
+		}
+	}
+
+	payload.Properties["monorepo_project"] = project
+	return payload
+}
+
+// findWorkspace returns the directory and the configurations of the nearest Rush, Lerna or Turborepo workspace
+// above a directory, without leaving the scanned directory
+func findWorkspace(monorepoParser *parsers.MonorepoParser, currentPath, basePath string, provider types.Provider) (string, []*parsers.MonorepoConfig) {
+	for dir := currentPath; dir != basePath; {
+		parent := filepath.Dir(dir)
+		if parent == dir || !strings.HasPrefix(parent, basePath) {
+			break
+		}
+		dir = parent
+
+		var configs []*parsers.MonorepoConfig
+		for _, name := range workspaceConfigFiles {
+			content, err := provider.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				continue
+			}
+			// Package configurations of Turborepo (extends) are not parsed, the root one is further up
+			if config := configParser(monorepoParser, name)(content); config != nil {
+				configs = append(configs, config)
+			}
+		}
+		if len(configs) > 0 {
+			return dir, configs
+		}
+	}
+	return "", nil
+}
+
+// workspaceProjects returns the projects of a workspace: those listed in rush.json, otherwise the named packages
+// matching the globs of lerna.json, of the package.json workspaces or of pnpm-workspace.yaml
+func workspaceProjects(monorepoParser *parsers.MonorepoParser, config *parsers.MonorepoConfig, root string, provider types.Provider) []parsers.MonorepoProject {
+	if config.Tool == parsers.MonorepoRush {
+		return config.Projects
+	}
+
+	globs := config.Packages
+	if len(globs) == 0 {
+		if content, err := provider.ReadFile(filepath.Join(root, "package.json")); err == nil {
+			if pkg := monorepoParser.ParseWorkspacePackage(content); pkg != nil {
+				globs = pkg.Workspaces
+			}
+		}
+	}
+	if len(globs) == 0 {
+		if content, err := provider.ReadFile(filepath.Join(root, "pnpm-workspace.yaml")); err == nil {
+			globs = monorepoParser.ParsePnpmWorkspace(content)
+		}
+	}
+	if len(globs) == 0 && config.Tool == parsers.MonorepoLerna {
+		globs = []string{"packages/*"}
+	}
+
+	// "!packages/legacy" excludes folders matched by other globs
+	excluded := make(map[string]bool)
+	var folders []string
+	for _, glob := range globs {
+		if negated, found := strings.CutPrefix(glob, "!"); found {
+			for _, folder := range expandWorkspaceGlob(root, negated, provider) {
+				excluded[folder] = true
+			}
+			continue
+		}
+		folders = append(folders, expandWorkspaceGlob(root, glob, provider)...)
+	}
+
+	var projects []parsers.MonorepoProject
+	for _, folder := range folders {
+		if excluded[folder] || projectName(projects, folder) != "" {
+			continue
+		}
+		content, err := provider.ReadFile(filepath.Join(root, filepath.FromSlash(folder), "package.json"))
+		if err != nil {
+			continue
+		}
+		if pkg := monorepoParser.ParseWorkspacePackage(content); pkg != nil && pkg.Name != "" {
+			projects = append(projects, parsers.MonorepoProject{Name: pkg.Name, Folder: folder})
+		}
+	}
+	return projects
+}
+
+// expandWorkspaceGlob returns the folders (relative to the workspace root) matching a workspace glob. "**" matches
+// any number of directories, node_modules and hidden directories are not searched
+func expandWorkspaceGlob(root, glob string, provider types.Provider) []string {
+	folders := []string{"."}
+	for _, segment := range strings.Split(path.Clean(glob), "/") {
+		var next []string
+		for _, folder := range folders {
+			switch {
+			case segment == "**":
+				next = append(next, folder)
+				next = append(next, workspaceSubdirs(root, folder, true, provider)...)
+			case strings.ContainsAny(segment, "*?["):
+				for _, subdir := range workspaceSubdirs(root, folder, false, provider) {
+					if matched, _ := path.Match(segment, path.Base(subdir)); matched {
+						next = append(next, subdir)
+					}
+				}
+			default:
+				next = append(next, path.Join(folder, segment))
+			}
+		}
+		folders = next
+	}
+	return folders
+}
+
+// workspaceSubdirs lists the subdirectories of a workspace folder, recursively if requested
+func workspaceSubdirs(root, folder string, recursive bool, provider types.Provider) []string {
+	entries, err := provider.ListDir(filepath.Join(root, filepath.FromSlash(folder)))
+	if err != nil {
+		return nil
+	}
+	var subdirs []string
+	for _, entry := range entries {
+		if entry.Type != "dir" || entry.Name == "node_modules" || strings.HasPrefix(entry.Name, ".") {
+			continue
+		}
+		subdir := path.Join(folder, entry.Name)
+		subdirs = append(subdirs, subdir)
+		if recursive {
+			subdirs = append(subdirs, workspaceSubdirs(root, subdir, true, provider)...)
+		}
+	}
+	return subdirs
+}
+
+// projectName returns the name of the project in a folder of the workspace, or an empty string
+func projectName(projects []parsers.MonorepoProject, folder string) string {
+	for _, project := range projects {
+		if path.Clean(project.Folder) == folder {
+			return project.Name
+		}
+	}
+	return ""
+}
+
+// hasProject reports whether a workspace has a project with the given name
+func hasProject(projects []parsers.MonorepoProject, name string) bool {
+	for _, project := range projects {
+		if project.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// packageName returns the name of the package.json next to a project.json, or an empty string
+func packageName(files []types.File, currentPath string, provider types.Provider) string {
+	for _, file := range files {
+		if file.Name != "package.json" {
+			continue
+		}
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil {
+			return ""
+		}
+		var packageJSON struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(content, &packageJSON) != nil {
+			return ""
+		}
+		return packageJSON.Name
+	}
+	return ""
+}
+
+func init() {
+	components.Register(&Detector{})
+}
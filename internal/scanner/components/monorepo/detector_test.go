@@ -0,0 +1,250 @@
+package monorepo
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(dir string) ([]types.File, error) {
+	seen := make(map[string]bool)
+	var files []types.File
+	for file := range m.files {
+		rel, found := strings.CutPrefix(file, dir+"/")
+		if !found {
+			continue
+		}
+		name, _, isDir := strings.Cut(rel, "/")
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if isDir {
+			files = append(files, types.File{Name: name, Type: "dir"})
+		} else {
+			files = append(files, types.File{Name: name, Type: "file"})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "monorepo", detector.Name())
+}
+
+func TestDetector_Detect_Orchestrators(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/nx.json":    `{"targetDefaults": {"build": {"dependsOn": ["^build"]}}}`,
+		"/project/turbo.json": `{"tasks": {"build": {"outputs": ["dist/**"]}}}`,
+		"/project/lerna.json": `{"version": "1.4.0", "packages": ["packages/*"]}`,
+		"/project/rush.json":  `{"rushVersion": "5.112.2", "projects": [{"packageName": "@acme/web", "projectFolder": "apps/web"}]}`,
+	}}
+	files := []types.File{
+		{Name: "nx.json", Type: "file"},
+		{Name: "turbo.json", Type: "file"},
+		{Name: "lerna.json", Type: "file"},
+		{Name: "rush.json", Type: "file"},
+		{Name: "package.json", Type: "file"},
+	}
+
+	results := (&Detector{}).Detect(files, "/project", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 4)
+
+	var techs []string
+	for _, payload := range results {
+		assert.Equal(t, "virtual", payload.Name)
+		techs = append(techs, payload.Techs...)
+	}
+	assert.Equal(t, []string{"nxjs", "turborepo", "lerna", "rush"}, techs)
+
+	configs, ok := results[3].Properties["monorepo"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, configs, 1)
+	config := configs[0].(*parsers.MonorepoConfig)
+	assert.Equal(t, "/rush.json", config.File)
+	assert.Equal(t, []parsers.MonorepoProject{{Name: "@acme/web", Folder: "apps/web"}}, config.Projects)
+}
+
+func TestDetector_Detect_NxProject(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/apps/web/project.json": `{"name": "web", "projectType": "application", "tags": ["scope:web"], "implicitDependencies": ["ui", "!api"]}`,
+		"/project/apps/web/package.json": `{"name": "@acme/web"}`,
+	}}
+	files := []types.File{{Name: "project.json", Type: "file"}, {Name: "package.json", Type: "file"}}
+
+	results := (&Detector{}).Detect(files, "/project/apps/web", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "web", payload.Name)
+	assert.Equal(t, []string{"/apps/web/project.json"}, payload.Path)
+	assert.Equal(t, []string{"nxjs"}, payload.Tech)
+	assert.Equal(t, []string{"ui"}, payload.ComponentRefs)
+
+	project, ok := payload.Properties["monorepo_project"].(*parsers.NxProject)
+	require.True(t, ok)
+	assert.Equal(t, "application", project.ProjectType)
+	assert.Equal(t, []string{"scope:web"}, project.Tags)
+}
+
+func TestDetector_Detect_NxProjectName(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/libs/ui/project.json": `{"sourceRoot": "libs/ui/src", "projectType": "library"}`,
+		"/project/libs/ui/package.json": `{"name": "@acme/ui"}`,
+		"/project/libs/db/project.json": `{"sourceRoot": "libs/db/src", "projectType": "library"}`,
+	}}
+
+	// Unnamed projects are named after their package.json, then after their directory
+	results := (&Detector{}).Detect([]types.File{{Name: "project.json", Type: "file"}, {Name: "package.json", Type: "file"}},
+		"/project/libs/ui", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "@acme/ui", results[0].Name)
+
+	results = (&Detector{}).Detect([]types.File{{Name: "project.json", Type: "file"}}, "/project/libs/db", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "db", results[0].Name)
+}
+
+func TestDetector_Detect_NotNxProject(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/project.json": `{"dependencies": {"Microsoft.NETCore.App": "1.0.0"}}`,
+		"/project/turbo.json":   `{"extends": ["//"], "tasks": {}}`,
+	}}
+	files := []types.File{{Name: "project.json", Type: "file"}, {Name: "turbo.json", Type: "file"}}
+	results := (&Detector{}).Detect(files, "/project", "/project", provider, &MockDependencyDetector{})
+	assert.Empty(t, results)
+}
+
+func TestDetector_Detect_RushProject(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/rush.json": `{"rushVersion": "5.112.2", "projects": [
+  {"packageName": "@acme/web", "projectFolder": "apps/web"},
+  {"packageName": "@acme/ui", "projectFolder": "libraries/ui"}
+]}`,
+		"/project/apps/web/package.json": `{"name": "@acme/web", "dependencies": {"@acme/ui": "workspace:*", "react": "^18.2.0"}}`,
+	}}
+	files := []types.File{{Name: "package.json", Type: "file"}}
+
+	results := (&Detector{}).Detect(files, "/project/apps/web", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "@acme/web", payload.Name)
+	assert.Equal(t, []string{"/apps/web/package.json"}, payload.Path)
+	assert.Equal(t, []string{"rush"}, payload.Tech)
+	assert.Equal(t, []string{"@acme/ui"}, payload.ComponentRefs)
+	assert.Equal(t, &parsers.WorkspaceProject{
+		File:         "/apps/web/package.json",
+		Tool:         parsers.MonorepoRush,
+		Name:         "@acme/web",
+		Dependencies: []string{"@acme/ui"},
+	}, payload.Properties["monorepo_project"])
+
+	// Folders rush.json does not list are not projects
+	provider.files["/project/tools/scripts/package.json"] = `{"name": "scripts"}`
+	assert.Empty(t, (&Detector{}).Detect(files, "/project/tools/scripts", "/project", provider, &MockDependencyDetector{}))
+}
+
+func TestDetector_Detect_LernaPackage(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/lerna.json":                   `{"version": "independent", "packages": ["packages/*", "!packages/legacy"]}`,
+		"/project/packages/cli/package.json":    `{"name": "acme-cli", "dependencies": {"acme-core": "^2.0.0", "acme-legacy": "^1.0.0", "chalk": "^5.0.0"}}`,
+		"/project/packages/core/package.json":   `{"name": "acme-core"}`,
+		"/project/packages/legacy/package.json": `{"name": "acme-legacy"}`,
+	}}
+	files := []types.File{{Name: "package.json", Type: "file"}}
+
+	results := (&Detector{}).Detect(files, "/project/packages/cli", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "acme-cli", results[0].Name)
+	assert.Equal(t, []string{"lerna"}, results[0].Tech)
+	assert.Equal(t, []string{"acme-core"}, results[0].ComponentRefs)
+
+	// Excluded packages are not projects
+	assert.Empty(t, (&Detector{}).Detect(files, "/project/packages/legacy", "/project", provider, &MockDependencyDetector{}))
+}
+
+func TestDetector_Detect_TurboWorkspace(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/turbo.json": `{"tasks": {
+  "build": {"dependsOn": ["^build", "@acme/config#build"]},
+  "web#deploy": {"dependsOn": ["api#deploy"]},
+  "api#deploy": {"dependsOn": ["db#migrate"]}
+}}`,
+		"/project/pnpm-workspace.yaml":                "packages:\n  - apps/*\n  - packages/**\n",
+		"/project/apps/web/package.json":              `{"name": "web", "dependencies": {"@acme/ui": "workspace:*"}}`,
+		"/project/apps/web/turbo.json":                `{"extends": ["//"], "tasks": {"build": {"outputs": [".next/**"]}}}`,
+		"/project/apps/api/package.json":              `{"name": "api"}`,
+		"/project/packages/ui/package.json":           `{"name": "@acme/ui"}`,
+		"/project/packages/tools/config/package.json": `{"name": "@acme/config"}`,
+	}}
+	files := []types.File{{Name: "package.json", Type: "file"}, {Name: "turbo.json", Type: "file"}}
+
+	// The package turbo.json extends the root one and does not tag the package as a workspace root
+	results := (&Detector{}).Detect(files, "/project/apps/web", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "web", results[0].Name)
+	assert.Equal(t, []string{"turborepo"}, results[0].Tech)
+	assert.Equal(t, []string{"@acme/ui", "@acme/config", "api"}, results[0].ComponentRefs)
+
+	// The config package does not depend on its own build
+	results = (&Detector{}).Detect([]types.File{{Name: "package.json", Type: "file"}}, "/project/packages/tools/config", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "@acme/config", results[0].Name)
+	assert.Empty(t, results[0].ComponentRefs)
+}
+
+func TestDetector_Detect_PackageOutsideWorkspace(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/web/package.json": `{"name": "web", "dependencies": {"react": "^18.2.0"}}`,
+	}}
+	results := (&Detector{}).Detect([]types.File{{Name: "package.json", Type: "file"}}, "/project/web", "/project", provider, &MockDependencyDetector{})
+	assert.Empty(t, results)
+}
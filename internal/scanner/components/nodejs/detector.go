@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
@@ -97,6 +98,10 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 			payload.Licenses = append(payload.Licenses, packageJSON.License)
 		}
 
+		// Node.js version from engines and .nvmrc, package manager from packageManager
+		runtimeParser := parsers.NewRuntimeParser()
+		components.AddRuntimeVersions(payload, runtimeParser.ParsePackageJSONRuntimes(content), files, currentPath, provider, "node")
+
 		// Add to payloads array instead of returning immediately
 		payloads = append(payloads, payload)
 	}
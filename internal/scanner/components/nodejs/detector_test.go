@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -435,3 +436,30 @@ func TestDetector_Detect_RelativePathHandling(t *testing.T) {
 	assert.Equal(t, "path-test-app", payload.Name)
 	assert.Equal(t, "/subdir/package.json", payload.Path[0], "Should handle relative paths correctly")
 }
+
+func TestDetector_Detect_RuntimeVersions(t *testing.T) {
+	detector := &Detector{}
+	depDetector := &MockDependencyDetector{}
+
+	provider := &MockProvider{files: map[string]string{
+		"/project/package.json":   `{"name": "web", "engines": {"node": ">=18"}, "packageManager": "pnpm@9.1.0+sha512.abc"}`,
+		"/project/.nvmrc":         "v20.11.0\n",
+		"/project/.tool-versions": "nodejs 18.19.0\n",
+	}}
+	files := []types.File{{Name: "package.json"}, {Name: ".nvmrc"}, {Name: ".tool-versions"}}
+
+	results := detector.Detect(files, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+
+	// .nvmrc wins over .tool-versions and engines
+	assert.Equal(t, map[string]interface{}{
+		"node": parsers.RuntimeVersion{Version: "20.11.0", Source: ".nvmrc"},
+		"pnpm": parsers.RuntimeVersion{Version: "9.1.0", Source: "package.json#packageManager"},
+	}, results[0].Properties["runtime"])
+
+	// Without version files the engines constraint is reported
+	results = detector.Detect([]types.File{{Name: "package.json"}}, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.Equal(t, parsers.RuntimeVersion{Version: ">=18", Source: "package.json#engines.node"},
+		results[0].Properties["runtime"].(map[string]interface{})["node"])
+}
@@ -0,0 +1,100 @@
+package notebook
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// maxNotebookSize skips notebooks larger than 10MB, which mostly consist of embedded outputs
+const maxNotebookSize = 10_000_000
+
+// Detector records each directory containing Jupyter notebooks as a data-science component. The packages
+// installed by %pip/!pip magics and the imports of Python code cells are matched against the python dependency rules
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "notebook"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	notebookParser := parsers.NewNotebookParser()
+
+	var payload *types.Payload
+	for _, file := range files {
+		if file.Type == "dir" || !strings.EqualFold(filepath.Ext(file.Name), ".ipynb") {
+			continue
+		}
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil || len(content) > maxNotebookSize {
+			continue
+		}
+		notebook := notebookParser.ParseNotebook(content)
+		if notebook == nil {
+			continue
+		}
+
+		relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+		notebook.File = relativeFilePath
+		if payload == nil {
+			payload = types.NewPayload(filepath.Base(currentPath), []string{relativeFilePath})
+			payload.AddPrimaryTech("jupyter")
+		} else {
+			payload.AddPath(relativeFilePath)
+		}
+		addNotebook(payload, notebook, depDetector)
+	}
+
+	if payload == nil {
+		return nil
+	}
+	return []*types.Payload{payload}
+}
+
+// addNotebook adds the installed packages, matched techs and properties of a notebook to the component
+func addNotebook(payload *types.Payload, notebook *parsers.Notebook, depDetector components.DependencyDetector) {
+	var packageNames []string
+	for _, pkg := range notebook.Packages {
+		packageNames = append(packageNames, pkg.Name)
+		payload.AddDependency(types.Dependency{Type: "python", Name: pkg.Name, Example: pkg.Version})
+	}
+	if len(packageNames) > 0 {
+		for tech, reasons := range depDetector.MatchDependencies(packageNames, "python") {
+			for _, reason := range reasons {
+				payload.AddTech(tech, reason)
+			}
+		}
+	}
+
+	for _, imp := range notebook.Imports {
+		for _, tech := range matchImport(imp, depDetector) {
+			payload.AddTech(tech, tech+" matched notebook import: "+imp.Module)
+		}
+	}
+
+	existing, _ := payload.Properties["notebooks"].([]interface{})
+	payload.Properties["notebooks"] = append(existing, notebook)
+}
+
+// matchImport returns the techs of the most specific candidate of an import matching a python rule
+func matchImport(imp parsers.Import, depDetector components.DependencyDetector) []string {
+	for _, candidate := range imp.Candidates {
+		var techs []string
+		for tech := range depDetector.MatchDependencies([]string{candidate}, "python") {
+			techs = append(techs, tech)
+		}
+		if len(techs) > 0 {
+			sort.Strings(techs)
+			return techs
+		}
+	}
+	return nil
+}
+
+func init() {
+	components.Register(&Detector{})
+}
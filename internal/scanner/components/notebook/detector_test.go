@@ -0,0 +1,126 @@
+package notebook
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing, matching python packages by name
+type MockDependencyDetector struct {
+	techs map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	matched := map[string][]string{}
+	for _, dep := range dependencies {
+		if tech, exists := m.techs[dep]; exists && depType == "python" {
+			matched[tech] = append(matched[tech], tech+" matched: "+dep)
+		}
+	}
+	return matched
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "notebook", detector.Name())
+}
+
+func TestDetector_Detect(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/project/analysis/churn.ipynb": `{
+  "cells": [
+    {"cell_type": "code", "source": ["%pip install pandas==2.2.1 boto3\n", "import pandas as pd\n"]},
+    {"cell_type": "code", "source": ["from openai import OpenAI\n"]}
+  ],
+  "metadata": {"kernelspec": {"name": "python3", "display_name": "Python 3", "language": "python"}},
+  "nbformat": 4
+}`,
+		"/project/analysis/explore.ipynb": `{
+  "cells": [{"cell_type": "code", "source": "!pip install pandas\nimport torch\n"}],
+  "metadata": {"kernelspec": {"name": "python3", "display_name": "Python 3", "language": "python"}},
+  "nbformat": 4
+}`,
+		"/project/analysis/broken.ipynb": `{`,
+	}}
+	depDetector := &MockDependencyDetector{techs: map[string]string{
+		"boto3":  "aws",
+		"openai": "openai",
+		"torch":  "pytorch",
+	}}
+	files := []types.File{
+		{Name: "churn.ipynb", Type: "file"},
+		{Name: "explore.ipynb", Type: "file"},
+		{Name: "broken.ipynb", Type: "file"},
+		{Name: "README.md", Type: "file"},
+	}
+
+	results := (&Detector{}).Detect(files, "/project/analysis", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "analysis", payload.Name)
+	assert.Equal(t, []string{"/analysis/churn.ipynb", "/analysis/explore.ipynb"}, payload.Path)
+	assert.Equal(t, []string{"jupyter"}, payload.Tech)
+	assert.Contains(t, payload.Techs, "aws")
+	assert.Contains(t, payload.Techs, "openai")
+	assert.Contains(t, payload.Techs, "pytorch")
+	assert.Contains(t, payload.Reason, "openai matched notebook import: openai")
+	assert.Equal(t, []types.Dependency{
+		{Type: "python", Name: "pandas", Example: "2.2.1"},
+		{Type: "python", Name: "boto3"},
+	}, payload.Dependencies)
+
+	notebooks, ok := payload.Properties["notebooks"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, notebooks, 2)
+	notebook := notebooks[0].(*parsers.Notebook)
+	assert.Equal(t, "/analysis/churn.ipynb", notebook.File)
+	assert.Equal(t, "python3", notebook.Kernel.Name)
+}
+
+func TestDetector_Detect_NoNotebooks(t *testing.T) {
+	files := []types.File{{Name: "main.py", Type: "file"}, {Name: "notebooks.ipynb", Type: "dir"}}
+	results := (&Detector{}).Detect(files, "/project", "/project", &MockProvider{}, &MockDependencyDetector{})
+	assert.Empty(t, results)
+}
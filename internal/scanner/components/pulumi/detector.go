@@ -0,0 +1,69 @@
+package pulumi
+
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "pulumi"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if file.Name == "Pulumi.yaml" || file.Name == "Pulumi.yml" {
+			payload := d.detectPulumiProject(file, files, currentPath, basePath, provider)
+			if payload != nil {
+				results = append(results, payload)
+			}
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectPulumiProject(file types.File, files []types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	pulumiParser := parsers.NewPulumiParser()
+	project := pulumiParser.ParseProject(string(content))
+	if project == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	project.File = relativeFilePath
+
+	// Stacks are defined by Pulumi.<stack>.yaml files next to the project file
+	for _, stackFile := range files {
+		stack, isStack := pulumiParser.IsPulumiStackFile(stackFile.Name)
+		if !isStack {
+			continue
+		}
+		stackContent, err := provider.ReadFile(filepath.Join(currentPath, stackFile.Name))
+		if err != nil {
+			continue
+		}
+		pulumiParser.AddStack(project, stack, string(stackContent))
+	}
+
+	payload := types.NewPayloadWithPath(project.Name, relativeFilePath)
+	payload.AddPrimaryTech("pulumi")
+	payload.Properties["pulumi"] = project
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
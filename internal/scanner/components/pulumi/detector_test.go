@@ -0,0 +1,101 @@
+package pulumi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return nil
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "pulumi", detector.Name())
+}
+
+func TestDetector_Detect_ProjectWithStacks(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/infra/Pulumi.yaml":      "name: shop-infra\nruntime: nodejs\n",
+		"/mock/infra/Pulumi.prod.yaml": "config:\n  aws:region: eu-west-1\n  shop-infra:dbPassword:\n    secure: v1:abc\n",
+		"/mock/infra/Pulumi.dev.yaml":  "config:\n  aws:region: eu-central-1\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{
+		{Name: "Pulumi.yaml", Path: "/mock/infra/Pulumi.yaml"},
+		{Name: "Pulumi.prod.yaml", Path: "/mock/infra/Pulumi.prod.yaml"},
+		{Name: "Pulumi.dev.yaml", Path: "/mock/infra/Pulumi.dev.yaml"},
+		{Name: "index.ts", Path: "/mock/infra/index.ts"},
+	}
+	results := detector.Detect(files, "/mock/infra", "/mock", provider, &MockDependencyDetector{})
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "shop-infra", payload.Name)
+	assert.Equal(t, []string{"pulumi"}, payload.Tech)
+	assert.Equal(t, []string{"/infra/Pulumi.yaml"}, payload.Path)
+
+	project, ok := payload.Properties["pulumi"].(*parsers.PulumiProject)
+	require.True(t, ok)
+	assert.Equal(t, "nodejs", project.Runtime)
+	assert.Equal(t, []string{"dev", "prod"}, project.Stacks)
+	assert.Equal(t, []string{"aws:region", "shop-infra:dbPassword"}, project.ConfigKeys)
+	assert.Equal(t, []string{"aws"}, project.Providers)
+}
+
+func TestDetector_Detect_StackFileWithoutProject(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/Pulumi.prod.yaml": "config:\n  aws:region: eu-west-1\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "Pulumi.prod.yaml", Path: "/mock/Pulumi.prod.yaml"}}
+	results := detector.Detect(files, "/mock", "/mock", provider, &MockDependencyDetector{})
+
+	assert.Empty(t, results)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
@@ -75,6 +76,10 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 		// Detect license
 		detectLicense(string(content), payload)
 
+		// Python version from requires-python and .python-version
+		runtimeParser := parsers.NewRuntimeParser()
+		components.AddRuntimeVersions(payload, runtimeParser.ParsePyprojectRuntime(string(content)), files, currentPath, provider, "python")
+
 		payloads = append(payloads, payload)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -553,3 +554,20 @@ license = "MIT"
 		})
 	}
 }
+
+func TestDetector_Detect_RuntimeVersions(t *testing.T) {
+	detector := &Detector{}
+	depDetector := &MockDependencyDetector{}
+
+	provider := &MockProvider{files: map[string]string{
+		"/project/pyproject.toml":  "[project]\nname = \"api\"\nrequires-python = \">=3.10\"\n",
+		"/project/.python-version": "3.12.1\n",
+	}}
+
+	// .python-version wins over requires-python
+	results := detector.Detect([]types.File{{Name: "pyproject.toml"}, {Name: ".python-version"}}, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1)
+	assert.Equal(t, map[string]interface{}{
+		"python": parsers.RuntimeVersion{Version: "3.12.1", Source: ".python-version"},
+	}, results[0].Properties["runtime"])
+}
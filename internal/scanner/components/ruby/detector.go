@@ -20,7 +20,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	// Check for Gemfile (component - creates named payload)
 	for _, file := range files {
 		if file.Name == "Gemfile" {
-			payload := d.detectGemfile(file, currentPath, basePath, provider, depDetector)
+			payload := d.detectGemfile(file, files, currentPath, basePath, provider, depDetector)
 			if payload != nil {
 				results = append(results, payload)
 			}
@@ -30,7 +30,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	return results
 }
 
-func (d *Detector) detectGemfile(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectGemfile(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -79,6 +79,10 @@ func (d *Detector) detectGemfile(file types.File, currentPath, basePath string,
 		payload.Dependencies = dependencies
 	}
 
+	// Ruby version from the ruby directive and .ruby-version
+	runtimeParser := parsers.NewRuntimeParser()
+	components.AddRuntimeVersions(payload, runtimeParser.ParseGemfileRuntime(string(content)), files, currentPath, provider, "ruby")
+
 	return payload
 }
 
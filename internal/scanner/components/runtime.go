@@ -0,0 +1,88 @@
+package components
+
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// runtimeVersionFiles are the files pinning the version of a single runtime, in order of precedence
+var runtimeVersionFiles = map[string][]string{
+	"node":       {".nvmrc", ".node-version"},
+	"python":     {".python-version"},
+	"ruby":       {".ruby-version"},
+	"rust":       {"rust-toolchain.toml", "rust-toolchain"},
+	"dotnet-sdk": {"global.json"},
+}
+
+// runtimeToolFiles are the files of tool version managers pinning several runtimes
+var runtimeToolFiles = []string{".tool-versions", "mise.toml", ".mise.toml"}
+
+// AddRuntimeVersions sets the runtime property of a component from the versions declared by its manifest
+// and the version files of the given runtimes next to it. Pinned versions (.nvmrc, .tool-versions, ...) win
+// over manifest declarations (engines, requires-python, ...) because they select the runtime actually used
+func AddRuntimeVersions(payload *types.Payload, declared map[string]parsers.RuntimeVersion, files []types.File, currentPath string, provider types.Provider, runtimes ...string) {
+	versions := make(map[string]interface{})
+	for name, version := range declared {
+		versions[name] = version
+	}
+
+	for _, runtime := range runtimes {
+		if pinned, found := pinnedRuntimeVersion(runtime, files, currentPath, provider); found {
+			versions[runtime] = pinned
+		}
+	}
+
+	if len(versions) > 0 {
+		payload.MergeProperties(map[string]interface{}{"runtime": versions})
+	}
+}
+
+// pinnedRuntimeVersion returns the version of a runtime pinned by a version file or a tool version manager
+func pinnedRuntimeVersion(runtime string, files []types.File, currentPath string, provider types.Provider) (parsers.RuntimeVersion, bool) {
+	runtimeParser := parsers.NewRuntimeParser()
+
+	for _, name := range runtimeVersionFiles[runtime] {
+		content, found := readRuntimeFile(name, files, currentPath, provider)
+		if !found {
+			continue
+		}
+		if version := runtimeParser.ParseVersionFile(name, content); version != "" {
+			return parsers.RuntimeVersion{Version: version, Source: name}, true
+		}
+	}
+
+	for _, name := range runtimeToolFiles {
+		content, found := readRuntimeFile(name, files, currentPath, provider)
+		if !found {
+			continue
+		}
+		var versions map[string]string
+		switch name {
+		case ".tool-versions":
+			versions = runtimeParser.ParseToolVersions(content)
+		default:
+			versions = runtimeParser.ParseMiseToml(content)
+		}
+		if version := versions[runtime]; version != "" {
+			return parsers.RuntimeVersion{Version: version, Source: name}, true
+		}
+	}
+
+	return parsers.RuntimeVersion{}, false
+}
+
+func readRuntimeFile(name string, files []types.File, currentPath string, provider types.Provider) (string, bool) {
+	for _, file := range files {
+		if file.Name != name {
+			continue
+		}
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil {
+			return "", false
+		}
+		return string(content), true
+	}
+	return "", false
+}
@@ -20,7 +20,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	// Check for Cargo.toml
 	for _, file := range files {
 		if file.Name == "Cargo.toml" {
-			payload := d.detectCargoToml(file, currentPath, basePath, provider, depDetector)
+			payload := d.detectCargoToml(file, files, currentPath, basePath, provider, depDetector)
 			if payload != nil {
 				results = append(results, payload)
 			}
@@ -30,7 +30,7 @@ func (d *Detector) Detect(files []types.File, currentPath, basePath string, prov
 	return results
 }
 
-func (d *Detector) detectCargoToml(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+func (d *Detector) detectCargoToml(file types.File, files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
 	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
 	if err != nil {
 		return nil
@@ -91,6 +91,10 @@ func (d *Detector) detectCargoToml(file types.File, currentPath, basePath string
 		}
 	}
 
+	// Minimum Rust version from rust-version, toolchain from rust-toolchain.toml
+	runtimeParser := parsers.NewRuntimeParser()
+	components.AddRuntimeVersions(payload, runtimeParser.ParseCargoRuntime(string(content)), files, currentPath, provider, "rust")
+
 	return payload
 }
 
@@ -0,0 +1,85 @@
+package serverless
+
+import (
+	"path/filepath"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "serverless"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if file.Name == "serverless.yml" || file.Name == "serverless.yaml" {
+			payload := d.detectServerless(file, currentPath, basePath, provider, depDetector)
+			if payload != nil {
+				results = append(results, payload)
+			}
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectServerless(file types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	serverlessParser := parsers.NewServerlessParser()
+	service := serverlessParser.ParseServerless(string(content))
+	if service == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	service.File = relativeFilePath
+
+	payload := types.NewPayloadWithPath(service.Service, relativeFilePath)
+	payload.AddPrimaryTech("serverless")
+	payload.Properties["serverless"] = service
+
+	// The provider determines the function platform (aws -> aws.lambda)
+	if service.Provider != "" {
+		matchedTechs := depDetector.MatchDependencies([]string{service.Provider}, "serverless.provider")
+		for tech, reasons := range matchedTechs {
+			for _, reason := range reasons {
+				payload.AddTech(tech, reason)
+			}
+		}
+	}
+
+	// Record each event source type, the functions it triggers are in the serverless property (event_functions)
+	eventTypes := service.EventTypes()
+	for _, eventType := range eventTypes {
+		payload.AddDependency(types.Dependency{
+			Type: "serverless.event",
+			Name: eventType,
+		})
+	}
+
+	// Event source names are provider specific, rules map the AWS ones (sqs -> aws.sqs)
+	if service.Provider == "aws" && len(eventTypes) > 0 {
+		matchedTechs := depDetector.MatchDependencies(eventTypes, "serverless.event")
+		for tech, reasons := range matchedTechs {
+			for _, reason := range reasons {
+				payload.AddTech(tech, reason)
+			}
+		}
+	}
+
+	return payload
+}
+
+func init() {
+	components.Register(&Detector{})
+}
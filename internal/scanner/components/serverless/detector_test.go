@@ -0,0 +1,137 @@
+package serverless
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by exact name per dependency type
+type MockDependencyDetector struct {
+	techs map[string]map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		if tech, exists := m.techs[depType][dep]; exists {
+			result[tech] = append(result[tech], "matched: "+dep)
+		}
+	}
+	return result
+}
+
+var awsRules = &MockDependencyDetector{techs: map[string]map[string]string{
+	"serverless.provider": {"aws": "aws.lambda"},
+	"serverless.event":    {"sqs": "aws.sqs", "s3": "aws.s3", "http": "aws.apigateway"},
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "serverless", detector.Name())
+}
+
+func TestDetector_Detect_AWSService(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/orders/serverless.yml": `service: orders
+provider:
+  name: aws
+  runtime: python3.12
+plugins:
+  - serverless-python-requirements
+functions:
+  api:
+    handler: api.handler
+    events:
+      - http: POST /orders
+  worker:
+    handler: worker.handler
+    events:
+      - sqs: arn:aws:sqs:eu-west-1:123456789012:orders
+      - s3: uploads
+      - schedule: rate(5 minutes)
+`,
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "serverless.yml", Path: "/mock/orders/serverless.yml"}}
+	results := detector.Detect(files, "/mock/orders", "/mock", provider, awsRules)
+
+	require.Len(t, results, 1)
+	payload := results[0]
+	assert.Equal(t, "orders", payload.Name)
+	assert.Equal(t, []string{"serverless"}, payload.Tech)
+	for _, tech := range []string{"aws.lambda", "aws.sqs", "aws.s3", "aws.apigateway"} {
+		assert.Contains(t, payload.Techs, tech)
+	}
+
+	assert.Equal(t, []types.Dependency{
+		{Type: "serverless.event", Name: "http"},
+		{Type: "serverless.event", Name: "s3"},
+		{Type: "serverless.event", Name: "schedule"},
+		{Type: "serverless.event", Name: "sqs"},
+	}, payload.Dependencies)
+
+	service, ok := payload.Properties["serverless"].(*parsers.ServerlessService)
+	require.True(t, ok)
+	assert.Equal(t, "/orders/serverless.yml", service.File)
+	assert.Equal(t, "python3.12", service.Runtime)
+	assert.Equal(t, []string{"serverless-python-requirements"}, service.Plugins)
+	assert.Len(t, service.Functions, 2)
+}
+
+func TestDetector_Detect_NonAWSEventsNotMapped(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/serverless.yml": "service: fn\nprovider:\n  name: azure\nfunctions:\n  hello:\n    handler: hello\n    events:\n      - http: true\n",
+	}}
+
+	detector := &Detector{}
+	files := []types.File{{Name: "serverless.yml", Path: "/mock/serverless.yml"}}
+	results := detector.Detect(files, "/mock", "/mock", provider, awsRules)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"serverless"}, results[0].Tech)
+	assert.NotContains(t, results[0].Techs, "aws.apigateway")
+	assert.Len(t, results[0].Dependencies, 1)
+}
@@ -0,0 +1,57 @@
+package shell
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "shell"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		isMakefile := isMakefile(file.Name)
+		ext := strings.ToLower(filepath.Ext(file.Name))
+		if !isMakefile && ext != ".sh" && ext != ".bash" {
+			continue
+		}
+
+		content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil {
+			continue
+		}
+
+		script := string(content)
+		if isMakefile {
+			shellParser := parsers.NewShellParser()
+			script = shellParser.ParseMakefileRecipes(script)
+		}
+
+		payload := types.NewPayloadWithPath("virtual", components.RelativePath(basePath, currentPath, file.Name))
+
+		// Scripts only contribute the tools they invoke
+		if components.AddCommandDependencies(payload, []string{script}, depDetector) {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+// isMakefile reports whether a file is a Makefile or an included makefile (*.mk)
+func isMakefile(name string) bool {
+	return name == "Makefile" || name == "makefile" || name == "GNUmakefile" || strings.HasSuffix(name, ".mk")
+}
+
+func init() {
+	components.Register(&Detector{})
+}
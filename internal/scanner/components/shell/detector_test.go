@@ -0,0 +1,96 @@
+package shell
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+// Dependencies are matched by exact name, like the command rules
+type MockDependencyDetector struct {
+	names map[string]string
+}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	result := make(map[string][]string)
+	for _, dep := range dependencies {
+		if tech, exists := m.names[dep]; exists {
+			result[tech] = append(result[tech], "matched: "+dep)
+		}
+	}
+	return result
+}
+
+var commandRules = &MockDependencyDetector{names: map[string]string{
+	"kubectl":       "kubernetes",
+	"docker buildx": "docker",
+	"psql":          "postgresql",
+}}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "shell", detector.Name())
+}
+
+func TestDetector_Detect_ScriptsAndMakefiles(t *testing.T) {
+	provider := &MockProvider{files: map[string]string{
+		"/mock/scripts/deploy.sh": "#!/bin/sh\nset -e\nkubectl apply -f k8s/\n",
+		"/mock/Makefile":          "image:\n\tdocker buildx build -t app .\n\nmigrate:\n\t@psql -f schema.sql\n",
+		"/mock/scripts/hello.sh":  "echo hello\n",
+	}}
+	detector := &Detector{}
+
+	results := detector.Detect([]types.File{{Name: "deploy.sh"}, {Name: "hello.sh"}, {Name: "README.md"}}, "/mock/scripts", "/mock", provider, commandRules)
+	assert.Len(t, results, 1, "scripts without known tools produce no payload")
+	assert.Equal(t, []string{"/scripts/deploy.sh"}, results[0].Path)
+	assert.Equal(t, []types.Dependency{{Type: "command", Name: "kubectl"}}, results[0].Dependencies)
+	assert.Contains(t, results[0].Techs, "kubernetes")
+
+	results = detector.Detect([]types.File{{Name: "Makefile"}}, "/mock", "/mock", provider, commandRules)
+	assert.Len(t, results, 1)
+	assert.Equal(t, []types.Dependency{
+		{Type: "command", Name: "docker buildx"},
+		{Type: "command", Name: "psql"},
+	}, results[0].Dependencies)
+	assert.Contains(t, results[0].Techs, "docker")
+	assert.Contains(t, results[0].Techs, "postgresql")
+}
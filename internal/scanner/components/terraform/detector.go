@@ -3,6 +3,7 @@ package terraform
 import (
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
 	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
@@ -49,7 +50,7 @@ func (d *Detector) detectTerraformLock(file types.File, currentPath, basePath st
 	}
 
 	// Parse terraform lock file using parser
-	terraformParser := parsers.NewTerraformParser()
+	terraformParser := parsers.NewTerraformParserWithCategorizer(components.TerraformCategorizer(depDetector))
 	providers := terraformParser.ParseTerraformLock(string(content))
 
 	if len(providers) == 0 {
@@ -135,10 +136,11 @@ func (d *Detector) detectTerraformResource(file types.File, currentPath, basePat
 	}
 
 	// Parse terraform resource file using parser - get full resource information
-	terraformParser := parsers.NewTerraformParser()
+	terraformParser := parsers.NewTerraformParserWithCategorizer(components.TerraformCategorizer(depDetector))
 	resources := terraformParser.ParseTerraformResources(string(content))
+	config := terraformParser.ParseTerraformConfig(string(content))
 
-	if len(resources) == 0 {
+	if len(resources) == 0 && config.IsEmpty() {
 		return nil
 	}
 
@@ -151,8 +153,9 @@ func (d *Detector) detectTerraformResource(file types.File, currentPath, basePat
 	}
 	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
 
-	// Aggregate resources into TerraformInfo
+	// Aggregate resources and configuration into TerraformInfo
 	terraformInfo := terraformParser.AggregateTerraformResources(resources)
+	terraformInfo = terraformParser.ApplyTerraformConfig(terraformInfo, config)
 	if terraformInfo != nil {
 		terraformInfo.File = relativeFilePath
 		// Add Terraform info to properties as array (Properties already initialized by NewPayloadWithPath)
@@ -211,12 +214,45 @@ func (d *Detector) detectTerraformResource(file types.File, currentPath, basePat
 		payload.AddChild(childPayload)
 	}
 
+	// Add modules as dependencies and link local modules to their component
+	if config != nil {
+		for _, module := range config.Modules {
+			dependencies = append(dependencies, types.Dependency{
+				Type:    "terraform-module",
+				Name:    module.Source,
+				Example: module.Version,
+			})
+
+			if module.SourceType == "local" {
+				d.addLocalModule(payload, module, currentPath, basePath)
+			}
+		}
+	}
+
 	// Set dependencies on parent payload
 	payload.Dependencies = dependencies
 
 	return []*types.Payload{payload}
 }
 
+// addLocalModule creates a component for a local-path module and links it with an edge
+func (d *Detector) addLocalModule(payload *types.Payload, module parsers.TerraformModule, currentPath, basePath string) {
+	modulePath := filepath.Join(currentPath, module.Source)
+	relativeModulePath, err := filepath.Rel(basePath, modulePath)
+	if err != nil || relativeModulePath == "." || strings.HasPrefix(relativeModulePath, "..") {
+		return // Module outside of the scanned tree
+	}
+
+	// Name by module directory so that modules referenced from several places are merged
+	modulePayload := types.NewPayloadWithPath(filepath.ToSlash(relativeModulePath), "/"+filepath.ToSlash(relativeModulePath))
+	modulePayload.AddPrimaryTech("terraform")
+	modulePayload.AddTech("terraform", "terraform module: "+module.Name)
+	modulePayload.Properties["terraform_module"] = module
+
+	child := payload.AddChild(modulePayload)
+	payload.AddEdges(child)
+}
+
 func init() {
 	components.Register(&Detector{})
 }
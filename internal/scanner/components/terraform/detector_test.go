@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -546,3 +547,68 @@ func TestDetector_Detect_LargeTfFile(t *testing.T) {
 	// Verify no results due to file size limit
 	assert.Empty(t, results, "Should not detect large files over 500KB")
 }
+
+func TestDetector_Detect_ModulesAndConfig(t *testing.T) {
+	detector := &Detector{}
+
+	tfContent := `terraform {
+  required_version = ">= 1.5"
+  backend "gcs" {}
+}
+
+module "app" {
+  source = "./modules/app"
+}
+
+module "outside" {
+  source = "../../shared"
+}
+
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
+`
+
+	provider := &MockProvider{
+		files: map[string]string{
+			"/project/main.tf": tfContent,
+		},
+	}
+	depDetector := &MockDependencyDetector{matchedTechs: map[string][]string{}}
+
+	files := []types.File{
+		{Name: "main.tf", Path: "/project/main.tf"},
+	}
+
+	results := detector.Detect(files, "/project", "/project", provider, depDetector)
+	require.Len(t, results, 1, "A file with only modules should still be detected")
+
+	payload := results[0]
+	terraformProps, ok := payload.Properties["terraform"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, terraformProps, 1)
+
+	info := terraformProps[0].(*parsers.TerraformInfo)
+	assert.Equal(t, ">= 1.5", info.RequiredVersion)
+	assert.Equal(t, "gcs", info.Backend)
+	assert.Len(t, info.Modules, 3)
+
+	// Only the local module inside the scanned tree becomes a component
+	require.Len(t, payload.Childs, 1)
+	module := payload.Childs[0]
+	assert.Equal(t, "modules/app", module.Name)
+	assert.Equal(t, []string{"/modules/app"}, module.Path)
+	assert.Equal(t, []string{"terraform"}, module.Tech)
+
+	require.Len(t, payload.Edges, 1)
+	assert.Same(t, module, payload.Edges[0].Target)
+
+	moduleDeps := 0
+	for _, dep := range payload.Dependencies {
+		if dep.Type == "terraform-module" {
+			moduleDeps++
+		}
+	}
+	assert.Equal(t, 3, moduleDeps)
+}
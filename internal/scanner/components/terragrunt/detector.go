@@ -0,0 +1,122 @@
+package terragrunt
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/components"
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+type Detector struct{}
+
+func (d *Detector) Name() string {
+	return "terragrunt"
+}
+
+func (d *Detector) Detect(files []types.File, currentPath, basePath string, provider types.Provider, depDetector components.DependencyDetector) []*types.Payload {
+	var results []*types.Payload
+
+	for _, file := range files {
+		if file.Name == "terragrunt.hcl" {
+			payload := d.detectTerragrunt(file, currentPath, basePath, provider)
+			if payload != nil {
+				results = append(results, payload)
+			}
+		}
+	}
+
+	return results
+}
+
+func (d *Detector) detectTerragrunt(file types.File, currentPath, basePath string, provider types.Provider) *types.Payload {
+	content, err := provider.ReadFile(filepath.Join(currentPath, file.Name))
+	if err != nil {
+		return nil
+	}
+
+	terragruntParser := parsers.NewTerragruntParser()
+	config := terragruntParser.ParseTerragrunt(string(content))
+	if config == nil {
+		return nil
+	}
+
+	relativeFilePath := components.RelativePath(basePath, currentPath, file.Name)
+	config.File = relativeFilePath
+
+	// Shared root configurations (remote_state, generate blocks) only contribute the tech
+	if !config.IsUnit() {
+		payload := types.NewPayloadWithPath("virtual", relativeFilePath)
+		payload.AddTech("terragrunt", "matched file: "+file.Name)
+		payload.Properties["terragrunt"] = []interface{}{config}
+		return payload
+	}
+
+	// Units are named by their directory, which is how Terragrunt addresses them
+	relativeDir, _ := filepath.Rel(basePath, currentPath)
+	unitName := filepath.ToSlash(relativeDir)
+	if unitName == "." {
+		unitName = filepath.Base(currentPath)
+	}
+
+	payload := types.NewPayloadWithPath(unitName, relativeFilePath)
+	payload.AddPrimaryTech("terragrunt")
+	payload.AddTech("terraform", "terragrunt unit: "+unitName)
+	payload.Properties["terragrunt"] = []interface{}{config}
+
+	// Record the deployed module source
+	if config.Source != "" {
+		payload.AddDependency(types.Dependency{
+			Type:    "terraform-module",
+			Name:    config.Source,
+			Example: config.SourceVersion,
+		})
+		if config.SourceType == "local" {
+			if ref := resolveUnitPath(config.Source, currentPath, basePath); ref != "" {
+				payload.AddEdgeRef(ref)
+			}
+		}
+	}
+
+	// Link to the units this one depends on once the whole tree is scanned
+	for _, dependency := range config.Dependencies {
+		if ref := resolveUnitPath(dependency.ConfigPath, currentPath, basePath); ref != "" {
+			payload.AddEdgeRef(ref)
+		}
+	}
+
+	return payload
+}
+
+// resolveUnitPath converts a path relative to the unit into a scan-root relative directory
+// Returns "" for paths that are computed at runtime or point outside of the scanned tree
+func resolveUnitPath(configPath, currentPath, basePath string) string {
+	if configPath == "" || strings.ContainsAny(configPath, "${}()") {
+		return ""
+	}
+
+	// Terragrunt uses // to separate the module root from the subdirectory
+	configPath = strings.ReplaceAll(configPath, "//", "/")
+	if strings.HasSuffix(configPath, ".hcl") {
+		configPath = filepath.Dir(configPath)
+	}
+
+	target := configPath
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(currentPath, configPath)
+	}
+
+	relative, err := filepath.Rel(basePath, target)
+	if err != nil || strings.HasPrefix(relative, "..") {
+		return ""
+	}
+	if relative == "." {
+		return "/"
+	}
+	return "/" + filepath.ToSlash(relative)
+}
+
+func init() {
+	components.Register(&Detector{})
+}
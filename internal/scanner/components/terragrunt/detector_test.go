@@ -0,0 +1,135 @@
+package terragrunt
+
+import (
+	"os"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProvider implements types.Provider for testing
+type MockProvider struct {
+	files map[string]string
+}
+
+func (m *MockProvider) ReadFile(path string) ([]byte, error) {
+	if content, exists := m.files[path]; exists {
+		return []byte(content), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (m *MockProvider) ListDir(path string) ([]types.File, error) {
+	return nil, nil
+}
+
+func (m *MockProvider) Open(path string) (string, error) {
+	if content, exists := m.files[path]; exists {
+		return content, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (m *MockProvider) Exists(path string) (bool, error) {
+	_, exists := m.files[path]
+	return exists, nil
+}
+
+func (m *MockProvider) IsDir(path string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockProvider) GetBasePath() string {
+	return "/mock"
+}
+
+// MockDependencyDetector implements components.DependencyDetector for testing
+type MockDependencyDetector struct{}
+
+func (m *MockDependencyDetector) MatchDependencies(dependencies []string, depType string) map[string][]string {
+	return map[string][]string{}
+}
+
+func TestDetector_Name(t *testing.T) {
+	detector := &Detector{}
+	assert.Equal(t, "terragrunt", detector.Name())
+}
+
+func TestDetector_Detect_Unit(t *testing.T) {
+	detector := &Detector{}
+
+	provider := &MockProvider{
+		files: map[string]string{
+			"/project/live/prod/app/terragrunt.hcl": `include "root" {
+  path = find_in_parent_folders()
+}
+
+terraform {
+  source = "../../../modules//app"
+}
+
+dependency "vpc" {
+  config_path = "../vpc"
+}
+
+dependencies {
+  paths = ["../mysql/terragrunt.hcl", "${get_terragrunt_dir()}/../redis", "../../../../outside"]
+}
+`,
+		},
+	}
+
+	files := []types.File{{Name: "terragrunt.hcl", Path: "/project/live/prod/app/terragrunt.hcl"}}
+	results := detector.Detect(files, "/project/live/prod/app", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	unit := results[0]
+	assert.Equal(t, "live/prod/app", unit.Name)
+	assert.Equal(t, []string{"/live/prod/app/terragrunt.hcl"}, unit.Path)
+	assert.Equal(t, []string{"terragrunt"}, unit.Tech)
+	assert.Contains(t, unit.Techs, "terraform")
+
+	require.Len(t, unit.Dependencies, 1)
+	assert.Equal(t, types.Dependency{Type: "terraform-module", Name: "../../../modules//app"}, unit.Dependencies[0])
+
+	// Local module source plus the static dependency paths inside the tree
+	assert.Equal(t, []string{"/modules/app", "/live/prod/vpc", "/live/prod/mysql"}, unit.EdgeRefs)
+
+	props, ok := unit.Properties["terragrunt"].([]interface{})
+	require.True(t, ok)
+	config := props[0].(*parsers.TerragruntConfig)
+	assert.Equal(t, "/live/prod/app/terragrunt.hcl", config.File)
+	assert.Len(t, config.Dependencies, 4)
+}
+
+func TestDetector_Detect_RootConfig(t *testing.T) {
+	detector := &Detector{}
+
+	provider := &MockProvider{
+		files: map[string]string{
+			"/project/terragrunt.hcl": `remote_state {
+  backend = "gcs"
+  config  = {}
+}`,
+		},
+	}
+
+	files := []types.File{{Name: "terragrunt.hcl", Path: "/project/terragrunt.hcl"}}
+	results := detector.Detect(files, "/project", "/project", provider, &MockDependencyDetector{})
+	require.Len(t, results, 1)
+
+	payload := results[0]
+	assert.Equal(t, "virtual", payload.Name)
+	assert.Contains(t, payload.Techs, "terragrunt")
+	assert.Empty(t, payload.Tech)
+}
+
+func TestDetector_Detect_NoTerragruntFiles(t *testing.T) {
+	detector := &Detector{}
+	files := []types.File{{Name: "main.tf", Path: "/project/main.tf"}}
+	results := detector.Detect(files, "/project", "/project", &MockProvider{}, &MockDependencyDetector{})
+	assert.Empty(t, results)
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
@@ -17,6 +18,9 @@ type DependencyMatcher struct {
 // DependencyDetector handles dependency-based technology detection
 type DependencyDetector struct {
 	matchers map[string][]*DependencyMatcher // keyed by dependency type (npm, python, etc.)
+
+	// terraformCategorizer categorizes Terraform and CloudFormation resources of the scan, see TerraformCategorizer
+	terraformCategorizer *parsers.TerraformCategorizer
 }
 
 // NewDependencyDetector creates a new dependency detector
@@ -57,6 +61,12 @@ func NewDependencyDetector(rules []types.Rule) *DependencyDetector {
 	return detector
 }
 
+// TerraformCategorizer returns the Terraform resource categorizer of the scan, nil before the scan configured it.
+// Detectors retrieve it with components.TerraformCategorizer
+func (d *DependencyDetector) TerraformCategorizer() *parsers.TerraformCategorizer {
+	return d.terraformCategorizer
+}
+
 // MatchDependencies matches a list of package names against dependency patterns
 func (d *DependencyDetector) MatchDependencies(packages []string, depType string) map[string][]string {
 	matched := make(map[string][]string)
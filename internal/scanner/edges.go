@@ -0,0 +1,84 @@
+package scanner
+
+import (
+	"path"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// resolveEdgeRefs turns directory and name references collected during the scan (Payload.EdgeRefs,
+// Payload.ComponentRefs) into edges between components, once every component in the tree is known
+func resolveEdgeRefs(root *types.Payload) {
+	componentsByDir := make(map[string]*types.Payload)
+	indexComponentPaths(root, componentsByDir)
+	indexComponentDirs(root, componentsByDir)
+
+	componentsByName := make(map[string]*types.Payload)
+	for _, child := range root.Childs {
+		indexComponentNames(child, componentsByName)
+	}
+
+	linkEdgeRefs(root, componentsByDir, componentsByName)
+}
+
+// indexComponentPaths maps the primary path of each component to the component
+// Only the first path is used, later ones are merged in from virtual payloads of other files.
+// The first component found wins (parents are visited before children)
+func indexComponentPaths(payload *types.Payload, index map[string]*types.Payload) {
+	if len(payload.Path) > 0 {
+		if _, exists := index[payload.Path[0]]; !exists {
+			index[payload.Path[0]] = payload
+		}
+	}
+
+	for _, child := range payload.Childs {
+		indexComponentPaths(child, index)
+	}
+}
+
+// indexComponentDirs maps the directory of each component file (e.g. /live/vpc for
+// /live/vpc/terragrunt.hcl) to the component, unless a component has that exact path
+func indexComponentDirs(payload *types.Payload, index map[string]*types.Payload) {
+	if len(payload.Path) > 0 {
+		dir := path.Dir(payload.Path[0])
+		if _, exists := index[dir]; !exists {
+			index[dir] = payload
+		}
+	}
+
+	for _, child := range payload.Childs {
+		indexComponentDirs(child, index)
+	}
+}
+
+// indexComponentNames maps the name of each component below the root to the component (the first one found wins)
+func indexComponentNames(payload *types.Payload, index map[string]*types.Payload) {
+	if _, exists := index[payload.Name]; !exists {
+		index[payload.Name] = payload
+	}
+
+	for _, child := range payload.Childs {
+		indexComponentNames(child, index)
+	}
+}
+
+func linkEdgeRefs(payload *types.Payload, dirIndex, nameIndex map[string]*types.Payload) {
+	for _, ref := range payload.EdgeRefs {
+		target, exists := dirIndex[ref]
+		if !exists || target == payload {
+			continue
+		}
+		payload.AddEdge(types.Edge{Target: target, Read: true, Write: true})
+	}
+	for _, ref := range payload.ComponentRefs {
+		target, exists := nameIndex[ref]
+		if !exists || target == payload {
+			continue
+		}
+		payload.AddEdge(types.Edge{Target: target, Read: true, Write: true})
+	}
+
+	for _, child := range payload.Childs {
+		linkEdgeRefs(child, dirIndex, nameIndex)
+	}
+}
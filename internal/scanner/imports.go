@@ -0,0 +1,99 @@
+package scanner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/scanner/parsers"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// maxImportFileSize skips bundled or generated sources larger than 1MB
+const maxImportFileSize = 1_000_000
+
+// ImportMatcher matches the imports of source files against the dependency rules (opt-in, see --scan-imports)
+// Imports are weaker evidence than manifests: a module may be vendored, optional or only referenced in dead code
+type ImportMatcher struct {
+	parser      *parsers.ImportParser
+	depDetector *DependencyDetector
+	mavenGroups map[string]string   // Maven group id -> tech, for group ids used by a single tech
+	cache       map[string][]string // dependency type + module -> matched techs
+}
+
+// NewImportMatcher creates an import matcher for the dependency rules
+func NewImportMatcher(rules []types.Rule, depDetector *DependencyDetector) *ImportMatcher {
+	// Java and Kotlin packages are matched against the group ids of Maven coordinates
+	groupTechs := make(map[string]map[string]bool)
+	for _, rule := range rules {
+		for _, dep := range rule.Dependencies {
+			group, _, found := strings.Cut(dep.Name, ":")
+			if dep.Type != "maven" || !found || strings.HasPrefix(dep.Name, "/") || strings.Count(group, ".") < 1 {
+				continue
+			}
+			if groupTechs[group] == nil {
+				groupTechs[group] = make(map[string]bool)
+			}
+			groupTechs[group][rule.Tech] = true
+		}
+	}
+	mavenGroups := make(map[string]string)
+	for group, techs := range groupTechs {
+		if len(techs) != 1 {
+			continue // Shared group ids (com.azure, com.google.cloud) do not identify a tech
+		}
+		for tech := range techs {
+			mavenGroups[group] = tech
+		}
+	}
+
+	return &ImportMatcher{
+		parser:      parsers.NewImportParser(),
+		depDetector: depDetector,
+		mavenGroups: mavenGroups,
+		cache:       make(map[string][]string),
+	}
+}
+
+// MatchFile returns the techs of the modules imported by a source file with a reason per tech
+func (m *ImportMatcher) MatchFile(fileName string, content []byte) map[string][]string {
+	depType := m.parser.ImportDependencyType(fileName)
+	if depType == "" || len(content) > maxImportFileSize {
+		return nil
+	}
+
+	matched := make(map[string][]string)
+	for _, imp := range m.parser.ParseImports(fileName, string(content)) {
+		for _, tech := range m.matchImport(depType, imp) {
+			matched[tech] = append(matched[tech], tech+" matched import: "+imp.Module+" (low confidence)")
+		}
+	}
+	return matched
+}
+
+// matchImport returns the techs of the most specific candidate of an import matching a rule
+func (m *ImportMatcher) matchImport(depType string, imp parsers.Import) []string {
+	key := depType + " " + imp.Module
+	if techs, cached := m.cache[key]; cached {
+		return techs
+	}
+
+	var techs []string
+	for _, candidate := range imp.Candidates {
+		if depType == "maven" {
+			if tech, exists := m.mavenGroups[candidate]; exists {
+				techs = []string{tech}
+			}
+		} else {
+			for tech := range m.depDetector.MatchDependencies([]string{candidate}, depType) {
+				techs = append(techs, tech)
+			}
+			sort.Strings(techs)
+		}
+		if len(techs) > 0 {
+			break
+		}
+	}
+
+	m.cache[key] = techs
+	return techs
+}
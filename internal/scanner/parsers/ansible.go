@@ -0,0 +1,319 @@
+package parsers
+
+import (
+	"bufio"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnsibleParser handles Ansible playbook, task, requirements, galaxy and ansible.cfg parsing
+type AnsibleParser struct{}
+
+// NewAnsibleParser creates a new Ansible parser
+func NewAnsibleParser() *AnsibleParser {
+	return &AnsibleParser{}
+}
+
+// AnsibleRequirement represents a role or collection from requirements.yml or galaxy.yml dependencies
+type AnsibleRequirement struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"` // role or collection
+	Version string `json:"version,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// AnsibleInfo represents information extracted from a single Ansible file
+type AnsibleInfo struct {
+	File string `json:"file,omitempty"`
+	Kind string `json:"kind"` // playbook, tasks, handlers, requirements, collection, config
+
+	// Playbooks, role tasks and handlers
+	Role    string   `json:"role,omitempty"`
+	Plays   int      `json:"plays,omitempty"`
+	Hosts   []string `json:"hosts,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Modules []string `json:"modules,omitempty"`
+	Imports []string `json:"imports,omitempty"` // Playbooks included with import_playbook
+
+	// requirements.yml and galaxy.yml
+	Collection   string               `json:"collection,omitempty"` // namespace.name of galaxy.yml
+	Version      string               `json:"version,omitempty"`
+	Requirements []AnsibleRequirement `json:"requirements,omitempty"`
+
+	// ansible.cfg
+	Inventory       string `json:"inventory,omitempty"`
+	RolesPath       string `json:"roles_path,omitempty"`
+	CollectionsPath string `json:"collections_path,omitempty"`
+}
+
+// ansibleTaskKeywords are task keys that are not module names
+var ansibleTaskKeywords = map[string]bool{
+	"name": true, "when": true, "register": true, "loop": true, "loop_control": true,
+	"become": true, "become_user": true, "become_method": true, "become_flags": true,
+	"tags": true, "vars": true, "notify": true, "listen": true, "ignore_errors": true,
+	"ignore_unreachable": true, "changed_when": true, "failed_when": true,
+	"delegate_to": true, "delegate_facts": true, "run_once": true, "until": true,
+	"retries": true, "delay": true, "environment": true, "args": true, "no_log": true,
+	"check_mode": true, "diff": true, "async": true, "poll": true, "throttle": true,
+	"any_errors_fatal": true, "module_defaults": true, "collections": true,
+	"connection": true, "debugger": true, "timeout": true, "remote_user": true,
+	"block": true, "rescue": true, "always": true, "local_action": true, "action": true,
+}
+
+// ansiblePlayTaskLists are play keys holding task lists
+var ansiblePlayTaskLists = []string{"pre_tasks", "tasks", "post_tasks", "handlers"}
+
+// IsPlaybookCandidate performs a cheap content check before parsing a YAML file as playbook
+func (p *AnsibleParser) IsPlaybookCandidate(content string) bool {
+	return strings.Contains(content, "hosts:") || strings.Contains(content, "import_playbook")
+}
+
+// ParsePlaybook parses a playbook, a list of plays with hosts or import_playbook entries
+// Returns nil if the content is not a playbook
+func (p *AnsibleParser) ParsePlaybook(content string) *AnsibleInfo {
+	plays := parseYAMLSequence(content)
+	if plays == nil {
+		return nil
+	}
+
+	info := &AnsibleInfo{Kind: "playbook"}
+	modules := make(map[string]bool)
+
+	for _, play := range plays.Content {
+		if play.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		hosts := yamlMappingValue(play, "hosts")
+		if hosts == nil {
+			imported := yamlScalar(play, "import_playbook")
+			if imported == "" {
+				imported = yamlScalar(play, "ansible.builtin.import_playbook")
+			}
+			if imported == "" {
+				return nil // Neither a play nor an import, so not a playbook
+			}
+			info.Imports = append(info.Imports, imported)
+			continue
+		}
+
+		info.Plays++
+		for _, host := range scalarValues(hosts) {
+			info.Hosts = appendUniqueSorted(info.Hosts, host)
+		}
+
+		if roles := yamlMappingValue(play, "roles"); roles != nil && roles.Kind == yaml.SequenceNode {
+			for _, role := range roles.Content {
+				if name := ansibleRoleName(role); name != "" {
+					info.Roles = appendUniqueSorted(info.Roles, name)
+				}
+			}
+		}
+
+		for _, key := range ansiblePlayTaskLists {
+			collectAnsibleModules(yamlMappingValue(play, key), modules)
+		}
+	}
+
+	if info.Plays == 0 && len(info.Imports) == 0 {
+		return nil
+	}
+
+	info.Modules = sortedKeys(modules)
+	return info
+}
+
+// ParseTasks parses a task file (roles/<role>/tasks/*.yml or handlers/*.yml)
+func (p *AnsibleParser) ParseTasks(content string) *AnsibleInfo {
+	tasks := parseYAMLSequence(content)
+	if tasks == nil {
+		return nil
+	}
+
+	modules := make(map[string]bool)
+	collectAnsibleModules(tasks, modules)
+
+	return &AnsibleInfo{Kind: "tasks", Modules: sortedKeys(modules)}
+}
+
+// ParseRequirements parses requirements.yml in the current ({roles, collections}) or legacy (list of roles) format
+func (p *AnsibleParser) ParseRequirements(content string) *AnsibleInfo {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil || len(root.Content) == 0 {
+		return nil
+	}
+	doc := root.Content[0]
+
+	info := &AnsibleInfo{Kind: "requirements"}
+	switch doc.Kind {
+	case yaml.SequenceNode:
+		info.Requirements = parseAnsibleRequirements(doc, "role")
+	case yaml.MappingNode:
+		info.Requirements = append(parseAnsibleRequirements(yamlMappingValue(doc, "roles"), "role"),
+			parseAnsibleRequirements(yamlMappingValue(doc, "collections"), "collection")...)
+	}
+
+	if len(info.Requirements) == 0 {
+		return nil
+	}
+	return info
+}
+
+// ParseGalaxy parses a collection's galaxy.yml
+func (p *AnsibleParser) ParseGalaxy(content string) *AnsibleInfo {
+	var galaxy struct {
+		Namespace    string            `yaml:"namespace"`
+		Name         string            `yaml:"name"`
+		Version      string            `yaml:"version"`
+		Dependencies map[string]string `yaml:"dependencies"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &galaxy); err != nil || galaxy.Namespace == "" || galaxy.Name == "" {
+		return nil
+	}
+
+	info := &AnsibleInfo{
+		Kind:       "collection",
+		Collection: galaxy.Namespace + "." + galaxy.Name,
+		Version:    galaxy.Version,
+	}
+	names := make([]string, 0, len(galaxy.Dependencies))
+	for name := range galaxy.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		info.Requirements = append(info.Requirements, AnsibleRequirement{
+			Name:    name,
+			Type:    "collection",
+			Version: galaxy.Dependencies[name],
+		})
+	}
+
+	return info
+}
+
+// ParseConfig parses ansible.cfg and extracts paths from the [defaults] section
+func (p *AnsibleParser) ParseConfig(content string) *AnsibleInfo {
+	info := &AnsibleInfo{Kind: "config"}
+	section := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		if section != "defaults" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "inventory", "hostfile":
+			info.Inventory = value
+		case "roles_path":
+			info.RolesPath = value
+		case "collections_path", "collections_paths":
+			info.CollectionsPath = value
+		}
+	}
+
+	return info
+}
+
+// collectAnsibleModules adds the modules used by a task list, descending into block/rescue/always
+func collectAnsibleModules(tasks *yaml.Node, modules map[string]bool) {
+	if tasks == nil || tasks.Kind != yaml.SequenceNode {
+		return
+	}
+
+	for _, task := range tasks.Content {
+		if task.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(task.Content); i += 2 {
+			key, value := task.Content[i].Value, task.Content[i+1]
+			switch {
+			case key == "block" || key == "rescue" || key == "always":
+				collectAnsibleModules(value, modules)
+			case key == "action" || key == "local_action":
+				// action: module_name arg=value, or action: {module: module_name}
+				if value.Kind == yaml.ScalarNode {
+					if fields := strings.Fields(value.Value); len(fields) > 0 {
+						modules[fields[0]] = true
+					}
+				} else if module := yamlScalar(value, "module"); module != "" {
+					modules[module] = true
+				}
+			case ansibleTaskKeywords[key] || strings.HasPrefix(key, "with_"):
+				continue
+			default:
+				modules[key] = true
+			}
+		}
+	}
+}
+
+// ansibleRoleName returns the role name of a play roles entry (string, {role: x} or {name: x})
+func ansibleRoleName(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	if name := yamlScalar(node, "role"); name != "" {
+		return name
+	}
+	return yamlScalar(node, "name")
+}
+
+// parseAnsibleRequirements parses a list of role or collection requirement entries
+func parseAnsibleRequirements(node *yaml.Node, requirementType string) []AnsibleRequirement {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var requirements []AnsibleRequirement
+	for _, entry := range node.Content {
+		requirement := AnsibleRequirement{Type: requirementType}
+		switch entry.Kind {
+		case yaml.ScalarNode:
+			requirement.Name = entry.Value
+		case yaml.MappingNode:
+			requirement.Name = yamlScalar(entry, "name")
+			requirement.Version = yamlScalar(entry, "version")
+			requirement.Source = yamlScalar(entry, "source")
+			if src := yamlScalar(entry, "src"); src != "" {
+				requirement.Source = src
+				if requirement.Name == "" {
+					requirement.Name = src
+				}
+			}
+		}
+		if requirement.Name != "" {
+			requirements = append(requirements, requirement)
+		}
+	}
+
+	return requirements
+}
+
+func sortedKeys(set map[string]bool) []string {
+	if len(set) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
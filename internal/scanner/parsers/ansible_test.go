@@ -0,0 +1,192 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlaybook(t *testing.T) {
+	content := `- name: Database servers
+  hosts: db
+  become: true
+  roles:
+    - common
+    - role: geerlingguy.postgresql
+      vars:
+        postgresql_version: "16"
+  pre_tasks:
+    - name: Update cache
+      ansible.builtin.apt:
+        update_cache: true
+  tasks:
+    - name: Create database
+      community.postgresql.postgresql_db:
+        name: app
+      become_user: postgres
+    - block:
+        - name: Create user
+          community.postgresql.postgresql_user:
+            name: app
+      rescue:
+        - ansible.builtin.debug:
+            msg: failed
+    - name: Legacy action
+      action: ec2_instance name=web
+    - name: Loop
+      ansible.builtin.file:
+        path: "{{ item }}"
+      with_items: [a, b]
+
+- hosts:
+    - web
+    - db
+  tasks:
+    - amazon.aws.s3_bucket:
+        name: assets
+  handlers:
+    - name: restart nginx
+      ansible.builtin.service:
+        name: nginx
+
+- import_playbook: monitoring.yml
+`
+
+	parser := NewAnsibleParser()
+	require.True(t, parser.IsPlaybookCandidate(content))
+
+	info := parser.ParsePlaybook(content)
+	require.NotNil(t, info)
+	assert.Equal(t, "playbook", info.Kind)
+	assert.Equal(t, 2, info.Plays)
+	assert.Equal(t, []string{"db", "web"}, info.Hosts)
+	assert.Equal(t, []string{"common", "geerlingguy.postgresql"}, info.Roles)
+	assert.Equal(t, []string{"monitoring.yml"}, info.Imports)
+	assert.Equal(t, []string{
+		"amazon.aws.s3_bucket",
+		"ansible.builtin.apt",
+		"ansible.builtin.debug",
+		"ansible.builtin.file",
+		"ansible.builtin.service",
+		"community.postgresql.postgresql_db",
+		"community.postgresql.postgresql_user",
+		"ec2_instance",
+	}, info.Modules)
+}
+
+func TestParsePlaybook_NotAPlaybook(t *testing.T) {
+	parser := NewAnsibleParser()
+
+	tests := map[string]string{
+		"mapping":        "hosts: web\n",
+		"list of tasks":  "- name: x\n  ansible.builtin.debug: {}\n",
+		"list of scalar": "- a\n- b\n",
+		"invalid yaml":   "- hosts: [unclosed\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Nil(t, parser.ParsePlaybook(content))
+		})
+	}
+}
+
+func TestParseTasks(t *testing.T) {
+	info := NewAnsibleParser().ParseTasks(`- name: Install packages
+  apt:
+    name: postgresql
+- include_tasks: users.yml
+- name: Configure
+  community.postgresql.postgresql_set:
+    name: max_connections
+    value: "200"
+  notify: restart postgresql
+`)
+	require.NotNil(t, info)
+	assert.Equal(t, []string{"apt", "community.postgresql.postgresql_set", "include_tasks"}, info.Modules)
+
+	assert.Nil(t, NewAnsibleParser().ParseTasks("key: value\n"))
+}
+
+func TestParseRequirements(t *testing.T) {
+	parser := NewAnsibleParser()
+
+	t.Run("roles and collections", func(t *testing.T) {
+		info := parser.ParseRequirements(`roles:
+  - name: geerlingguy.mysql
+    version: 4.3.3
+  - src: https://github.com/org/ansible-role-app.git
+    name: app
+    version: main
+collections:
+  - community.postgresql
+  - name: amazon.aws
+    version: ">=7.0.0"
+    source: https://galaxy.ansible.com
+`)
+		require.NotNil(t, info)
+		assert.Equal(t, "requirements", info.Kind)
+		assert.Equal(t, []AnsibleRequirement{
+			{Name: "geerlingguy.mysql", Type: "role", Version: "4.3.3"},
+			{Name: "app", Type: "role", Version: "main", Source: "https://github.com/org/ansible-role-app.git"},
+			{Name: "community.postgresql", Type: "collection"},
+			{Name: "amazon.aws", Type: "collection", Version: ">=7.0.0", Source: "https://galaxy.ansible.com"},
+		}, info.Requirements)
+	})
+
+	t.Run("legacy list of roles", func(t *testing.T) {
+		info := parser.ParseRequirements(`- src: geerlingguy.nginx
+  version: 3.1.0
+- geerlingguy.redis
+`)
+		require.NotNil(t, info)
+		assert.Equal(t, []AnsibleRequirement{
+			{Name: "geerlingguy.nginx", Type: "role", Version: "3.1.0", Source: "geerlingguy.nginx"},
+			{Name: "geerlingguy.redis", Type: "role"},
+		}, info.Requirements)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, parser.ParseRequirements("roles: []\n"))
+		assert.Nil(t, parser.ParseRequirements(""))
+	})
+}
+
+func TestParseGalaxy(t *testing.T) {
+	info := NewAnsibleParser().ParseGalaxy(`namespace: acme
+name: platform
+version: 1.2.0
+dependencies:
+  community.general: ">=8.0.0"
+  ansible.posix: "*"
+`)
+	require.NotNil(t, info)
+	assert.Equal(t, "collection", info.Kind)
+	assert.Equal(t, "acme.platform", info.Collection)
+	assert.Equal(t, "1.2.0", info.Version)
+	assert.Equal(t, []AnsibleRequirement{
+		{Name: "ansible.posix", Type: "collection", Version: "*"},
+		{Name: "community.general", Type: "collection", Version: ">=8.0.0"},
+	}, info.Requirements)
+
+	assert.Nil(t, NewAnsibleParser().ParseGalaxy("name: platform\n"))
+}
+
+func TestParseAnsibleConfig(t *testing.T) {
+	info := NewAnsibleParser().ParseConfig(`# Project settings
+[defaults]
+inventory = inventories/prod/hosts.yml
+roles_path = ./roles:~/.ansible/roles
+collections_path = ./collections
+host_key_checking = False
+
+[ssh_connection]
+pipelining = True
+`)
+	require.NotNil(t, info)
+	assert.Equal(t, "config", info.Kind)
+	assert.Equal(t, "inventories/prod/hosts.yml", info.Inventory)
+	assert.Equal(t, "./roles:~/.ansible/roles", info.RolesPath)
+	assert.Equal(t, "./collections", info.CollectionsPath)
+}
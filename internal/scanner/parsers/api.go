@@ -0,0 +1,348 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIParser handles API contracts: OpenAPI/Swagger and AsyncAPI documents, GraphQL schemas and protobuf files
+type APIParser struct{}
+
+// NewAPIParser creates a new API contract parser
+func NewAPIParser() *APIParser {
+	return &APIParser{}
+}
+
+// API contract types
+const (
+	APITypeOpenAPI  = "openapi"
+	APITypeSwagger  = "swagger"
+	APITypeAsyncAPI = "asyncapi"
+	APITypeGraphQL  = "graphql"
+	APITypeProtobuf = "protobuf"
+)
+
+// APIContract represents an API contract exposed or consumed by a component
+type APIContract struct {
+	File        string `json:"file,omitempty"`
+	Type        string `json:"type"`                   // openapi, swagger, asyncapi, graphql or protobuf
+	SpecVersion string `json:"spec_version,omitempty"` // Version of the specification (3.1.0, 2.0, proto3)
+	Title       string `json:"title,omitempty"`
+	Version     string `json:"version,omitempty"` // Version of the API (info.version)
+
+	// OpenAPI and AsyncAPI
+	Servers    []string `json:"servers,omitempty"`
+	Paths      int      `json:"paths,omitempty"`
+	Operations int      `json:"operations,omitempty"`
+	Channels   []string `json:"channels,omitempty"`
+	Protocols  []string `json:"protocols,omitempty"` // Server protocols and bindings (kafka, amqp, mqtt, ...)
+
+	// GraphQL
+	Types         int `json:"types,omitempty"`
+	Queries       int `json:"queries,omitempty"`
+	Mutations     int `json:"mutations,omitempty"`
+	Subscriptions int `json:"subscriptions,omitempty"`
+
+	// Protobuf
+	Packages []string `json:"packages,omitempty"`
+	Services []string `json:"services,omitempty"`
+	RPCs     int      `json:"rpcs,omitempty"`
+}
+
+var (
+	// Top level openapi, swagger and asyncapi keys of YAML and JSON documents
+	apiSpecKeyRegex = regexp.MustCompile(`(?m)^(?:openapi|swagger|asyncapi)\s*:|"(?:openapi|swagger|asyncapi)"\s*:`)
+
+	openAPIMethods = map[string]bool{
+		"get": true, "put": true, "post": true, "delete": true,
+		"options": true, "head": true, "patch": true, "trace": true,
+	}
+
+	graphQLCommentRegex     = regexp.MustCompile(`#[^\n]*`)
+	graphQLBlockStringRegex = regexp.MustCompile(`(?s)""".*?"""`)
+	graphQLStringRegex      = regexp.MustCompile(`"(?:[^"\\\n]|\\.)*"`)
+	// type, interface, enum, input, union and scalar definitions, with their extensions
+	graphQLDefinitionRegex = regexp.MustCompile(`(?m)^\s*(extend\s+)?(type|interface|enum|input|union|scalar)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+	graphQLSchemaRegex     = regexp.MustCompile(`(?m)^\s*(?:extend\s+)?schema\b[^{]*\{([^}]*)\}`)
+	graphQLRootRegex       = regexp.MustCompile(`(query|mutation|subscription)\s*:\s*([_A-Za-z][_0-9A-Za-z]*)`)
+
+	protoCommentRegex = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+	protoSyntaxRegex  = regexp.MustCompile(`\b(?:syntax|edition)\s*=\s*"([^"]+)"`)
+	protoPackageRegex = regexp.MustCompile(`\bpackage\s+([\w.]+)\s*;`)
+	protoServiceRegex = regexp.MustCompile(`\bservice\s+(\w+)\s*\{`)
+	protoRPCRegex     = regexp.MustCompile(`\brpc\s+\w+\s*\(`)
+)
+
+// IsAPISpecCandidate performs a cheap content check before parsing a YAML or JSON file
+func (p *APIParser) IsAPISpecCandidate(content string) bool {
+	return apiSpecKeyRegex.MatchString(content)
+}
+
+// ParseAPISpec parses an OpenAPI (3.x), Swagger (2.0) or AsyncAPI document in YAML or JSON format
+// Returns nil if the content is not an API specification
+func (p *APIParser) ParseAPISpec(content string) *APIContract {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil {
+		return nil
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	doc := root.Content[0]
+
+	var contract *APIContract
+	switch {
+	case yamlScalar(doc, "openapi") != "":
+		contract = &APIContract{Type: APITypeOpenAPI, SpecVersion: yamlScalar(doc, "openapi")}
+		parseOpenAPIPaths(contract, doc)
+		for _, server := range yamlSequence(yamlMappingValue(doc, "servers")) {
+			if url := yamlScalar(server, "url"); url != "" {
+				contract.Servers = appendUnique(contract.Servers, url)
+			}
+		}
+	case yamlScalar(doc, "swagger") != "":
+		contract = &APIContract{Type: APITypeSwagger, SpecVersion: yamlScalar(doc, "swagger")}
+		parseOpenAPIPaths(contract, doc)
+		contract.Servers = swaggerServers(doc)
+	case yamlScalar(doc, "asyncapi") != "":
+		contract = &APIContract{Type: APITypeAsyncAPI, SpecVersion: yamlScalar(doc, "asyncapi")}
+		parseAsyncAPI(contract, doc)
+	default:
+		return nil
+	}
+
+	info := yamlMappingValue(doc, "info")
+	contract.Title = yamlScalar(info, "title")
+	contract.Version = yamlScalar(info, "version")
+	return contract
+}
+
+// parseOpenAPIPaths counts the paths and the operations (HTTP methods) of an OpenAPI or Swagger document
+func parseOpenAPIPaths(contract *APIContract, doc *yaml.Node) {
+	paths := yamlMappingValue(doc, "paths")
+	if paths == nil || paths.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		contract.Paths++
+		item := paths.Content[i+1]
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			if openAPIMethods[strings.ToLower(item.Content[j].Value)] {
+				contract.Operations++
+			}
+		}
+	}
+}
+
+// swaggerServers builds the server URLs of a Swagger 2.0 document from schemes, host and basePath
+func swaggerServers(doc *yaml.Node) []string {
+	host := yamlScalar(doc, "host")
+	if host == "" {
+		return nil
+	}
+	basePath := yamlScalar(doc, "basePath")
+
+	schemes := yamlScalars(yamlMappingValue(doc, "schemes"))
+	if len(schemes) == 0 {
+		return []string{host + basePath}
+	}
+	servers := make([]string, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, scheme+"://"+host+basePath)
+	}
+	return servers
+}
+
+// parseAsyncAPI collects the servers, channels, operations and protocols of an AsyncAPI 2.x or 3.x document
+// Protocols come from the servers and from the bindings of servers, channels, operations and messages
+func parseAsyncAPI(contract *APIContract, doc *yaml.Node) {
+	protocols := make(map[string]bool)
+
+	servers := yamlMappingValue(doc, "servers")
+	if servers != nil && servers.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(servers.Content); i += 2 {
+			server := servers.Content[i+1]
+			url := yamlScalar(server, "url") // 2.x
+			if url == "" {
+				url = yamlScalar(server, "host") + yamlScalar(server, "pathname") // 3.x
+			}
+			if url != "" {
+				contract.Servers = appendUnique(contract.Servers, url)
+			}
+			if protocol := yamlScalar(server, "protocol"); protocol != "" {
+				protocols[strings.ToLower(protocol)] = true
+			}
+		}
+	}
+
+	channels := yamlMappingValue(doc, "channels")
+	if channels != nil && channels.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(channels.Content); i += 2 {
+			channel := channels.Content[i+1]
+			// 3.x channels are keyed by id and carry the address
+			name := yamlScalar(channel, "address")
+			if name == "" {
+				name = channels.Content[i].Value
+			}
+			contract.Channels = appendUnique(contract.Channels, name)
+
+			// 2.x operations are declared in the channel
+			for _, operation := range []string{"publish", "subscribe"} {
+				if yamlMappingValue(channel, operation) != nil {
+					contract.Operations++
+				}
+			}
+		}
+	}
+
+	if operations := yamlMappingValue(doc, "operations"); operations != nil && operations.Kind == yaml.MappingNode {
+		contract.Operations += len(operations.Content) / 2
+	}
+
+	for _, section := range []string{"servers", "channels", "operations", "components"} {
+		collectBindingProtocols(yamlMappingValue(doc, section), protocols)
+	}
+	contract.Protocols = sortedKeys(protocols)
+}
+
+// collectBindingProtocols adds the keys of all bindings objects below a node (bindings: {kafka: ...})
+func collectBindingProtocols(node *yaml.Node, protocols map[string]bool) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if key == "bindings" && value.Kind == yaml.MappingNode {
+				for j := 0; j+1 < len(value.Content); j += 2 {
+					protocols[strings.ToLower(value.Content[j].Value)] = true
+				}
+				continue
+			}
+			collectBindingProtocols(value, protocols)
+		}
+		return
+	}
+	for _, child := range node.Content {
+		collectBindingProtocols(child, protocols)
+	}
+}
+
+// ParseGraphQLSchema counts the types and the root fields (queries, mutations, subscriptions) of a GraphQL SDL file
+// Returns nil for documents without type definitions, such as client operations
+func (p *APIParser) ParseGraphQLSchema(content string) *APIContract {
+	content = graphQLBlockStringRegex.ReplaceAllString(content, "")
+	content = graphQLStringRegex.ReplaceAllString(content, `""`)
+	content = graphQLCommentRegex.ReplaceAllString(content, "")
+
+	roots := map[string]string{"Query": "query", "Mutation": "mutation", "Subscription": "subscription"}
+	if match := graphQLSchemaRegex.FindStringSubmatch(content); match != nil {
+		roots = make(map[string]string)
+		for _, root := range graphQLRootRegex.FindAllStringSubmatch(match[1], -1) {
+			roots[root[2]] = root[1]
+		}
+	}
+
+	definitions := graphQLDefinitionRegex.FindAllStringSubmatchIndex(content, -1)
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	contract := &APIContract{Type: APITypeGraphQL}
+	types := make(map[string]bool)
+	for _, definition := range definitions {
+		extension := definition[2] >= 0
+		name := content[definition[6]:definition[7]]
+
+		operation, isRoot := roots[name]
+		if !isRoot {
+			if !extension {
+				types[name] = true
+			}
+			continue
+		}
+
+		fields := countGraphQLFields(content[definition[1]:])
+		switch operation {
+		case "query":
+			contract.Queries += fields
+		case "mutation":
+			contract.Mutations += fields
+		case "subscription":
+			contract.Subscriptions += fields
+		}
+	}
+	contract.Types = len(types)
+	return contract
+}
+
+// countGraphQLFields counts the fields of the selection set following a type definition
+// Fields are the names followed by ":" or "(" at the first level, outside of arguments
+func countGraphQLFields(content string) int {
+	start := strings.Index(content, "{")
+	// Definitions without fields (extend type Query @key(...)) end before the next definition
+	if start < 0 || graphQLDefinitionRegex.MatchString(content[:start]) {
+		return 0
+	}
+
+	fields := 0
+	braces, parens := 0, 0
+	for i := start; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '{':
+			braces++
+		case c == '}':
+			braces--
+			if braces == 0 {
+				return fields
+			}
+		case c == '(':
+			parens++
+		case c == ')':
+			parens--
+		case braces == 1 && parens == 0 && isGraphQLNameStart(c) && (i == 0 || !isGraphQLNameChar(content[i-1]) && content[i-1] != '@'):
+			end := i
+			for end < len(content) && isGraphQLNameChar(content[end]) {
+				end++
+			}
+			next := strings.TrimLeft(content[end:], " \t\r\n")
+			// Types after the colon (name: String) are followed by neither
+			if strings.HasPrefix(next, ":") || strings.HasPrefix(next, "(") {
+				fields++
+			}
+			i = end - 1
+		}
+	}
+	return fields
+}
+
+func isGraphQLNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return isGraphQLNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// ParseProto returns the packages, services and RPCs of a protobuf file
+func (p *APIParser) ParseProto(content string) *APIContract {
+	content = protoCommentRegex.ReplaceAllString(content, "")
+
+	contract := &APIContract{Type: APITypeProtobuf}
+	if match := protoSyntaxRegex.FindStringSubmatch(content); match != nil {
+		contract.SpecVersion = match[1]
+	}
+	for _, match := range protoPackageRegex.FindAllStringSubmatch(content, -1) {
+		contract.Packages = appendUnique(contract.Packages, match[1])
+	}
+	for _, match := range protoServiceRegex.FindAllStringSubmatch(content, -1) {
+		contract.Services = appendUnique(contract.Services, match[1])
+	}
+	contract.RPCs = len(protoRPCRegex.FindAllString(content, -1))
+	return contract
+}
@@ -0,0 +1,311 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPISpec_OpenAPI(t *testing.T) {
+	content := `openapi: 3.0.3
+info:
+  title: Orders API
+  version: 1.4.0
+servers:
+  - url: https://api.example.com/v1
+  - url: https://staging.example.com/v1
+paths:
+  /orders:
+    parameters:
+      - name: tenant
+        in: header
+    get:
+      summary: List orders
+    post:
+      summary: Create order
+  /orders/{id}:
+    get:
+      summary: Get order
+    delete:
+      summary: Delete order
+components:
+  schemas:
+    Order:
+      type: object
+`
+
+	parser := NewAPIParser()
+	assert.True(t, parser.IsAPISpecCandidate(content))
+
+	contract := parser.ParseAPISpec(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, &APIContract{
+		Type:        APITypeOpenAPI,
+		SpecVersion: "3.0.3",
+		Title:       "Orders API",
+		Version:     "1.4.0",
+		Servers:     []string{"https://api.example.com/v1", "https://staging.example.com/v1"},
+		Paths:       2,
+		Operations:  4,
+	}, contract)
+}
+
+func TestParseAPISpec_SwaggerJSON(t *testing.T) {
+	content := `{
+  "swagger": "2.0",
+  "info": {"title": "Petstore", "version": "1.0.0"},
+  "host": "petstore.example.com",
+  "basePath": "/v2",
+  "schemes": ["https", "http"],
+  "paths": {
+    "/pets": {"get": {}, "post": {}},
+    "/pets/{petId}": {"get": {}, "put": {}, "delete": {}}
+  }
+}`
+
+	parser := NewAPIParser()
+	assert.True(t, parser.IsAPISpecCandidate(content))
+
+	contract := parser.ParseAPISpec(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, APITypeSwagger, contract.Type)
+	assert.Equal(t, "2.0", contract.SpecVersion)
+	assert.Equal(t, "Petstore", contract.Title)
+	assert.Equal(t, []string{"https://petstore.example.com/v2", "http://petstore.example.com/v2"}, contract.Servers)
+	assert.Equal(t, 2, contract.Paths)
+	assert.Equal(t, 5, contract.Operations)
+}
+
+func TestParseAPISpec_AsyncAPI2(t *testing.T) {
+	content := `asyncapi: 2.6.0
+info:
+  title: Order Events
+  version: 2.0.0
+servers:
+  production:
+    url: kafka.example.com:9092
+    protocol: kafka-secure
+  broker:
+    url: amqp://rabbitmq.example.com
+    protocol: amqp
+channels:
+  orders.created:
+    publish:
+      bindings:
+        kafka:
+          groupId: orders
+      message:
+        bindings:
+          kafka:
+            key:
+              type: string
+  orders.shipped:
+    subscribe:
+      message:
+        payload:
+          type: object
+    bindings:
+      amqp:
+        is: routingKey
+`
+
+	contract := NewAPIParser().ParseAPISpec(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, &APIContract{
+		Type:        APITypeAsyncAPI,
+		SpecVersion: "2.6.0",
+		Title:       "Order Events",
+		Version:     "2.0.0",
+		Servers:     []string{"kafka.example.com:9092", "amqp://rabbitmq.example.com"},
+		Operations:  2,
+		Channels:    []string{"orders.created", "orders.shipped"},
+		Protocols:   []string{"amqp", "kafka", "kafka-secure"},
+	}, contract)
+}
+
+func TestParseAPISpec_AsyncAPI3(t *testing.T) {
+	content := `asyncapi: 3.0.0
+info:
+  title: Sensors
+  version: 1.0.0
+servers:
+  mosquitto:
+    host: test.mosquitto.org
+    protocol: mqtt
+channels:
+  measurements:
+    address: sensors/{sensorId}/measurements
+operations:
+  receiveMeasurement:
+    action: receive
+    channel:
+      $ref: '#/channels/measurements'
+  sendCommand:
+    action: send
+    bindings:
+      mqtt:
+        qos: 1
+`
+
+	contract := NewAPIParser().ParseAPISpec(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, []string{"test.mosquitto.org"}, contract.Servers)
+	assert.Equal(t, []string{"sensors/{sensorId}/measurements"}, contract.Channels)
+	assert.Equal(t, 2, contract.Operations)
+	assert.Equal(t, []string{"mqtt"}, contract.Protocols)
+}
+
+func TestParseAPISpec_NotASpec(t *testing.T) {
+	parser := NewAPIParser()
+
+	assert.False(t, parser.IsAPISpecCandidate("name: app\nversion: 1.0.0\n"))
+	// Nested keys are not specifications
+	assert.Nil(t, parser.ParseAPISpec("config:\n  openapi: 3.0.0\n"))
+	assert.Nil(t, parser.ParseAPISpec("openapi: [unclosed\n"))
+	assert.Nil(t, parser.ParseAPISpec("- openapi: 3.0.0\n"))
+}
+
+func TestParseGraphQLSchema(t *testing.T) {
+	content := `"""
+The root query type: type Fake { ignored: String }
+"""
+type Query {
+  # orders(status: Status): [Order!]!
+  orders(status: Status = OPEN, first: Int): [Order!]!
+  order(id: ID!): Order
+  me: User @deprecated(reason: "Use viewer")
+  viewer: User
+}
+
+type Mutation {
+  createOrder(input: CreateOrderInput!): Order!
+  cancelOrder(
+    id: ID!
+    reason: String
+  ): Order
+}
+
+extend type Query {
+  health: String
+}
+
+type Subscription {
+  orderShipped(id: ID!): Order
+}
+
+type Order implements Node @key(fields: "id") {
+  id: ID!
+  status: Status
+  customer: User
+}
+
+interface Node {
+  id: ID!
+}
+
+type User {
+  id: ID!
+  name: String
+}
+
+enum Status {
+  OPEN
+  SHIPPED
+}
+
+input CreateOrderInput {
+  items: [ID!]!
+}
+
+scalar DateTime
+union SearchResult = Order | User
+`
+
+	contract := NewAPIParser().ParseGraphQLSchema(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, &APIContract{
+		Type:          APITypeGraphQL,
+		Types:         7,
+		Queries:       5,
+		Mutations:     2,
+		Subscriptions: 1,
+	}, contract)
+}
+
+func TestParseGraphQLSchema_CustomRoots(t *testing.T) {
+	content := `schema {
+  query: RootQuery
+  mutation: RootMutation
+}
+
+type RootQuery {
+  ping: String
+}
+
+type RootMutation {
+  reset: Boolean
+}
+
+type Query {
+  notARoot: String
+}
+`
+
+	contract := NewAPIParser().ParseGraphQLSchema(content)
+	require.NotNil(t, contract)
+	assert.Equal(t, 1, contract.Queries)
+	assert.Equal(t, 1, contract.Mutations)
+	assert.Equal(t, 1, contract.Types, "Query is a regular type when the schema defines other roots")
+}
+
+func TestParseGraphQLSchema_ClientOperations(t *testing.T) {
+	content := `query GetOrder($id: ID!) {
+  order(id: $id) {
+    id
+    status
+  }
+}
+`
+	assert.Nil(t, NewAPIParser().ParseGraphQLSchema(content))
+}
+
+func TestParseProto(t *testing.T) {
+	content := `syntax = "proto3";
+
+package orders.v1;
+
+import "google/protobuf/timestamp.proto";
+
+// service Legacy { rpc Old(Req) returns (Res); }
+/* rpc Hidden(Req) returns (Res); */
+
+service OrderService {
+  rpc GetOrder(GetOrderRequest) returns (Order);
+  rpc ListOrders(ListOrdersRequest) returns (stream Order) {
+    option (google.api.http) = { get: "/v1/orders" };
+  }
+}
+
+service AdminService {
+  rpc Purge(PurgeRequest) returns (PurgeResponse);
+}
+
+message Order {
+  string id = 1;
+}
+`
+
+	contract := NewAPIParser().ParseProto(content)
+	assert.Equal(t, &APIContract{
+		Type:        APITypeProtobuf,
+		SpecVersion: "proto3",
+		Packages:    []string{"orders.v1"},
+		Services:    []string{"OrderService", "AdminService"},
+		RPCs:        3,
+	}, contract)
+
+	messages := NewAPIParser().ParseProto("syntax = \"proto2\";\nmessage Empty {}\n")
+	assert.Equal(t, "proto2", messages.SpecVersion)
+	assert.Empty(t, messages.Services)
+}
@@ -0,0 +1,640 @@
+package parsers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Application frameworks whose configuration files are analyzed
+const (
+	AppConfigSpring = "spring"
+	AppConfigAspNet = "aspnet"
+)
+
+// AppConfigParser handles Spring Boot (application*.yml/.properties) and ASP.NET Core (appsettings*.json,
+// launchSettings.json) configuration files. Values are only inspected for connection schemes and well-known
+// keys, connection strings and credentials are never stored
+type AppConfigParser struct{}
+
+// NewAppConfigParser creates a new application configuration parser
+func NewAppConfigParser() *AppConfigParser {
+	return &AppConfigParser{}
+}
+
+// AppConfig represents the wiring found in an application configuration file
+type AppConfig struct {
+	File           string          `json:"file,omitempty"`
+	Framework      string          `json:"framework"`                 // spring or aspnet
+	Profiles       []string        `json:"profiles,omitempty"`        // Spring profiles or launch profiles the file applies to
+	ActiveProfiles []string        `json:"active_profiles,omitempty"` // spring.profiles.active, include and groups
+	Environments   []string        `json:"environments,omitempty"`    // ASP.NET environments (appsettings.<Environment>.json, ASPNETCORE_ENVIRONMENT)
+	Connections    []AppConnection `json:"connections,omitempty"`
+	Issuers        []string        `json:"issuers,omitempty"` // OAuth/OIDC issuer and authority URLs
+
+	References []types.ServiceRef `json:"-"` // Services referenced by connection values and host keys
+	Endpoints  []types.Endpoint   `json:"-"` // Ports the application listens on (server.port, Kestrel endpoints)
+}
+
+// AppConnection represents a tech wired through a configuration key
+type AppConnection struct {
+	Key    string `json:"key"`              // Configuration key, e.g. spring.datasource.url or ConnectionStrings:Default
+	Tech   string `json:"tech"`             // Tech detected from the value scheme or the key
+	Scheme string `json:"scheme,omitempty"` // Scheme of the value, e.g. jdbc:postgresql or rediss
+}
+
+// configEntry is a flattened configuration key with its scalar value
+type configEntry struct {
+	key   string
+	value string
+}
+
+var (
+	springConfigFileRegex = regexp.MustCompile(`^(?:application|bootstrap)(?:-([\w.-]+))?\.(?:ya?ml|properties)$`)
+	aspNetSettingsRegex   = regexp.MustCompile(`^appsettings(?:\.([\w-]+))?\.json$`)
+	urlSchemeRegex        = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9+.-]*)://`)
+	arrayIndexRegex       = regexp.MustCompile(`\[\d+\]`)
+)
+
+// springKeyTechs maps normalized Spring key prefixes to techs
+var springKeyTechs = []struct {
+	prefix string
+	tech   string
+}{
+	{"spring.kafka.", "apache_kafka"},
+	{"spring.cloud.stream.kafka.", "apache_kafka"},
+	{"spring.data.redis.", "redis"},
+	{"spring.redis.", "redis"},
+	{"spring.data.mongodb.", "mongodb"},
+	{"spring.rabbitmq.", "rabbitmq"},
+	{"spring.elasticsearch.", "elasticsearch"},
+	{"spring.data.elasticsearch.", "elasticsearch"},
+	{"spring.cassandra.", "apache_cassandra"},
+	{"spring.data.cassandra.", "apache_cassandra"},
+	{"spring.cloud.azure.servicebus.", "azure.servicebus"},
+	{"spring.cloud.azure.storage.", "azure.storage"},
+	{"spring.cloud.azure.cosmos.", "azure.cosmosdb"},
+	{"spring.cloud.azure.active-directory.", "azure.entraid"},
+}
+
+// aspNetSectionTechs maps normalized ASP.NET configuration section names to techs
+var aspNetSectionTechs = map[string]string{
+	"redis":           "redis",
+	"rediscache":      "redis",
+	"kafka":           "apache_kafka",
+	"rabbitmq":        "rabbitmq",
+	"elasticsearch":   "elasticsearch",
+	"mongodb":         "mongodb",
+	"mongo":           "mongodb",
+	"servicebus":      "azure.servicebus",
+	"azureservicebus": "azure.servicebus",
+	"cosmosdb":        "azure.cosmosdb",
+	"azuread":         "azure.entraid",
+	"azureadb2c":      "azure.entraid",
+	"entraid":         "azure.entraid",
+	"keycloak":        "keycloak",
+}
+
+// issuerKeys are the normalized last key segments holding OAuth/OIDC issuer URLs
+var issuerKeys = map[string]bool{
+	"issueruri":       true,
+	"issuer":          true,
+	"validissuer":     true,
+	"authority":       true,
+	"metadataaddress": true,
+	"jwkseturi":       true,
+}
+
+// issuerTechs maps issuer URL patterns to identity provider techs
+var issuerTechs = []struct {
+	pattern string
+	tech    string
+}{
+	{"/realms/", "keycloak"},
+	{".auth0.com", "auth0"},
+	{".okta.com", "okta"},
+	{".oktapreview.com", "okta"},
+	{"cognito-idp.", "aws.cognito"},
+	{"login.microsoftonline.com", "azure.entraid"},
+	{".b2clogin.com", "azure.entraid"},
+	{"sts.windows.net", "azure.entraid"},
+}
+
+// urlSchemeTechs maps URL schemes of non-database services to techs (databases use DatabaseTech)
+var urlSchemeTechs = map[string]string{
+	"redis":  "redis",
+	"rediss": "redis",
+	"amqp":   "rabbitmq",
+	"amqps":  "rabbitmq",
+	"nats":   "nats",
+}
+
+// IsSpringConfigFile reports whether a file is a Spring Boot configuration file and returns its profile
+func (p *AppConfigParser) IsSpringConfigFile(fileName string) (string, bool) {
+	match := springConfigFileRegex.FindStringSubmatch(fileName)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// IsAspNetSettingsFile reports whether a file is an ASP.NET Core settings file and returns its environment
+func (p *AppConfigParser) IsAspNetSettingsFile(fileName string) (string, bool) {
+	match := aspNetSettingsRegex.FindStringSubmatch(fileName)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// ParseSpringConfig parses a Spring Boot application or bootstrap file (YAML or properties, multi-document)
+func (p *AppConfigParser) ParseSpringConfig(fileName, content string) *AppConfig {
+	var documents [][]configEntry
+	if strings.HasSuffix(fileName, ".properties") {
+		documents = parsePropertiesDocuments(content)
+	} else {
+		documents = parseYAMLDocuments(content)
+	}
+	if documents == nil {
+		return nil
+	}
+
+	config := &AppConfig{Framework: AppConfigSpring}
+	if profile, _ := p.IsSpringConfigFile(fileName); profile != "" {
+		config.Profiles = appendUnique(config.Profiles, profile)
+	}
+
+	// Ports of the application server (server.*) and the actuator server (management.server.*), with SSL enabled
+	ports := make(map[string]int)
+	ssl := make(map[string]bool)
+	for _, entries := range documents {
+		for _, entry := range entries {
+			key := normalizeSpringKey(entry.key)
+			if server, found := strings.CutSuffix(key, ".port"); found && (server == "server" || server == "management.server") {
+				ports[server] = springPort(entry.value)
+				continue
+			}
+			if server, found := strings.CutSuffix(key, ".ssl.enabled"); found {
+				ssl[server] = entry.value == "true"
+			}
+			switch {
+			case key == "spring.config.activate.onprofile" || key == "spring.profiles":
+				config.Profiles = appendProfiles(config.Profiles, entry.value)
+				continue
+			case key == "spring.profiles.active" || key == "spring.profiles.include" || strings.HasPrefix(key, "spring.profiles.group."):
+				config.ActiveProfiles = appendProfiles(config.ActiveProfiles, entry.value)
+				continue
+			}
+
+			config.addReferences(entry.key, entry.value)
+			if issuer := issuerURL(key, ".", entry.value); issuer != "" {
+				config.addIssuer(entry.key, issuer)
+				continue
+			}
+			if tech, scheme := ConnectionTech(entry.value); tech != "" {
+				config.addConnection(entry.key, tech, scheme)
+				continue
+			}
+			for _, rule := range springKeyTechs {
+				if strings.HasPrefix(key, normalizeSpringKey(rule.prefix)) {
+					config.addConnection(entry.key, rule.tech, "")
+					break
+				}
+			}
+		}
+	}
+	for _, server := range []string{"server", "management.server"} {
+		if port := ports[server]; port > 0 && ssl[server] {
+			config.addEndpoint(port, "https")
+		} else if port > 0 {
+			config.addEndpoint(port, "http")
+		}
+	}
+
+	return config.orNil()
+}
+
+// ParseAspNetSettings parses an ASP.NET Core appsettings file (JSON with comments)
+func (p *AppConfigParser) ParseAspNetSettings(fileName, content string) *AppConfig {
+	entries, ok := parseJSONEntries(content, ":")
+	if !ok {
+		return nil
+	}
+
+	config := &AppConfig{Framework: AppConfigAspNet}
+	if environment, _ := p.IsAspNetSettingsFile(fileName); environment != "" {
+		config.Environments = []string{environment}
+	}
+
+	for _, entry := range entries {
+		if config.addAspNetEndpoints(entry.key, entry.value) {
+			continue
+		}
+		config.addReferences(entry.key, entry.value)
+		if issuer := issuerURL(strings.ToLower(entry.key), ":", entry.value); issuer != "" {
+			config.addIssuer(entry.key, issuer)
+			continue
+		}
+		if tech, scheme := ConnectionTech(entry.value); tech != "" {
+			config.addConnection(entry.key, tech, scheme)
+			continue
+		}
+		for _, section := range strings.Split(entry.key, ":") {
+			if tech, exists := aspNetSectionTechs[normalizeSectionName(section)]; exists {
+				config.addConnection(entry.key, tech, "")
+				break
+			}
+		}
+	}
+
+	return config.orNil()
+}
+
+// ParseLaunchSettings parses Properties/launchSettings.json, recording launch profiles and their environments
+func (p *AppConfigParser) ParseLaunchSettings(content string) *AppConfig {
+	var settings struct {
+		Profiles map[string]struct {
+			EnvironmentVariables map[string]string `json:"environmentVariables"`
+		} `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(stripJSONComments(content)), &settings); err != nil {
+		return nil
+	}
+
+	config := &AppConfig{Framework: AppConfigAspNet}
+	for name, profile := range settings.Profiles {
+		config.Profiles = append(config.Profiles, name)
+		for _, key := range []string{"ASPNETCORE_ENVIRONMENT", "DOTNET_ENVIRONMENT"} {
+			if environment := profile.EnvironmentVariables[key]; environment != "" {
+				config.Environments = appendUnique(config.Environments, environment)
+			}
+		}
+	}
+	sort.Strings(config.Profiles)
+	sort.Strings(config.Environments)
+
+	return config.orNil()
+}
+
+// ConnectionTech returns the tech and scheme of a connection value: JDBC/R2DBC URLs, service URLs
+// (postgres://, rediss://, amqp://, ...) and ADO.NET or Azure connection strings
+func ConnectionTech(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	lower := strings.ToLower(value)
+
+	for _, prefix := range []string{"jdbc:", "r2dbc:"} {
+		if rest, found := strings.CutPrefix(lower, prefix); found {
+			// Testcontainers and connection pool wrappers (jdbc:tc:postgresql:, r2dbc:pool:mysql:)
+			rest = strings.TrimPrefix(strings.TrimPrefix(rest, "tc:"), "pool:")
+			driver, _, _ := strings.Cut(rest, ":")
+			if tech := DatabaseTech(driver); tech != "" {
+				return tech, prefix + driver
+			}
+			return "", ""
+		}
+	}
+
+	if match := urlSchemeRegex.FindStringSubmatch(lower); match != nil {
+		if tech := DatabaseTech(match[1]); tech != "" {
+			return tech, match[1]
+		}
+		return urlSchemeTechs[match[1]], match[1]
+	}
+
+	if strings.Contains(value, ";") || strings.Contains(value, "=") {
+		return connectionStringTech(lower), ""
+	}
+	return "", ""
+}
+
+// connectionStringTech identifies the backend of an ADO.NET, StackExchange.Redis or Azure connection string
+func connectionStringTech(lower string) string {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(lower, ";") {
+		key, value, found := strings.Cut(part, "=")
+		if found {
+			parts[strings.ReplaceAll(strings.TrimSpace(key), " ", "")] = strings.TrimSpace(value)
+		}
+	}
+	has := func(keys ...string) bool {
+		for _, key := range keys {
+			if _, exists := parts[key]; exists {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(parts["endpoint"], "sb://"):
+		return "azure.servicebus"
+	case has("accountendpoint"):
+		return "azure.cosmosdb"
+	case has("defaultendpointsprotocol", "accountkey") || parts["usedevelopmentstorage"] == "true":
+		return "azure.storage"
+	case strings.Contains(lower, ".redis.cache.windows.net") || strings.Contains(lower, "abortconnect="):
+		return "redis"
+	case has("host") && has("database", "username"):
+		return "postgresql"
+	case has("initialcatalog", "trusted_connection", "integratedsecurity", "multipleactiveresultsets", "trustservercertificate"):
+		return "mssql"
+	case has("server") && (has("uid", "sslmode") || parts["port"] == "3306"):
+		return "mysql"
+	case has("server") && has("database", "userid"):
+		return "mssql"
+	}
+
+	if source, exists := parts["datasource"]; exists {
+		if strings.HasSuffix(source, ".db") || strings.HasSuffix(source, ".sqlite") || strings.HasSuffix(source, ".sqlite3") || source == ":memory:" {
+			return "sqlite"
+		}
+	}
+	return ""
+}
+
+// issuerURL returns the issuer URL of an OAuth/OIDC issuer key, without user info, or an empty string
+func issuerURL(lowerKey, separator, value string) string {
+	segments := strings.Split(lowerKey, separator)
+	last := normalizeSectionName(segments[len(segments)-1])
+	if !issuerKeys[last] {
+		return ""
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ""
+	}
+	parsed.User = nil
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+// addConnection records a tech wired through a key, once per tech
+func (c *AppConfig) addConnection(key, tech, scheme string) {
+	for _, connection := range c.Connections {
+		if connection.Tech == tech {
+			return
+		}
+	}
+	c.Connections = append(c.Connections, AppConnection{Key: arrayIndexRegex.ReplaceAllString(key, ""), Tech: tech, Scheme: scheme})
+}
+
+// addReferences records the services referenced by a key, once each
+func (c *AppConfig) addReferences(key, value string) {
+	for _, ref := range ParseServiceRefs(key, value) {
+		if !slices.Contains(c.References, ref) {
+			c.References = append(c.References, ref)
+		}
+	}
+}
+
+// addEndpoint records a port the application listens on, once. Whether it is published is decided by the
+// container or orchestrator configuration, so application ports are internal
+func (c *AppConfig) addEndpoint(port int, protocol string) {
+	endpoint := types.Endpoint{Port: port, Protocol: protocol, Exposure: types.EndpointInternal, Source: c.Framework}
+	if !slices.Contains(c.Endpoints, endpoint) {
+		c.Endpoints = append(c.Endpoints, endpoint)
+	}
+}
+
+// addAspNetEndpoints records the ports of the URLs (urls, Kestrel:Endpoints:<Name>:Url) and ports (http_ports,
+// https_ports) ASP.NET Core listens on. It returns whether the key configures endpoints
+func (c *AppConfig) addAspNetEndpoints(key, value string) bool {
+	key = strings.ToLower(key)
+	switch {
+	case key == "urls" || (strings.HasPrefix(key, "kestrel:endpoints:") && strings.HasSuffix(key, ":url")):
+		for _, address := range strings.Split(value, ";") {
+			parsed, err := url.Parse(strings.TrimSpace(address))
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+				continue
+			}
+			port := 80
+			if parsed.Scheme == "https" {
+				port = 443
+			}
+			if parsed.Port() != "" {
+				port, err = strconv.Atoi(parsed.Port())
+			}
+			if err == nil && port > 0 {
+				c.addEndpoint(port, parsed.Scheme)
+			}
+		}
+		return true
+	case key == "http_ports" || key == "https_ports":
+		for _, portValue := range strings.Split(value, ";") {
+			if port, err := strconv.Atoi(strings.TrimSpace(portValue)); err == nil && port > 0 {
+				c.addEndpoint(port, strings.TrimSuffix(key, "_ports"))
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// springPort returns the port of a Spring port property, or of the default of a placeholder (${PORT:8080}).
+// Port 0 (random port) and placeholders without default return 0
+func springPort(value string) int {
+	value = strings.TrimSpace(value)
+	if placeholder, found := strings.CutPrefix(value, "${"); found {
+		_, value, _ = strings.Cut(strings.TrimSuffix(placeholder, "}"), ":")
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 0 {
+		return 0
+	}
+	return port
+}
+
+// addIssuer records an issuer URL and the identity provider it belongs to
+func (c *AppConfig) addIssuer(key, issuer string) {
+	c.Issuers = appendUnique(c.Issuers, issuer)
+	for _, rule := range issuerTechs {
+		if strings.Contains(issuer, rule.pattern) {
+			c.addConnection(key, rule.tech, "")
+			return
+		}
+	}
+}
+
+// orNil returns nil for configurations without profiles, environments, connections, issuers or endpoints
+func (c *AppConfig) orNil() *AppConfig {
+	if len(c.Profiles) == 0 && len(c.ActiveProfiles) == 0 && len(c.Environments) == 0 &&
+		len(c.Connections) == 0 && len(c.Issuers) == 0 && len(c.Endpoints) == 0 {
+		return nil
+	}
+	return c
+}
+
+// appendProfiles adds comma-separated profile names, skipping placeholders and negations
+func appendProfiles(profiles []string, value string) []string {
+	for _, profile := range strings.Split(value, ",") {
+		profile = strings.TrimSpace(profile)
+		if profile == "" || strings.Contains(profile, "${") || strings.Contains(profile, "@") || strings.HasPrefix(profile, "!") {
+			continue
+		}
+		profiles = appendUnique(profiles, profile)
+	}
+	return profiles
+}
+
+// normalizeSpringKey applies Spring relaxed binding: lowercase, without dashes and underscores
+func normalizeSpringKey(key string) string {
+	key = strings.ToLower(arrayIndexRegex.ReplaceAllString(key, ""))
+	return strings.NewReplacer("-", "", "_", "").Replace(key)
+}
+
+// normalizeSectionName lowercases a configuration section name and removes separators
+func normalizeSectionName(name string) string {
+	return strings.NewReplacer("-", "", "_", "", ".", "").Replace(strings.ToLower(name))
+}
+
+// parsePropertiesDocuments parses a .properties file into documents separated by #--- or !---
+func parsePropertiesDocuments(content string) [][]configEntry {
+	documents := [][]configEntry{nil}
+	var pending string
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if pending == "" && (trimmed == "#---" || trimmed == "!---") {
+			documents = append(documents, nil)
+			continue
+		}
+		if pending == "" && (trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!")) {
+			continue
+		}
+
+		// Continuation lines end with a backslash
+		if strings.HasSuffix(trimmed, `\`) {
+			pending += strings.TrimSuffix(trimmed, `\`)
+			continue
+		}
+		trimmed = pending + trimmed
+		pending = ""
+
+		separator := strings.IndexAny(trimmed, "=:")
+		if separator <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(trimmed[:separator])
+		value := strings.TrimSpace(trimmed[separator+1:])
+		documents[len(documents)-1] = append(documents[len(documents)-1], configEntry{key: key, value: value})
+	}
+	return documents
+}
+
+// parseYAMLDocuments parses a multi-document YAML file into flattened documents, nil on syntax errors
+func parseYAMLDocuments(content string) [][]configEntry {
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	var documents [][]configEntry
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents
+			}
+			return nil
+		}
+		var entries []configEntry
+		flattenYAML(&node, "", ".", &entries)
+		documents = append(documents, entries)
+	}
+}
+
+// flattenYAML appends the scalar values of a YAML node with their joined keys
+func flattenYAML(node *yaml.Node, prefix, separator string, entries *[]configEntry) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			flattenYAML(child, prefix, separator, entries)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if prefix != "" {
+				key = prefix + separator + key
+			}
+			flattenYAML(node.Content[i+1], key, separator, entries)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			flattenYAML(child, fmt.Sprintf("%s[%d]", prefix, i), separator, entries)
+		}
+	case yaml.ScalarNode:
+		if prefix != "" {
+			*entries = append(*entries, configEntry{key: prefix, value: node.Value})
+		}
+	}
+}
+
+// parseJSONEntries parses JSON with comments and trailing commas into flattened entries in document order
+func parseJSONEntries(content, separator string) ([]configEntry, bool) {
+	// JSON is YAML, which tolerates the trailing commas of hand-written settings
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(stripJSONComments(content)), &node); err != nil {
+		return nil, false
+	}
+	if len(node.Content) == 0 || node.Content[0].Kind != yaml.MappingNode {
+		return nil, false
+	}
+
+	var entries []configEntry
+	flattenYAML(&node, "", separator, &entries)
+	for i := range entries {
+		// Arrays are addressed by index segments in .NET configuration (Section:0:Key)
+		entries[i].key = strings.NewReplacer("[", separator, "]", "").Replace(entries[i].key)
+	}
+	return entries, true
+}
+
+// stripJSONComments removes // and /* */ comments outside of strings and replaces tabs
+func stripJSONComments(content string) string {
+	var builder strings.Builder
+	inString, escaped := false, false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if inString {
+			builder.WriteByte(c)
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '"':
+			inString = true
+			builder.WriteByte(c)
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			builder.WriteByte('\n')
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			end := strings.Index(content[i+2:], "*/")
+			if end < 0 {
+				return builder.String()
+			}
+			i += end + 3
+		case c == '\t':
+			builder.WriteByte(' ')
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,217 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConfigParser_FileNames(t *testing.T) {
+	parser := NewAppConfigParser()
+
+	profile, ok := parser.IsSpringConfigFile("application.yml")
+	assert.True(t, ok)
+	assert.Empty(t, profile)
+	profile, ok = parser.IsSpringConfigFile("application-prod.properties")
+	assert.True(t, ok)
+	assert.Equal(t, "prod", profile)
+	_, ok = parser.IsSpringConfigFile("bootstrap.yaml")
+	assert.True(t, ok)
+	_, ok = parser.IsSpringConfigFile("application.json")
+	assert.False(t, ok)
+
+	environment, ok := parser.IsAspNetSettingsFile("appsettings.Development.json")
+	assert.True(t, ok)
+	assert.Equal(t, "Development", environment)
+	_, ok = parser.IsAspNetSettingsFile("appsettings.json")
+	assert.True(t, ok)
+	_, ok = parser.IsAspNetSettingsFile("settings.json")
+	assert.False(t, ok)
+}
+
+func TestConnectionTech(t *testing.T) {
+	tests := []struct {
+		value  string
+		tech   string
+		scheme string
+	}{
+		{"jdbc:postgresql://db:5432/orders", "postgresql", "jdbc:postgresql"},
+		{"jdbc:tc:mysql:8.0:///test", "mysql", "jdbc:mysql"},
+		{"jdbc:sqlserver://db;databaseName=orders", "mssql", "jdbc:sqlserver"},
+		{"jdbc:oracle:thin:@db:1521:orcl", "oracle", "jdbc:oracle"},
+		{"r2dbc:pool:postgresql://db/orders", "postgresql", "r2dbc:postgresql"},
+		{"jdbc:h2:mem:test", "", ""},
+		{"mongodb+srv://cluster0.example.net/shop", "mongodb", "mongodb+srv"},
+		{"rediss://cache:6380", "redis", "rediss"},
+		{"amqps://broker", "rabbitmq", "amqps"},
+		{"https://example.com", "", "https"},
+		{"Host=db;Database=orders;Username=app;Password=secret", "postgresql", ""},
+		{"Server=(localdb)\\mssqllocaldb;Database=Orders;Trusted_Connection=True;MultipleActiveResultSets=true", "mssql", ""},
+		{"Server=db;Port=3306;Database=orders;Uid=app;Pwd=secret", "mysql", ""},
+		{"Data Source=app.db", "sqlite", ""},
+		{"cache.redis.cache.windows.net:6380,password=secret,ssl=True,abortConnect=False", "redis", ""},
+		{"Endpoint=sb://orders.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret", "azure.servicebus", ""},
+		{"DefaultEndpointsProtocol=https;AccountName=orders;AccountKey=secret;EndpointSuffix=core.windows.net", "azure.storage", ""},
+		{"UseDevelopmentStorage=true", "azure.storage", ""},
+		{"AccountEndpoint=https://orders.documents.azure.com:443/;AccountKey=secret", "azure.cosmosdb", ""},
+		{"Information", "", ""},
+	}
+	for _, tt := range tests {
+		tech, scheme := ConnectionTech(tt.value)
+		assert.Equal(t, tt.tech, tech, tt.value)
+		if tt.tech != "" {
+			assert.Equal(t, tt.scheme, scheme, tt.value)
+		}
+	}
+}
+
+func TestAppConfigParser_ParseSpringConfig(t *testing.T) {
+	parser := NewAppConfigParser()
+
+	content := `spring:
+  application:
+    name: orders
+  profiles:
+    active: ${SPRING_PROFILES_ACTIVE:local}
+    include: metrics, tracing
+  datasource:
+    url: jdbc:postgresql://db:5432/orders
+    username: app
+    password: secret
+  kafka:
+    bootstrap-servers: kafka:9092
+  data:
+    redis:
+      host: cache
+  security:
+    oauth2:
+      resourceserver:
+        jwt:
+          issuer-uri: https://sso.example.com/realms/shop
+server:
+  port: ${PORT:8443}
+  ssl:
+    enabled: true
+management:
+  server:
+    port: 9090
+---
+spring:
+  config:
+    activate:
+      on-profile: prod
+  rabbitmq:
+    host: broker
+`
+	config := parser.ParseSpringConfig("application.yml", content)
+	require.NotNil(t, config)
+	assert.Equal(t, AppConfigSpring, config.Framework)
+	assert.Equal(t, []string{"prod"}, config.Profiles)
+	assert.Equal(t, []string{"metrics", "tracing"}, config.ActiveProfiles)
+	assert.Equal(t, []string{"https://sso.example.com/realms/shop"}, config.Issuers)
+	assert.Equal(t, []AppConnection{
+		{Key: "spring.datasource.url", Tech: "postgresql", Scheme: "jdbc:postgresql"},
+		{Key: "spring.kafka.bootstrap-servers", Tech: "apache_kafka"},
+		{Key: "spring.data.redis.host", Tech: "redis"},
+		{Key: "spring.security.oauth2.resourceserver.jwt.issuer-uri", Tech: "keycloak"},
+		{Key: "spring.rabbitmq.host", Tech: "rabbitmq"},
+	}, config.Connections)
+	assert.Equal(t, []types.ServiceRef{
+		{Host: "db", Tech: "postgresql", Read: true, Write: true},
+		{Host: "kafka", Tech: "apache_kafka"},
+		{Host: "cache", Tech: "redis"},
+		{Host: "sso.example.com"},
+		{Host: "broker", Tech: "rabbitmq"},
+	}, config.References)
+	assert.Equal(t, []types.Endpoint{
+		{Port: 8443, Protocol: "https", Exposure: types.EndpointInternal, Source: AppConfigSpring},
+		{Port: 9090, Protocol: "http", Exposure: types.EndpointInternal, Source: AppConfigSpring},
+	}, config.Endpoints)
+
+	properties := `# Orders service
+spring.datasource.url=jdbc:mysql://db:3306/orders
+spring.data.mongodb.uri = mongodb://docs/orders
+spring.cloud.azure.servicebus.namespace: orders
+spring.security.oauth2.client.provider.okta.issuer-uri=\
+  https://dev-123.okta.com/oauth2/default
+#---
+spring.config.activate.on-profile=cloud
+spring.elasticsearch.uris=http://search:9200
+`
+	config = parser.ParseSpringConfig("application-staging.properties", properties)
+	require.NotNil(t, config)
+	assert.Equal(t, []string{"staging", "cloud"}, config.Profiles)
+	techs := make([]string, 0, len(config.Connections))
+	for _, connection := range config.Connections {
+		techs = append(techs, connection.Tech)
+	}
+	assert.Equal(t, []string{"mysql", "mongodb", "azure.servicebus", "okta", "elasticsearch"}, techs)
+	assert.Equal(t, []string{"https://dev-123.okta.com/oauth2/default"}, config.Issuers)
+
+	assert.Nil(t, parser.ParseSpringConfig("application.yml", "logging:\n  level:\n    root: info\n"))
+	assert.Nil(t, parser.ParseSpringConfig("application.yml", "spring: [unclosed\n"))
+}
+
+func TestAppConfigParser_ParseAspNetSettings(t *testing.T) {
+	parser := NewAppConfigParser()
+
+	content := `{
+  // Connection strings are replaced at deploy time
+  "ConnectionStrings": {
+    "Orders": "Server=tcp:orders.database.windows.net,1433;Initial Catalog=orders;User ID=app;Password=secret;",
+    "Cache": "cache:6379,abortConnect=false",
+  },
+  /* Identity */
+  "AzureAd": {
+    "Instance": "https://login.microsoftonline.com/",
+    "TenantId": "00000000-0000-0000-0000-000000000000",
+    "Authority": "https://login.microsoftonline.com/contoso.onmicrosoft.com/v2.0"
+  },
+  "Kafka": {
+    "BootstrapServers": "kafka:9092"
+  },
+  "Logging": { "LogLevel": { "Default": "Information" } },
+  "Urls": "http://*:5000",
+  "Kestrel": { "Endpoints": { "Https": { "Url": "https://0.0.0.0" } } }
+}`
+	config := parser.ParseAspNetSettings("appsettings.Production.json", content)
+	require.NotNil(t, config)
+	assert.Equal(t, AppConfigAspNet, config.Framework)
+	assert.Equal(t, []string{"Production"}, config.Environments)
+	assert.Equal(t, []string{"https://login.microsoftonline.com/contoso.onmicrosoft.com/v2.0"}, config.Issuers)
+	assert.Equal(t, []AppConnection{
+		{Key: "ConnectionStrings:Orders", Tech: "mssql"},
+		{Key: "ConnectionStrings:Cache", Tech: "redis"},
+		{Key: "AzureAd:Instance", Tech: "azure.entraid"},
+		{Key: "Kafka:BootstrapServers", Tech: "apache_kafka"},
+	}, config.Connections)
+	assert.Equal(t, []types.Endpoint{
+		{Port: 5000, Protocol: "http", Exposure: types.EndpointInternal, Source: AppConfigAspNet},
+		{Port: 443, Protocol: "https", Exposure: types.EndpointInternal, Source: AppConfigAspNet},
+	}, config.Endpoints)
+
+	assert.Nil(t, parser.ParseAspNetSettings("appsettings.json", `{"Logging": {"LogLevel": {"Default": "Warning"}}}`))
+	assert.Nil(t, parser.ParseAspNetSettings("appsettings.json", `not json`))
+}
+
+func TestAppConfigParser_ParseLaunchSettings(t *testing.T) {
+	content := `{
+  "profiles": {
+    "https": {
+      "commandName": "Project",
+      "environmentVariables": { "ASPNETCORE_ENVIRONMENT": "Development" }
+    },
+    "Docker": {
+      "commandName": "Docker",
+      "environmentVariables": { "ASPNETCORE_ENVIRONMENT": "Staging" }
+    }
+  }
+}`
+	config := NewAppConfigParser().ParseLaunchSettings(content)
+	require.NotNil(t, config)
+	assert.Equal(t, []string{"Docker", "https"}, config.Profiles)
+	assert.Equal(t, []string{"Development", "Staging"}, config.Environments)
+	assert.Empty(t, config.Connections)
+}
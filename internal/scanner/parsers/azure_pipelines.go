@@ -0,0 +1,213 @@
+package parsers
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AzurePipelinesParser handles Azure Pipelines configuration parsing (azure-pipelines.yml and templates)
+type AzurePipelinesParser struct{}
+
+// NewAzurePipelinesParser creates a new Azure Pipelines parser
+func NewAzurePipelinesParser() *AzurePipelinesParser {
+	return &AzurePipelinesParser{}
+}
+
+// AzurePipelinesTask represents a task reference such as Docker@2
+type AzurePipelinesTask struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"` // Major version after "@"
+}
+
+// AzurePipelinesTemplate represents a template reference such as templates/build.yml@shared
+type AzurePipelinesTemplate struct {
+	Path       string `json:"path"`
+	Repository string `json:"repository,omitempty"` // Alias of a repository resource
+}
+
+// AzurePipelinesRepository represents a repository resource
+type AzurePipelinesRepository struct {
+	Alias string `json:"alias"`
+	Type  string `json:"type,omitempty"` // git, github, githubenterprise, bitbucket
+	Name  string `json:"name,omitempty"`
+	Ref   string `json:"ref,omitempty"`
+}
+
+// AzurePipelinesContainer represents a container resource or a job container
+type AzurePipelinesContainer struct {
+	Alias string `json:"alias,omitempty"`
+	Image string `json:"image"`
+}
+
+// AzurePipeline represents parsed information from an Azure Pipelines file
+type AzurePipeline struct {
+	File         string                     `json:"file,omitempty"`
+	Stages       []string                   `json:"stages,omitempty"`
+	Jobs         []string                   `json:"jobs,omitempty"`
+	Pools        []string                   `json:"pools,omitempty"` // vmImage or pool names
+	Tasks        []AzurePipelinesTask       `json:"tasks,omitempty"`
+	Templates    []AzurePipelinesTemplate   `json:"templates,omitempty"`
+	Repositories []AzurePipelinesRepository `json:"repositories,omitempty"`
+	Containers   []AzurePipelinesContainer  `json:"containers,omitempty"`
+	Scripts      []string                   `json:"-"` // script and bash steps
+}
+
+// IsEmpty reports whether nothing pipeline specific was found
+func (p *AzurePipeline) IsEmpty() bool {
+	return len(p.Stages) == 0 && len(p.Jobs) == 0 && len(p.Pools) == 0 && len(p.Tasks) == 0 &&
+		len(p.Templates) == 0 && len(p.Repositories) == 0 && len(p.Containers) == 0
+}
+
+// ParseAzurePipelines parses an Azure Pipelines file
+// Stages, jobs and steps can be nested at any depth (including template files), so the
+// YAML node tree is walked and keywords are collected wherever they appear
+func (p *AzurePipelinesParser) ParseAzurePipelines(content string) *AzurePipeline {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil
+	}
+
+	pipeline := &AzurePipeline{}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return pipeline
+	}
+
+	root := doc.Content[0]
+	if resources := yamlMappingValue(root, "resources"); resources != nil {
+		p.parseResources(resources, pipeline)
+	}
+	p.walk(root, pipeline)
+
+	return pipeline
+}
+
+func (p *AzurePipelinesParser) parseResources(resources *yaml.Node, pipeline *AzurePipeline) {
+	if repositories := yamlMappingValue(resources, "repositories"); repositories != nil && repositories.Kind == yaml.SequenceNode {
+		for _, repository := range repositories.Content {
+			alias := yamlScalar(repository, "repository")
+			if alias == "" {
+				continue
+			}
+			pipeline.Repositories = append(pipeline.Repositories, AzurePipelinesRepository{
+				Alias: alias,
+				Type:  yamlScalar(repository, "type"),
+				Name:  yamlScalar(repository, "name"),
+				Ref:   yamlScalar(repository, "ref"),
+			})
+		}
+	}
+
+	if containers := yamlMappingValue(resources, "containers"); containers != nil && containers.Kind == yaml.SequenceNode {
+		for _, container := range containers.Content {
+			if image := yamlScalar(container, "image"); image != "" {
+				pipeline.Containers = append(pipeline.Containers, AzurePipelinesContainer{
+					Alias: yamlScalar(container, "container"),
+					Image: image,
+				})
+			}
+		}
+	}
+}
+
+func (p *AzurePipelinesParser) walk(node *yaml.Node, pipeline *AzurePipeline) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			p.walk(item, pipeline)
+		}
+	case yaml.MappingNode:
+		p.collect(node, pipeline)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			// Resources are handled separately, their "container" keys are aliases
+			if node.Content[i].Value == "resources" {
+				continue
+			}
+			p.walk(node.Content[i+1], pipeline)
+		}
+	}
+}
+
+// collect records the keywords of a single mapping (stage, job, step or pool)
+func (p *AzurePipelinesParser) collect(node *yaml.Node, pipeline *AzurePipeline) {
+	if stage := yamlScalar(node, "stage"); stage != "" {
+		pipeline.Stages = append(pipeline.Stages, stage)
+	}
+	for _, key := range []string{"job", "deployment"} {
+		if job := yamlScalar(node, key); job != "" {
+			pipeline.Jobs = append(pipeline.Jobs, job)
+		}
+	}
+
+	if task := yamlScalar(node, "task"); task != "" {
+		name, version, _ := strings.Cut(task, "@")
+		pipeline.Tasks = appendUniqueTask(pipeline.Tasks, AzurePipelinesTask{Name: name, Version: version})
+	}
+
+	// powershell and pwsh steps are not shell scripts
+	for _, key := range []string{"script", "bash"} {
+		if script := yamlScalar(node, key); script != "" {
+			pipeline.Scripts = append(pipeline.Scripts, script)
+		}
+	}
+
+	if template := yamlScalar(node, "template"); template != "" {
+		path, repository, _ := strings.Cut(template, "@")
+		reference := AzurePipelinesTemplate{Path: path, Repository: repository}
+		if !containsTemplate(pipeline.Templates, reference) {
+			pipeline.Templates = append(pipeline.Templates, reference)
+		}
+	}
+
+	if pool := yamlMappingValue(node, "pool"); pool != nil {
+		name := pool.Value
+		if pool.Kind == yaml.MappingNode {
+			name = yamlScalar(pool, "vmImage")
+			if name == "" {
+				name = yamlScalar(pool, "name")
+			}
+		}
+		if name != "" && !containsString(pipeline.Pools, name) {
+			pipeline.Pools = append(pipeline.Pools, name)
+		}
+	}
+
+	// A job container is either the alias of a container resource or an image
+	if container := yamlMappingValue(node, "container"); container != nil {
+		image := container.Value
+		if container.Kind == yaml.MappingNode {
+			image = yamlScalar(container, "image")
+		}
+		if image != "" && !pipeline.hasContainer(image) {
+			pipeline.Containers = append(pipeline.Containers, AzurePipelinesContainer{Image: image})
+		}
+	}
+}
+
+// hasContainer reports whether a container resource with this alias or image exists
+func (p *AzurePipeline) hasContainer(reference string) bool {
+	for _, container := range p.Containers {
+		if container.Alias == reference || container.Image == reference {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUniqueTask(tasks []AzurePipelinesTask, task AzurePipelinesTask) []AzurePipelinesTask {
+	for _, existing := range tasks {
+		if existing == task {
+			return tasks
+		}
+	}
+	return append(tasks, task)
+}
+
+func containsTemplate(templates []AzurePipelinesTemplate, template AzurePipelinesTemplate) bool {
+	for _, existing := range templates {
+		if existing == template {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAzurePipelines(t *testing.T) {
+	content := `trigger:
+  - main
+
+resources:
+  repositories:
+    - repository: templates
+      type: github
+      name: contoso/pipeline-templates
+      ref: refs/tags/v1.2
+      endpoint: contoso
+  containers:
+    - container: builder
+      image: mcr.microsoft.com/dotnet/sdk:8.0
+
+pool:
+  vmImage: ubuntu-latest
+
+stages:
+  - stage: Build
+    jobs:
+      - job: Compile
+        container: builder
+        steps:
+          - task: UseDotNet@2
+            inputs:
+              version: 8.x
+          - task: Docker@2
+            inputs:
+              command: buildAndPush
+          - template: steps/test.yml
+          - script: dotnet build
+  - stage: Deploy
+    jobs:
+      - deployment: Production
+        pool: self-hosted
+        container:
+          image: mcr.microsoft.com/azure-cli:2.60
+        strategy:
+          runOnce:
+            deploy:
+              steps:
+                - task: AzureFunctionApp@2
+                - task: Docker@2
+                - template: deploy.yml@templates
+                  parameters:
+                    environment: prod
+`
+
+	pipeline := NewAzurePipelinesParser().ParseAzurePipelines(content)
+	require.NotNil(t, pipeline)
+
+	assert.Equal(t, []string{"Build", "Deploy"}, pipeline.Stages)
+	assert.Equal(t, []string{"Compile", "Production"}, pipeline.Jobs)
+	assert.Equal(t, []string{"ubuntu-latest", "self-hosted"}, pipeline.Pools)
+	assert.Equal(t, []AzurePipelinesTask{
+		{Name: "UseDotNet", Version: "2"},
+		{Name: "Docker", Version: "2"},
+		{Name: "AzureFunctionApp", Version: "2"},
+	}, pipeline.Tasks)
+	assert.Equal(t, []AzurePipelinesTemplate{
+		{Path: "steps/test.yml"},
+		{Path: "deploy.yml", Repository: "templates"},
+	}, pipeline.Templates)
+	assert.Equal(t, []AzurePipelinesRepository{
+		{Alias: "templates", Type: "github", Name: "contoso/pipeline-templates", Ref: "refs/tags/v1.2"},
+	}, pipeline.Repositories)
+	assert.Equal(t, []AzurePipelinesContainer{
+		{Alias: "builder", Image: "mcr.microsoft.com/dotnet/sdk:8.0"},
+		{Image: "mcr.microsoft.com/azure-cli:2.60"},
+	}, pipeline.Containers)
+}
+
+func TestParseAzurePipelines_StepsTemplate(t *testing.T) {
+	content := `parameters:
+  - name: configuration
+    default: Release
+steps:
+  - task: NuGetCommand@2
+  - task: ${{ parameters.extraTask }}
+`
+
+	pipeline := NewAzurePipelinesParser().ParseAzurePipelines(content)
+	require.NotNil(t, pipeline)
+	assert.False(t, pipeline.IsEmpty())
+	assert.Equal(t, []AzurePipelinesTask{
+		{Name: "NuGetCommand", Version: "2"},
+		{Name: "${{ parameters.extraTask }}"},
+	}, pipeline.Tasks)
+}
+
+func TestParseAzurePipelines_Empty(t *testing.T) {
+	parser := NewAzurePipelinesParser()
+
+	pipeline := parser.ParseAzurePipelines("name: settings\nvalues: [1, 2]\n")
+	require.NotNil(t, pipeline)
+	assert.True(t, pipeline.IsEmpty())
+
+	assert.Nil(t, parser.ParseAzurePipelines("steps: [unclosed\n"))
+}
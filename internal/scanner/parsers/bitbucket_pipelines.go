@@ -0,0 +1,183 @@
+package parsers
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BitbucketPipelinesParser handles Bitbucket Pipelines configuration parsing (bitbucket-pipelines.yml)
+type BitbucketPipelinesParser struct{}
+
+// NewBitbucketPipelinesParser creates a new Bitbucket Pipelines parser
+func NewBitbucketPipelinesParser() *BitbucketPipelinesParser {
+	return &BitbucketPipelinesParser{}
+}
+
+// BitbucketPipe represents a pipe used in a step script such as atlassian/aws-s3-deploy:1.1.0
+type BitbucketPipe struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// BitbucketService represents a service container declared under definitions
+type BitbucketService struct {
+	Name  string `json:"name"`
+	Image string `json:"image,omitempty"` // Empty for the built-in docker service
+}
+
+// BitbucketPipeline represents parsed information from a Bitbucket Pipelines file
+type BitbucketPipeline struct {
+	File      string             `json:"file,omitempty"`
+	Image     string             `json:"image,omitempty"` // Default image
+	Pipelines []string           `json:"pipelines,omitempty"`
+	Steps     int                `json:"steps,omitempty"`
+	Images    []string           `json:"images,omitempty"` // Step images
+	Services  []BitbucketService `json:"services,omitempty"`
+	Pipes     []BitbucketPipe    `json:"pipes,omitempty"`
+	Scripts   []string           `json:"-"` // script and after-script lines
+}
+
+// IsEmpty reports whether the file defines no pipelines
+func (p *BitbucketPipeline) IsEmpty() bool {
+	return p.Steps == 0 && len(p.Pipelines) == 0
+}
+
+// AllImages returns all distinct images (default, step and service images)
+func (p *BitbucketPipeline) AllImages() []string {
+	var images []string
+	add := func(image string) {
+		if image != "" && !containsString(images, image) {
+			images = append(images, image)
+		}
+	}
+
+	add(p.Image)
+	for _, image := range p.Images {
+		add(image)
+	}
+	for _, service := range p.Services {
+		add(service.Image)
+	}
+	return images
+}
+
+// ParseBitbucketPipelines parses a Bitbucket Pipelines file
+func (p *BitbucketPipelinesParser) ParseBitbucketPipelines(content string) *BitbucketPipeline {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil
+	}
+
+	pipeline := &BitbucketPipeline{}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return pipeline
+	}
+	root := doc.Content[0]
+
+	if image := yamlMappingValue(root, "image"); image != nil {
+		pipeline.Image = bitbucketImage(image)
+	}
+
+	if definitions := yamlMappingValue(root, "definitions"); definitions != nil {
+		if services := yamlMappingValue(definitions, "services"); services != nil && services.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(services.Content); i += 2 {
+				service := BitbucketService{Name: services.Content[i].Value}
+				if image := yamlMappingValue(services.Content[i+1], "image"); image != nil {
+					service.Image = bitbucketImage(image)
+				}
+				pipeline.Services = append(pipeline.Services, service)
+			}
+		}
+		// Steps shared through YAML anchors are declared below definitions
+		p.walk(definitions, pipeline)
+	}
+
+	if pipelines := yamlMappingValue(root, "pipelines"); pipelines != nil && pipelines.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(pipelines.Content); i += 2 {
+			pipeline.Pipelines = append(pipeline.Pipelines, pipelines.Content[i].Value)
+			p.walk(pipelines.Content[i+1], pipeline)
+		}
+	}
+
+	return pipeline
+}
+
+// walk collects steps, their images and the pipes of their scripts
+// Steps are nested below branches, pull-requests, parallel and stage blocks
+func (p *BitbucketPipelinesParser) walk(node *yaml.Node, pipeline *BitbucketPipeline) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		// Anchored steps are counted where they are declared
+		return
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			p.walk(item, pipeline)
+		}
+	case yaml.MappingNode:
+		if step := yamlMappingValue(node, "step"); step != nil && step.Kind == yaml.MappingNode {
+			p.parseStep(step, pipeline)
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			p.walk(node.Content[i+1], pipeline)
+		}
+	}
+}
+
+func (p *BitbucketPipelinesParser) parseStep(step *yaml.Node, pipeline *BitbucketPipeline) {
+	pipeline.Steps++
+
+	if image := yamlMappingValue(step, "image"); image != nil {
+		if name := bitbucketImage(image); name != "" && !containsString(pipeline.Images, name) {
+			pipeline.Images = append(pipeline.Images, name)
+		}
+	}
+
+	for _, key := range []string{"script", "after-script"} {
+		script := yamlMappingValue(step, key)
+		if script == nil || script.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, command := range script.Content {
+			if command.Kind == yaml.ScalarNode {
+				pipeline.Scripts = append(pipeline.Scripts, command.Value)
+				continue
+			}
+			pipe := yamlScalar(command, "pipe")
+			if pipe == "" {
+				continue
+			}
+			reference := parseBitbucketPipe(pipe)
+			if !containsPipe(pipeline.Pipes, reference) {
+				pipeline.Pipes = append(pipeline.Pipes, reference)
+			}
+		}
+	}
+}
+
+// parseBitbucketPipe splits atlassian/aws-s3-deploy:1.1.0 or docker://acme/pipe:2 into name and version
+func parseBitbucketPipe(pipe string) BitbucketPipe {
+	name, version := SplitImageReference(strings.TrimPrefix(pipe, "docker://"))
+	if version == "latest" && !strings.HasSuffix(pipe, ":latest") {
+		version = ""
+	}
+	return BitbucketPipe{Name: name, Version: version}
+}
+
+// bitbucketImage returns the image of "image: name" or "image: {name: ...}"
+func bitbucketImage(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return yamlScalar(node, "name")
+}
+
+func containsPipe(pipes []BitbucketPipe, pipe BitbucketPipe) bool {
+	for _, existing := range pipes {
+		if existing == pipe {
+			return true
+		}
+	}
+	return false
+}
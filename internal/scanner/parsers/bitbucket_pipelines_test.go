@@ -0,0 +1,89 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBitbucketPipelines(t *testing.T) {
+	content := `image: node:20
+
+definitions:
+  services:
+    postgres:
+      image: postgres:16
+    docker:
+      memory: 2048
+  steps:
+    - step: &test
+        name: Test
+        services: [postgres]
+        script:
+          - npm ci
+          - npm test
+
+pipelines:
+  default:
+    - step: *test
+  branches:
+    main:
+      - step: *test
+      - parallel:
+          - step:
+              name: Lint
+              image:
+                name: registry.example.com/tools/eslint:9
+              script:
+                - npx eslint .
+          - step:
+              name: Scan
+              script:
+                - pipe: snyk/snyk-scan:1.0.1
+      - stage:
+          name: Deploy
+          steps:
+            - step:
+                name: Upload
+                script:
+                  - pipe: atlassian/aws-s3-deploy:1.1.0
+                    variables:
+                      S3_BUCKET: assets
+                  - pipe: docker://acme/notify:2
+                after-script:
+                  - pipe: atlassian/slack-notify:2.2.0
+  pull-requests:
+    '**':
+      - step: *test
+`
+
+	pipeline := NewBitbucketPipelinesParser().ParseBitbucketPipelines(content)
+	require.NotNil(t, pipeline)
+
+	assert.Equal(t, "node:20", pipeline.Image)
+	assert.Equal(t, []string{"default", "branches", "pull-requests"}, pipeline.Pipelines)
+	assert.Equal(t, 4, pipeline.Steps)
+	assert.Equal(t, []string{"registry.example.com/tools/eslint:9"}, pipeline.Images)
+	assert.Equal(t, []BitbucketService{
+		{Name: "postgres", Image: "postgres:16"},
+		{Name: "docker"},
+	}, pipeline.Services)
+	assert.Equal(t, []BitbucketPipe{
+		{Name: "snyk/snyk-scan", Version: "1.0.1"},
+		{Name: "atlassian/aws-s3-deploy", Version: "1.1.0"},
+		{Name: "acme/notify", Version: "2"},
+		{Name: "atlassian/slack-notify", Version: "2.2.0"},
+	}, pipeline.Pipes)
+	assert.Equal(t, []string{"node:20", "registry.example.com/tools/eslint:9", "postgres:16"}, pipeline.AllImages())
+}
+
+func TestParseBitbucketPipelines_Empty(t *testing.T) {
+	parser := NewBitbucketPipelinesParser()
+
+	pipeline := parser.ParseBitbucketPipelines("image: node:20\n")
+	require.NotNil(t, pipeline)
+	assert.True(t, pipeline.IsEmpty())
+
+	assert.Nil(t, parser.ParseBitbucketPipelines("pipelines: [unclosed\n"))
+}
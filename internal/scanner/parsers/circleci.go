@@ -0,0 +1,200 @@
+package parsers
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CircleCIParser handles CircleCI configuration parsing (.circleci/config.yml)
+type CircleCIParser struct{}
+
+// NewCircleCIParser creates a new CircleCI parser
+func NewCircleCIParser() *CircleCIParser {
+	return &CircleCIParser{}
+}
+
+// CircleCIOrb represents an orb import such as node: circleci/node@5.1.0
+type CircleCIOrb struct {
+	Alias   string `json:"alias"`
+	Name    string `json:"name,omitempty"` // Empty for inline orbs
+	Version string `json:"version,omitempty"`
+}
+
+// CircleCIExecutor represents an executor, either declared under executors or inline in a job
+type CircleCIExecutor struct {
+	Name   string   `json:"name"`
+	Type   string   `json:"type"` // docker, machine, macos
+	Images []string `json:"images,omitempty"`
+}
+
+// CircleCIJob represents a job of the configuration
+type CircleCIJob struct {
+	Name     string `json:"name"`
+	Executor string `json:"executor,omitempty"` // Named executor or orb executor (node/default)
+}
+
+// CircleCIConfig represents parsed information from a CircleCI configuration
+type CircleCIConfig struct {
+	File      string             `json:"file,omitempty"`
+	Version   string             `json:"version,omitempty"`
+	Orbs      []CircleCIOrb      `json:"orbs,omitempty"`
+	Executors []CircleCIExecutor `json:"executors,omitempty"`
+	Jobs      []CircleCIJob      `json:"jobs,omitempty"`
+	Workflows []string           `json:"workflows,omitempty"`
+	Scripts   []string           `json:"-"` // run steps of jobs and commands
+}
+
+// IsEmpty reports whether the configuration defines neither orbs, executors, jobs nor workflows
+func (c *CircleCIConfig) IsEmpty() bool {
+	return len(c.Orbs) == 0 && len(c.Executors) == 0 && len(c.Jobs) == 0 && len(c.Workflows) == 0
+}
+
+// Images returns all distinct images of docker executors (machine images are VM images)
+func (c *CircleCIConfig) Images() []string {
+	var images []string
+	for _, executor := range c.Executors {
+		if executor.Type != "docker" {
+			continue
+		}
+		for _, image := range executor.Images {
+			if !containsString(images, image) {
+				images = append(images, image)
+			}
+		}
+	}
+	return images
+}
+
+// circleCIExecutorTypes are the keys declaring an executor
+var circleCIExecutorTypes = []string{"docker", "machine", "macos"}
+
+// ParseCircleCI parses a CircleCI configuration
+func (p *CircleCIParser) ParseCircleCI(content string) *CircleCIConfig {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil
+	}
+
+	config := &CircleCIConfig{}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return config
+	}
+	root := doc.Content[0]
+
+	config.Version = yamlScalar(root, "version")
+
+	if orbs := yamlMappingValue(root, "orbs"); orbs != nil && orbs.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(orbs.Content); i += 2 {
+			orb := CircleCIOrb{Alias: orbs.Content[i].Value}
+			// Inline orbs are mappings and have no registry reference
+			if orbs.Content[i+1].Kind == yaml.ScalarNode {
+				orb.Name, orb.Version, _ = strings.Cut(orbs.Content[i+1].Value, "@")
+			}
+			config.Orbs = append(config.Orbs, orb)
+		}
+	}
+
+	if executors := yamlMappingValue(root, "executors"); executors != nil && executors.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(executors.Content); i += 2 {
+			if executor, ok := parseCircleCIExecutor(executors.Content[i].Value, executors.Content[i+1]); ok {
+				config.Executors = append(config.Executors, executor)
+			}
+		}
+	}
+
+	if jobs := yamlMappingValue(root, "jobs"); jobs != nil && jobs.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(jobs.Content); i += 2 {
+			name, node := jobs.Content[i].Value, jobs.Content[i+1]
+			job := CircleCIJob{Name: name, Executor: circleCIExecutorReference(node)}
+
+			// Jobs with an inline executor get an executor named after the job
+			if executor, ok := parseCircleCIExecutor(name, node); ok {
+				config.Executors = append(config.Executors, executor)
+				job.Executor = name
+			}
+			config.Jobs = append(config.Jobs, job)
+		}
+	}
+
+	for _, key := range []string{"jobs", "commands"} {
+		if section := yamlMappingValue(root, key); section != nil {
+			config.Scripts = append(config.Scripts, circleCIRunCommands(section)...)
+		}
+	}
+
+	if workflows := yamlMappingValue(root, "workflows"); workflows != nil && workflows.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(workflows.Content); i += 2 {
+			// "version" is a legacy key of the workflows section
+			if workflows.Content[i+1].Kind == yaml.MappingNode {
+				config.Workflows = append(config.Workflows, workflows.Content[i].Value)
+			}
+		}
+	}
+
+	return config
+}
+
+// parseCircleCIExecutor reads the executor declared by a docker, machine or macos key
+func parseCircleCIExecutor(name string, node *yaml.Node) (CircleCIExecutor, bool) {
+	for _, executorType := range circleCIExecutorTypes {
+		value := yamlMappingValue(node, executorType)
+		if value == nil {
+			continue
+		}
+
+		executor := CircleCIExecutor{Name: name, Type: executorType}
+		switch {
+		case executorType == "docker" && value.Kind == yaml.SequenceNode:
+			// The first image is the primary container, the others are service containers
+			for _, container := range value.Content {
+				if image := yamlScalar(container, "image"); image != "" {
+					executor.Images = append(executor.Images, image)
+				}
+			}
+		case value.Kind == yaml.MappingNode:
+			if image := yamlScalar(value, "image"); image != "" {
+				executor.Images = append(executor.Images, image)
+			}
+		}
+		return executor, true
+	}
+	return CircleCIExecutor{}, false
+}
+
+// circleCIRunCommands returns the commands of "run: command" and "run: {command: ...}" steps below a node
+func circleCIRunCommands(node *yaml.Node) []string {
+	var commands []string
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			commands = append(commands, circleCIRunCommands(item)...)
+		}
+	case yaml.MappingNode:
+		if run := yamlMappingValue(node, "run"); run != nil {
+			if run.Kind == yaml.ScalarNode {
+				commands = append(commands, run.Value)
+			} else if command := yamlScalar(run, "command"); command != "" {
+				commands = append(commands, command)
+			}
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != "run" {
+				commands = append(commands, circleCIRunCommands(node.Content[i+1])...)
+			}
+		}
+	}
+	return commands
+}
+
+// circleCIExecutorReference returns the executor of "executor: name" or "executor: {name: ...}"
+func circleCIExecutorReference(node *yaml.Node) string {
+	executor := yamlMappingValue(node, "executor")
+	if executor == nil {
+		return ""
+	}
+	if executor.Kind == yaml.ScalarNode {
+		return executor.Value
+	}
+	return yamlScalar(executor, "name")
+}
@@ -0,0 +1,92 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCircleCI(t *testing.T) {
+	content := `version: 2.1
+
+orbs:
+  node: circleci/node@5.1.0
+  aws-ecr: circleci/aws-ecr@9.0
+  local:
+    commands:
+      hello:
+        steps:
+          - run: echo hello
+
+executors:
+  python:
+    docker:
+      - image: cimg/python:3.12
+      - image: cimg/postgres:16.1
+        environment:
+          POSTGRES_USER: app
+  vm:
+    machine:
+      image: ubuntu-2204:2024.01.1
+
+jobs:
+  test:
+    executor: python
+    steps:
+      - checkout
+  lint:
+    docker:
+      - image: cimg/node:20.11
+    steps:
+      - checkout
+  build:
+    executor:
+      name: node/default
+      tag: "20.11"
+    steps:
+      - node/install-packages
+
+workflows:
+  version: 2
+  ci:
+    jobs:
+      - test
+      - lint
+      - build
+`
+
+	config := NewCircleCIParser().ParseCircleCI(content)
+	require.NotNil(t, config)
+
+	assert.Equal(t, "2.1", config.Version)
+	assert.Equal(t, []CircleCIOrb{
+		{Alias: "node", Name: "circleci/node", Version: "5.1.0"},
+		{Alias: "aws-ecr", Name: "circleci/aws-ecr", Version: "9.0"},
+		{Alias: "local"},
+	}, config.Orbs)
+	assert.Equal(t, []CircleCIExecutor{
+		{Name: "python", Type: "docker", Images: []string{"cimg/python:3.12", "cimg/postgres:16.1"}},
+		{Name: "vm", Type: "machine", Images: []string{"ubuntu-2204:2024.01.1"}},
+		{Name: "lint", Type: "docker", Images: []string{"cimg/node:20.11"}},
+	}, config.Executors)
+	assert.Equal(t, []CircleCIJob{
+		{Name: "test", Executor: "python"},
+		{Name: "lint", Executor: "lint"},
+		{Name: "build", Executor: "node/default"},
+	}, config.Jobs)
+	assert.Equal(t, []string{"ci"}, config.Workflows)
+
+	// Machine images are not docker images
+	assert.Equal(t, []string{"cimg/python:3.12", "cimg/postgres:16.1", "cimg/node:20.11"}, config.Images())
+}
+
+func TestParseCircleCI_Empty(t *testing.T) {
+	parser := NewCircleCIParser()
+
+	config := parser.ParseCircleCI("version: 2.1\n")
+	require.NotNil(t, config)
+	assert.True(t, config.IsEmpty())
+
+	assert.Nil(t, parser.ParseCircleCI("jobs: [unclosed\n"))
+}
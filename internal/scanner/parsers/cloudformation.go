@@ -0,0 +1,233 @@
+package parsers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CloudFormationParser handles AWS CloudFormation, SAM and CDK file parsing
+type CloudFormationParser struct {
+	categorizer *TerraformCategorizer
+}
+
+// NewCloudFormationParser creates a new CloudFormation parser categorizing resources with the embedded taxonomy
+func NewCloudFormationParser() *CloudFormationParser {
+	return NewCloudFormationParserWithCategorizer(DefaultTerraformCategorizer())
+}
+
+// NewCloudFormationParserWithCategorizer creates a new CloudFormation parser categorizing resources with the
+// given categorizer
+func NewCloudFormationParserWithCategorizer(categorizer *TerraformCategorizer) *CloudFormationParser {
+	return &CloudFormationParser{categorizer: categorizer}
+}
+
+// samTransform is the transform that marks a template as AWS SAM
+const samTransform = "AWS::Serverless-2016-10-31"
+
+// CloudFormationResource represents an entry of the Resources section of a template
+type CloudFormationResource struct {
+	LogicalID string `json:"logical_id"`
+	Type      string `json:"type"` // e.g., "AWS::Lambda::Function"
+	Category  string `json:"category"`
+}
+
+// CloudFormationTemplate represents a parsed CloudFormation or SAM template
+type CloudFormationTemplate struct {
+	FormatVersion string
+	Description   string
+	Transforms    []string
+	Parameters    int
+	Outputs       int
+	Resources     []CloudFormationResource
+}
+
+// IsSAM reports whether the template uses the AWS SAM transform
+func (t *CloudFormationTemplate) IsSAM() bool {
+	for _, transform := range t.Transforms {
+		if transform == samTransform {
+			return true
+		}
+	}
+	return false
+}
+
+// CloudFormationInfo represents aggregated information about a CloudFormation template
+type CloudFormationInfo struct {
+	File                string         `json:"file,omitempty"`
+	Format              string         `json:"format"` // cloudformation or sam
+	FormatVersion       string         `json:"format_version,omitempty"`
+	Description         string         `json:"description,omitempty"`
+	Transforms          []string       `json:"transforms,omitempty"`
+	ResourcesByType     map[string]int `json:"resources_by_type,omitempty"`
+	ResourcesByCategory map[string]int `json:"resources_by_category,omitempty"`
+	TotalResources      int            `json:"total_resources,omitempty"`
+	TotalParameters     int            `json:"total_parameters,omitempty"`
+	TotalOutputs        int            `json:"total_outputs,omitempty"`
+}
+
+// CDKApp represents the app definition of a cdk.json file
+type CDKApp struct {
+	File     string `json:"file,omitempty"`
+	App      string `json:"app"`
+	Language string `json:"language,omitempty"`
+}
+
+// IsCloudFormationCandidate performs a cheap content check before parsing a YAML or JSON file
+func (p *CloudFormationParser) IsCloudFormationCandidate(content string) bool {
+	return strings.Contains(content, "AWSTemplateFormatVersion") || strings.Contains(content, "AWS::")
+}
+
+// ParseTemplate parses a CloudFormation template in YAML or JSON format
+// Intrinsic function tags (!Ref, !Sub, !GetAtt, ...) are tolerated by walking the YAML node tree
+// Returns nil if the content is not a CloudFormation template
+func (p *CloudFormationParser) ParseTemplate(content string) *CloudFormationTemplate {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil {
+		return nil
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		return nil
+	}
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	template := &CloudFormationTemplate{}
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i].Value, doc.Content[i+1]
+		switch key {
+		case "AWSTemplateFormatVersion":
+			template.FormatVersion = value.Value
+		case "Description":
+			if value.Kind == yaml.ScalarNode {
+				template.Description = value.Value
+			}
+		case "Transform":
+			template.Transforms = scalarValues(value)
+		case "Parameters":
+			template.Parameters = len(value.Content) / 2
+		case "Outputs":
+			template.Outputs = len(value.Content) / 2
+		case "Resources":
+			template.Resources = p.parseResources(value)
+		}
+	}
+
+	// A template needs a format version or at least one AWS resource type
+	if template.FormatVersion == "" && !hasAWSResource(template.Resources) {
+		return nil
+	}
+
+	return template
+}
+
+// parseResources extracts logical IDs and types from the Resources mapping
+func (p *CloudFormationParser) parseResources(node *yaml.Node) []CloudFormationResource {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	resources := make([]CloudFormationResource, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		logicalID, body := node.Content[i].Value, node.Content[i+1]
+		if body.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(body.Content); j += 2 {
+			if body.Content[j].Value == "Type" && body.Content[j+1].Kind == yaml.ScalarNode {
+				resourceType := body.Content[j+1].Value
+				resources = append(resources, CloudFormationResource{
+					LogicalID: logicalID,
+					Type:      resourceType,
+					Category:  p.categorizer.Categorize(resourceType),
+				})
+				break
+			}
+		}
+	}
+
+	return resources
+}
+
+func hasAWSResource(resources []CloudFormationResource) bool {
+	for _, resource := range resources {
+		if strings.HasPrefix(resource.Type, "AWS::") {
+			return true
+		}
+	}
+	return false
+}
+
+// AggregateTemplate aggregates a parsed template into CloudFormationInfo
+func (p *CloudFormationParser) AggregateTemplate(template *CloudFormationTemplate) *CloudFormationInfo {
+	if template == nil {
+		return nil
+	}
+
+	info := &CloudFormationInfo{
+		Format:          "cloudformation",
+		FormatVersion:   template.FormatVersion,
+		Description:     template.Description,
+		Transforms:      template.Transforms,
+		TotalResources:  len(template.Resources),
+		TotalParameters: template.Parameters,
+		TotalOutputs:    template.Outputs,
+	}
+	if template.IsSAM() {
+		info.Format = "sam"
+	}
+
+	if len(template.Resources) > 0 {
+		info.ResourcesByType = make(map[string]int)
+		info.ResourcesByCategory = make(map[string]int)
+		for _, resource := range template.Resources {
+			info.ResourcesByType[resource.Type]++
+			if resource.Category != "" {
+				info.ResourcesByCategory[resource.Category]++
+			}
+		}
+	}
+
+	return info
+}
+
+// ParseCDKJSON parses cdk.json and extracts the app command
+func (p *CloudFormationParser) ParseCDKJSON(content string) *CDKApp {
+	var cdk struct {
+		App string `json:"app"`
+	}
+	if err := json.Unmarshal([]byte(content), &cdk); err != nil || cdk.App == "" {
+		return nil
+	}
+
+	return &CDKApp{
+		App:      cdk.App,
+		Language: cdkAppLanguage(cdk.App),
+	}
+}
+
+// cdkAppLanguage infers the language of a CDK app from its app command
+// e.g. "npx ts-node --prefer-ts-exts bin/app.ts" -> typescript, "python3 app.py" -> python
+func cdkAppLanguage(app string) string {
+	for _, field := range strings.Fields(app) {
+		command := strings.TrimRight(field[strings.LastIndex(field, "/")+1:], "0123456789.")
+		switch {
+		case command == "ts-node" || command == "tsx" || strings.HasSuffix(field, ".ts"):
+			return "typescript"
+		case command == "python" || strings.HasSuffix(field, ".py"):
+			return "python"
+		case command == "mvn" || command == "gradle" || command == "gradlew":
+			return "java"
+		case command == "dotnet":
+			return "csharp"
+		case command == "go":
+			return "go"
+		case command == "node" || strings.HasSuffix(field, ".js"):
+			return "javascript"
+		}
+	}
+	return ""
+}
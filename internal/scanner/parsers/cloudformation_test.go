@@ -0,0 +1,168 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplate_YAMLWithIntrinsicTags(t *testing.T) {
+	content := `AWSTemplateFormatVersion: "2010-09-09"
+Description: Order processing stack
+Parameters:
+  Stage:
+    Type: String
+Resources:
+  OrdersQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: !Sub "${Stage}-orders"
+  OrdersTable:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: !Ref Stage
+  ProcessorFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Role: !GetAtt ProcessorRole.Arn
+      Environment:
+        Variables:
+          QUEUE_URL: !Ref OrdersQueue
+  ProcessorRole:
+    Type: AWS::IAM::Role
+Outputs:
+  QueueUrl:
+    Value: !Ref OrdersQueue
+`
+
+	parser := NewCloudFormationParser()
+	require.True(t, parser.IsCloudFormationCandidate(content))
+
+	template := parser.ParseTemplate(content)
+	require.NotNil(t, template)
+	assert.Equal(t, "2010-09-09", template.FormatVersion)
+	assert.Equal(t, "Order processing stack", template.Description)
+	assert.False(t, template.IsSAM())
+	require.Len(t, template.Resources, 4)
+	assert.Equal(t, CloudFormationResource{LogicalID: "OrdersQueue", Type: "AWS::SQS::Queue", Category: "messaging"}, template.Resources[0])
+
+	info := parser.AggregateTemplate(template)
+	require.NotNil(t, info)
+	assert.Equal(t, "cloudformation", info.Format)
+	assert.Equal(t, 4, info.TotalResources)
+	assert.Equal(t, 1, info.TotalParameters)
+	assert.Equal(t, 1, info.TotalOutputs)
+	assert.Equal(t, map[string]int{
+		"AWS::SQS::Queue":       1,
+		"AWS::DynamoDB::Table":  1,
+		"AWS::Lambda::Function": 1,
+		"AWS::IAM::Role":        1,
+	}, info.ResourcesByType)
+	assert.Equal(t, map[string]int{
+		"messaging":  1,
+		"database":   1,
+		"serverless": 1,
+		"identity":   1,
+	}, info.ResourcesByCategory)
+}
+
+func TestParseTemplate_SAM(t *testing.T) {
+	content := `Transform: AWS::Serverless-2016-10-31
+Resources:
+  Api:
+    Type: AWS::Serverless::HttpApi
+  HelloFunction:
+    Type: AWS::Serverless::Function
+    Properties:
+      Runtime: python3.12
+      Events:
+        Get:
+          Type: HttpApi
+          Properties:
+            ApiId: !Ref Api
+`
+
+	parser := NewCloudFormationParser()
+	template := parser.ParseTemplate(content)
+	require.NotNil(t, template)
+	assert.True(t, template.IsSAM())
+	assert.Equal(t, []string{"AWS::Serverless-2016-10-31"}, template.Transforms)
+
+	info := parser.AggregateTemplate(template)
+	assert.Equal(t, "sam", info.Format)
+	assert.Equal(t, map[string]int{"api": 1, "serverless": 1}, info.ResourcesByCategory)
+}
+
+func TestParseTemplate_JSON(t *testing.T) {
+	// CDK synthesized template
+	content := `{
+  "Resources": {
+    "Bucket83908E77": {
+      "Type": "AWS::S3::Bucket",
+      "UpdateReplacePolicy": "Retain"
+    },
+    "CDKMetadata": {
+      "Type": "AWS::CDK::Metadata"
+    }
+  },
+  "Transform": ["AWS::LanguageExtensions"]
+}`
+
+	parser := NewCloudFormationParser()
+	template := parser.ParseTemplate(content)
+	require.NotNil(t, template)
+	assert.Empty(t, template.FormatVersion)
+	assert.Equal(t, []string{"AWS::LanguageExtensions"}, template.Transforms)
+	require.Len(t, template.Resources, 2)
+	assert.Equal(t, "storage", template.Resources[0].Category)
+	assert.Equal(t, "other", template.Resources[1].Category)
+}
+
+func TestParseTemplate_NotCloudFormation(t *testing.T) {
+	parser := NewCloudFormationParser()
+
+	tests := map[string]string{
+		"kubernetes manifest": "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n",
+		"non-AWS resources":   "Resources:\n  Thing:\n    Type: Custom::Thing\n",
+		"package.json":        `{"name": "app", "version": "1.0.0"}`,
+		"yaml list":           "- AWS::S3::Bucket\n",
+		"invalid yaml":        "Resources: [unclosed\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Nil(t, parser.ParseTemplate(content))
+		})
+	}
+}
+
+func TestParseCDKJSON(t *testing.T) {
+	parser := NewCloudFormationParser()
+
+	tests := []struct {
+		app      string
+		language string
+	}{
+		{"npx ts-node --prefer-ts-exts bin/infra.ts", "typescript"},
+		{"node bin/infra.js", "javascript"},
+		{"python3 app.py", "python"},
+		{".venv/bin/python app.py", "python"},
+		{"mvn -e -q compile exec:java", "java"},
+		{"dotnet run -p src/Infra/Infra.csproj", "csharp"},
+		{"go mod download && go run infra.go", "go"},
+		{"./synth.sh", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.app, func(t *testing.T) {
+			app := parser.ParseCDKJSON(`{"app": "` + tt.app + `", "context": {}}`)
+			require.NotNil(t, app)
+			assert.Equal(t, tt.app, app.App)
+			assert.Equal(t, tt.language, app.Language)
+		})
+	}
+
+	assert.Nil(t, parser.ParseCDKJSON(`{"context": {}}`))
+	assert.Nil(t, parser.ParseCDKJSON(`not json`))
+}
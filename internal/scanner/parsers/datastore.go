@@ -0,0 +1,203 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Datastore configuration sources recognized by the datastore parser
+const (
+	DatastoreSourcePrisma      = "prisma"
+	DatastoreSourceTypeORM     = "typeorm"
+	DatastoreSourceDjango      = "django"
+	DatastoreSourceSQLAlchemy  = "sqlalchemy"
+	DatastoreSourceDatabaseURL = "database_url"
+)
+
+// DatastoreParser extracts the configured database backend from ORM configuration
+// Only provider names and URL schemes are recorded, connection strings and credentials are never stored
+type DatastoreParser struct{}
+
+// NewDatastoreParser creates a new datastore parser
+func NewDatastoreParser() *DatastoreParser {
+	return &DatastoreParser{}
+}
+
+// Datastore represents a database backend configured for an ORM or through a database URL
+type Datastore struct {
+	File     string `json:"file,omitempty"`
+	Source   string `json:"source"`           // prisma, typeorm, django, sqlalchemy or database_url
+	Provider string `json:"provider"`         // Provider as configured (e.g. "postgres", "sqlite3", "mysql+pymysql")
+	Database string `json:"database"`         // Database tech
+	Models   int    `json:"models,omitempty"` // Number of Prisma models
+}
+
+// databaseTechs maps provider names, Django engines, TypeORM types and URL schemes to database techs
+var databaseTechs = map[string]string{
+	"postgres":        "postgresql",
+	"postgresql":      "postgresql",
+	"postgis":         "postgresql",
+	"pgsql":           "postgresql",
+	"aurora-postgres": "postgresql",
+	"mysql":           "mysql",
+	"aurora-mysql":    "mysql",
+	"mariadb":         "mariadb",
+	"sqlite":          "sqlite",
+	"sqlite3":         "sqlite",
+	"better-sqlite3":  "sqlite",
+	"spatialite":      "sqlite",
+	"mssql":           "mssql",
+	"sqlserver":       "mssql",
+	"oracle":          "oracle",
+	"cockroachdb":     "cockroachdb",
+	"mongodb":         "mongodb",
+	"spanner":         "gcp.spanner",
+}
+
+var (
+	prismaDatasourceRegex = regexp.MustCompile(`(?s)datasource\s+\w+\s*\{(.*?)\}`)
+	prismaProviderRegex   = regexp.MustCompile(`provider\s*=\s*"([^"]+)"`)
+	prismaModelRegex      = regexp.MustCompile(`(?m)^\s*model\s+\w+\s*\{`)
+	lineCommentRegex      = regexp.MustCompile(`(?m)^\s*//.*$`)
+
+	typeORMConstructorRegex = regexp.MustCompile(`new\s+DataSource\s*\(|TypeOrmModule\.forRoot(?:Async)?\s*\(|createConnection\s*\(`)
+	typeORMTypeRegex        = regexp.MustCompile(`["']?\btype["']?\s*:\s*["']?([\w-]+)`)
+	typeORMEnvRegex         = regexp.MustCompile(`(?m)^\s*TYPEORM_CONNECTION\s*=\s*["']?([\w-]+)`)
+
+	djangoBackendRegex = regexp.MustCompile(`django(?:\.contrib\.gis)?\.db\.backends\.(\w+)`)
+	djangoEngineRegex  = regexp.MustCompile(`["']ENGINE["']\s*:\s*["']([\w.]+)["']`)
+
+	databaseURLRegex    = regexp.MustCompile(`["'=]\s*([a-z][a-z0-9+]*)://`)
+	databaseURLEnvRegex = regexp.MustCompile(`(?m)^\s*(?:export\s+)?(\w*(?:DATABASE_URL|DATABASE_URI|DB_URL))\s*=\s*["']?([a-zA-Z][a-zA-Z0-9+]*):`)
+)
+
+// typeORMScanWindow limits the search for the type option to the text following a DataSource constructor
+const typeORMScanWindow = 2000
+
+// DatabaseTech returns the database tech for a provider name or URL scheme, or an empty string
+// Driver suffixes of URL schemes are ignored (e.g. "postgresql+psycopg2", "mongodb+srv")
+func DatabaseTech(provider string) string {
+	name := strings.ToLower(strings.TrimSpace(provider))
+	name, _, _ = strings.Cut(name, "+")
+	return databaseTechs[name]
+}
+
+// ParsePrismaSchema returns the datasource of a Prisma schema and the number of models it defines
+// Schema files without datasource (multi-file schemas) are returned with an empty provider
+func (p *DatastoreParser) ParsePrismaSchema(content string) *Datastore {
+	content = lineCommentRegex.ReplaceAllString(content, "")
+
+	datastore := &Datastore{Source: DatastoreSourcePrisma, Models: len(prismaModelRegex.FindAllString(content, -1))}
+	if block := prismaDatasourceRegex.FindStringSubmatch(content); block != nil {
+		if provider := prismaProviderRegex.FindStringSubmatch(block[1]); provider != nil {
+			datastore.Provider = provider[1]
+			datastore.Database = DatabaseTech(provider[1])
+		}
+	}
+
+	if datastore.Provider == "" && datastore.Models == 0 {
+		return nil
+	}
+	return datastore
+}
+
+// ParseTypeORMConfig returns the database types of an ormconfig file (JSON, YAML, JS/TS or .env)
+func (p *DatastoreParser) ParseTypeORMConfig(fileName, content string) []*Datastore {
+	if strings.HasSuffix(fileName, ".env") {
+		return typeORMDatastores(typeORMEnvRegex.FindAllStringSubmatch(content, -1))
+	}
+	return typeORMDatastores(typeORMTypeRegex.FindAllStringSubmatch(content, -1))
+}
+
+// ParseTypeORMSource returns the database types of DataSource, TypeOrmModule.forRoot and createConnection options in source code
+func (p *DatastoreParser) ParseTypeORMSource(content string) []*Datastore {
+	var matches [][]string
+	for _, location := range typeORMConstructorRegex.FindAllStringIndex(content, -1) {
+		end := min(location[1]+typeORMScanWindow, len(content))
+		if match := typeORMTypeRegex.FindStringSubmatch(content[location[1]:end]); match != nil {
+			matches = append(matches, match)
+		}
+	}
+	return typeORMDatastores(matches)
+}
+
+// typeORMDatastores converts type option matches into datastores, skipping unknown types such as column types
+func typeORMDatastores(matches [][]string) []*Datastore {
+	var datastores []*Datastore
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		provider := match[1]
+		database := DatabaseTech(provider)
+		if database == "" || seen[provider] {
+			continue
+		}
+		seen[provider] = true
+		datastores = append(datastores, &Datastore{Source: DatastoreSourceTypeORM, Provider: provider, Database: database})
+	}
+	return datastores
+}
+
+// ParseDjangoSettings returns the database engines configured in Django settings (DATABASES or dj-database-url defaults)
+func (p *DatastoreParser) ParseDjangoSettings(content string) []*Datastore {
+	if !strings.Contains(content, "DATABASES") {
+		return nil
+	}
+
+	var providers []string
+	for _, match := range djangoBackendRegex.FindAllStringSubmatch(content, -1) {
+		providers = append(providers, match[1])
+	}
+	for _, match := range djangoEngineRegex.FindAllStringSubmatch(content, -1) {
+		// Third-party backends such as "mssql" or "django_cockroachdb"
+		if !strings.Contains(match[1], ".") {
+			providers = append(providers, strings.TrimPrefix(match[1], "django_"))
+		}
+	}
+	if strings.Contains(content, "dj_database_url") {
+		providers = append(providers, urlSchemes(content)...)
+	}
+	return newDatastores(DatastoreSourceDjango, providers)
+}
+
+// ParseSQLAlchemySource returns the database URL schemes used with create_engine or SQLALCHEMY_DATABASE_URI
+func (p *DatastoreParser) ParseSQLAlchemySource(content string) []*Datastore {
+	if !strings.Contains(content, "create_engine") && !strings.Contains(content, "SQLALCHEMY_DATABASE_URI") &&
+		!strings.Contains(content, "sqlalchemy.url") {
+		return nil
+	}
+	return newDatastores(DatastoreSourceSQLAlchemy, urlSchemes(content))
+}
+
+// ParseDatabaseURLs returns the URL schemes of DATABASE_URL style variables in dotenv files
+func (p *DatastoreParser) ParseDatabaseURLs(content string) []*Datastore {
+	var providers []string
+	for _, match := range databaseURLEnvRegex.FindAllStringSubmatch(content, -1) {
+		providers = append(providers, match[2])
+	}
+	return newDatastores(DatastoreSourceDatabaseURL, providers)
+}
+
+// urlSchemes returns the schemes of database URLs in string literals and assignments
+func urlSchemes(content string) []string {
+	var schemes []string
+	for _, match := range databaseURLRegex.FindAllStringSubmatch(content, -1) {
+		schemes = append(schemes, match[1])
+	}
+	return schemes
+}
+
+// newDatastores creates one datastore per known, distinct provider
+func newDatastores(source string, providers []string) []*Datastore {
+	var datastores []*Datastore
+	seen := make(map[string]bool)
+	for _, provider := range providers {
+		provider = strings.ToLower(provider)
+		database := DatabaseTech(provider)
+		if database == "" || seen[provider] {
+			continue
+		}
+		seen[provider] = true
+		datastores = append(datastores, &Datastore{Source: source, Provider: provider, Database: database})
+	}
+	return datastores
+}
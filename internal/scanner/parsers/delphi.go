@@ -5,7 +5,7 @@ import (
 	"strings"
 )
 
-// DelphiParser handles Delphi project file parsing (.dproj)
+// DelphiParser handles Delphi project file parsing (.dproj, .groupproj, .dpk) and uses clauses of .dpr/.pas sources
 type DelphiParser struct{}
 
 // DelphiProject represents a parsed Delphi project
@@ -13,8 +13,26 @@ type DelphiProject struct {
 	Name      string
 	Framework string   // VCL or FMX
 	Packages  []string // DCC_UsePackage entries
+	Sources   []string // MainSource and DCCReference entries, relative to the project file and "/"-separated
 }
 
+// DelphiPackage represents a parsed Delphi package source (.dpk)
+type DelphiPackage struct {
+	Name     string
+	Requires []string // Packages required by the package
+	Contains []string // Units compiled into the package
+}
+
+var (
+	groupProjectRegex   = regexp.MustCompile(`<Projects\s+Include="([^"]+)"`)
+	dprojSourceRegex    = regexp.MustCompile(`<MainSource>([^<]+)</MainSource>|<DCCReference\s+Include="([^"]+)"`)
+	delphiPackageRegex  = regexp.MustCompile(`(?is)^\s*package\s+([\w.]+)\s*;`)
+	delphiRequiresRegex = regexp.MustCompile(`(?is)\brequires\s+([^;]*);`)
+	delphiContainsRegex = regexp.MustCompile(`(?is)\bcontains\s+([^;]*);`)
+	delphiUsesRegex     = regexp.MustCompile(`(?is)\buses\s+([^;]*);`)
+	delphiUnitNameRegex = regexp.MustCompile(`^[A-Za-z_][\w.]*$`)
+)
+
 // NewDelphiParser creates a new DelphiParser instance
 func NewDelphiParser() *DelphiParser {
 	return &DelphiParser{}
@@ -33,6 +51,9 @@ func (p *DelphiParser) ParseDproj(content, filename string) DelphiProject {
 	// Extract packages from DCC_UsePackage
 	project.Packages = p.extractPackages(content)
 
+	// Extract the program and units compiled into the project
+	project.Sources = p.extractSources(content)
+
 	return project
 }
 
@@ -81,6 +102,112 @@ func (p *DelphiParser) extractPackages(content string) []string {
 	return packages
 }
 
+// extractSources extracts the main source and the DCCReference includes (units, required .dcp packages)
+func (p *DelphiParser) extractSources(content string) []string {
+	var sources []string
+	for _, match := range dprojSourceRegex.FindAllStringSubmatch(content, -1) {
+		source := strings.ReplaceAll(strings.TrimSpace(match[1]+match[2]), "\\", "/")
+		if source != "" && !containsString(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+	return sources
+}
+
+// ParseGroupProj returns the project files of a .groupproj project group, relative to the group file and "/"-separated
+func (p *DelphiParser) ParseGroupProj(content string) []string {
+	var projects []string
+	for _, match := range groupProjectRegex.FindAllStringSubmatch(content, -1) {
+		project := strings.ReplaceAll(strings.TrimSpace(match[1]), "\\", "/")
+		if project != "" && !containsString(projects, project) {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
+// ParseDpk parses the requires and contains clauses of a .dpk package source
+func (p *DelphiParser) ParseDpk(content string) DelphiPackage {
+	code := stripDelphiComments(content)
+	pkg := DelphiPackage{}
+	if match := delphiPackageRegex.FindStringSubmatch(code); match != nil {
+		pkg.Name = match[1]
+	}
+	if match := delphiRequiresRegex.FindStringSubmatch(code); match != nil {
+		pkg.Requires = parseDelphiUnitList(match[1])
+	}
+	if match := delphiContainsRegex.FindStringSubmatch(code); match != nil {
+		pkg.Contains = parseDelphiUnitList(match[1])
+	}
+	return pkg
+}
+
+// ParseUses returns the distinct units of the uses clauses (interface and implementation) of a .dpr or .pas source
+func (p *DelphiParser) ParseUses(content string) []string {
+	var units []string
+	for _, match := range delphiUsesRegex.FindAllStringSubmatch(stripDelphiComments(content), -1) {
+		for _, unit := range parseDelphiUnitList(match[1]) {
+			if !containsString(units, unit) {
+				units = append(units, unit)
+			}
+		}
+	}
+	return units
+}
+
+// parseDelphiUnitList splits a comma-separated unit list, dropping the file of "Unit in 'Unit.pas'" entries
+func parseDelphiUnitList(list string) []string {
+	var units []string
+	for _, item := range strings.Split(list, ",") {
+		fields := strings.Fields(item)
+		if len(fields) == 0 || !delphiUnitNameRegex.MatchString(fields[0]) {
+			continue
+		}
+		units = append(units, fields[0])
+	}
+	return units
+}
+
+// stripDelphiComments removes { } and (* *) comments, compiler directives, // line comments and the content
+// of string literals, so that keywords are only found in code
+func stripDelphiComments(content string) string {
+	var code strings.Builder
+	for i := 0; i < len(content); i++ {
+		switch {
+		case content[i] == '{':
+			end := strings.IndexByte(content[i:], '}')
+			if end < 0 {
+				return code.String()
+			}
+			i += end
+			code.WriteByte(' ')
+		case strings.HasPrefix(content[i:], "(*"):
+			end := strings.Index(content[i+2:], "*)")
+			if end < 0 {
+				return code.String()
+			}
+			i += end + 3
+			code.WriteByte(' ')
+		case strings.HasPrefix(content[i:], "//"):
+			end := strings.IndexByte(content[i:], '\n')
+			if end < 0 {
+				return code.String()
+			}
+			i += end - 1
+		case content[i] == '\'':
+			end := strings.IndexByte(content[i+1:], '\'')
+			if end < 0 {
+				return code.String()
+			}
+			i += end + 1
+			code.WriteString("''")
+		default:
+			code.WriteByte(content[i])
+		}
+	}
+	return code.String()
+}
+
 // IsVCL checks if the project uses VCL framework
 func (p *DelphiParser) IsVCL(framework string) bool {
 	return strings.EqualFold(framework, "VCL")
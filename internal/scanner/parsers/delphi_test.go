@@ -0,0 +1,101 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphiParser_ParseDproj(t *testing.T) {
+	content := `<Project xmlns="http://schemas.microsoft.com/developer/msbuild/2003">
+    <PropertyGroup>
+        <FrameworkType>VCL</FrameworkType>
+    </PropertyGroup>
+    <PropertyGroup Condition="'$(Base)'!=''">
+        <DCC_UsePackage>rtl;vcl;IndyCore;FireDAC;$(DCC_UsePackage)</DCC_UsePackage>
+    </PropertyGroup>
+    <PropertyGroup Condition="'$(Base_Win64)'!=''">
+        <DCC_UsePackage>rtl;dxCoreRS28;$(DCC_UsePackage)</DCC_UsePackage>
+    </PropertyGroup>
+    <PropertyGroup>
+        <MainSource>Orders.dpr</MainSource>
+    </PropertyGroup>
+    <ItemGroup>
+        <DelphiCompile Include="$(MainSource)"/>
+        <DCCReference Include="Main.pas"/>
+        <DCCReference Include="Data\Orders.Data.pas"/>
+    </ItemGroup>
+</Project>`
+	project := NewDelphiParser().ParseDproj(content, "Orders.dproj")
+	assert.Equal(t, "Orders", project.Name)
+	assert.Equal(t, "VCL", project.Framework)
+	assert.Equal(t, []string{"rtl", "vcl", "IndyCore", "FireDAC", "dxCoreRS28"}, project.Packages)
+	assert.Equal(t, []string{"Orders.dpr", "Main.pas", "Data/Orders.Data.pas"}, project.Sources)
+}
+
+func TestDelphiParser_ParseGroupProj(t *testing.T) {
+	content := `<Project xmlns="http://schemas.microsoft.com/developer/msbuild/2003">
+    <ItemGroup>
+        <Projects Include="Server\OrdersServer.dproj">
+            <Dependencies>Packages\OrdersCore.dproj</Dependencies>
+        </Projects>
+        <Projects Include="Client\OrdersClient.dproj"/>
+        <Projects Include="Packages\OrdersCore.dproj"/>
+    </ItemGroup>
+</Project>`
+	assert.Equal(t, []string{"Server/OrdersServer.dproj", "Client/OrdersClient.dproj", "Packages/OrdersCore.dproj"},
+		NewDelphiParser().ParseGroupProj(content))
+}
+
+func TestDelphiParser_ParseDpk(t *testing.T) {
+	content := `package OrdersCore;
+
+{$R *.res}
+{$IFDEF IMPLICITBUILDING This IFDEF should not be used by users}
+{$ALIGN 8}
+{$ENDIF IMPLICITBUILDING}
+{$RUNONLY}
+
+requires
+  rtl,
+  dbrtl, // database runtime
+  FireDAC,
+  IndyCore;
+
+contains
+  Orders.Model in 'Orders.Model.pas',
+  Orders.Repository in 'Orders.Repository.pas' {OrdersModule: TDataModule};
+
+end.
+`
+	pkg := NewDelphiParser().ParseDpk(content)
+	assert.Equal(t, "OrdersCore", pkg.Name)
+	assert.Equal(t, []string{"rtl", "dbrtl", "FireDAC", "IndyCore"}, pkg.Requires)
+	assert.Equal(t, []string{"Orders.Model", "Orders.Repository"}, pkg.Contains)
+}
+
+func TestDelphiParser_ParseUses(t *testing.T) {
+	content := `unit Orders.Repository;
+
+interface
+
+uses
+  System.SysUtils, System.Classes,
+  FireDAC.Comp.Client, FireDAC.Phys.PG, { FireDAC.Phys.MySQL, }
+  (* IdHTTP, *) Orders.Model in 'Orders.Model.pas';
+
+const
+  SQL = 'select * from orders; uses nothing';
+
+implementation
+
+uses
+  IdHTTP, cxGrid, // grids
+  System.SysUtils;
+
+end.
+`
+	assert.Equal(t, []string{
+		"System.SysUtils", "System.Classes", "FireDAC.Comp.Client", "FireDAC.Phys.PG", "Orders.Model", "IdHTTP", "cxGrid",
+	}, NewDelphiParser().ParseUses(content))
+}
@@ -1,9 +1,14 @@
 package parsers
 
 import (
+	"net"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"gopkg.in/yaml.v3"
 )
 
 // Compile Dockerfile parsing regexes once at package level for performance
@@ -11,6 +16,12 @@ var (
 	dockerfileFromRegex   = regexp.MustCompile(`(?i)^FROM\s+([^\s]+)(?:\s+AS\s+([^\s]+))?`)
 	dockerfileExposeRegex = regexp.MustCompile(`(?i)^EXPOSE\s+(.+)`)
 	dockerfilePortRegex   = regexp.MustCompile(`\d+`)
+
+	// exposedPortRegex matches a single exposed port with optional protocol (8080, 53/udp)
+	exposedPortRegex = regexp.MustCompile(`^(\d+)(?:/(tcp|udp|sctp))?$`)
+
+	// composeVariableRegex matches a compose variable with optional default (${WEB_PORT:-8080}, $PORT)
+	composeVariableRegex = regexp.MustCompile(`\$\{[^}:?-]*(?::?-([^}]*))?[^}]*\}|\$\w+`)
 )
 
 // DockerParser handles Docker-specific file parsing (docker-compose.yml/yaml and Dockerfile)
@@ -23,6 +34,8 @@ type DockerfileInfo struct {
 	ExposedPorts []int    `json:"exposed_ports,omitempty"`
 	MultiStage   bool     `json:"multi_stage,omitempty"`
 	Stages       []string `json:"stages,omitempty"`
+
+	Endpoints []types.Endpoint `json:"-"` // Exposed ports with their protocol, internal until published
 }
 
 // NewDockerParser creates a new Docker parser
@@ -60,6 +73,169 @@ func (p *DockerParser) ParseDockerCompose(content string) []DockerService {
 	return parser.services
 }
 
+// ComposeServiceEnvironment represents the environment of a docker-compose service
+type ComposeServiceEnvironment struct {
+	Name        string
+	Build       string // Build context relative to the compose file, empty for image-only services
+	Environment []EnvVariable
+}
+
+// ParseComposeEnvironment returns the build context and environment variables of each docker-compose service,
+// in file order. Files that fail to parse return nil
+func (p *DockerParser) ParseComposeEnvironment(content string) []ComposeServiceEnvironment {
+	var doc struct {
+		Services yaml.Node `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil || doc.Services.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var services []ComposeServiceEnvironment
+	for i := 0; i+1 < len(doc.Services.Content); i += 2 {
+		service := ComposeServiceEnvironment{Name: doc.Services.Content[i].Value}
+		definition := doc.Services.Content[i+1]
+		for j := 0; j+1 < len(definition.Content); j += 2 {
+			value := definition.Content[j+1]
+			switch definition.Content[j].Value {
+			case "environment":
+				service.Environment = envBlockVariables(value)
+			case "build":
+				// build: ./api or build: {context: ./api, dockerfile: Dockerfile}
+				if value.Kind == yaml.ScalarNode {
+					service.Build = path.Clean(value.Value)
+				}
+				for k := 0; k+1 < len(value.Content); k += 2 {
+					if value.Content[k].Value == "context" {
+						service.Build = path.Clean(value.Content[k+1].Value)
+					}
+				}
+			}
+		}
+		services = append(services, service)
+	}
+	return services
+}
+
+// ParseComposeEndpoints returns the ports of the docker-compose services, in file order, with Service set to the
+// service name. Published ports are public unless bound to a loopback address (127.0.0.1:5432:5432), ports only
+// listed in expose are internal. Variables take their default (${WEB_PORT:-8080}), port ranges and ports without
+// default are skipped. Files that fail to parse return nil
+func (p *DockerParser) ParseComposeEndpoints(content string) []types.Endpoint {
+	var doc struct {
+		Services yaml.Node `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil || doc.Services.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var endpoints []types.Endpoint
+	for i := 0; i+1 < len(doc.Services.Content); i += 2 {
+		name := doc.Services.Content[i].Value
+		definition := doc.Services.Content[i+1]
+		for j := 0; j+1 < len(definition.Content); j += 2 {
+			value := definition.Content[j+1]
+			for _, port := range value.Content {
+				var endpoint types.Endpoint
+				var ok bool
+				switch definition.Content[j].Value {
+				case "ports":
+					endpoint, ok = composePortEndpoint(port)
+				case "expose":
+					endpoint, ok = exposedEndpoint(port.Value, "compose")
+				}
+				if ok {
+					endpoint.Service = name
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+	}
+	return endpoints
+}
+
+// composePortEndpoint returns the endpoint of a compose ports entry, in short ([host_ip:][published:]target[/protocol])
+// or long syntax (target, published, host_ip, protocol)
+func composePortEndpoint(node *yaml.Node) (types.Endpoint, bool) {
+	var target, published, hostIP, protocol string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		var spec string
+		spec, protocol, _ = strings.Cut(composeVariableRegex.ReplaceAllString(node.Value, "$1"), "/")
+		// IPv6 host addresses are bracketed: [::1]:6001:6001
+		if address, rest, found := strings.Cut(strings.TrimPrefix(spec, "["), "]:"); found && strings.HasPrefix(spec, "[") {
+			hostIP, spec = address, rest
+		}
+		parts := strings.Split(spec, ":")
+		switch len(parts) {
+		case 1:
+		case 2:
+			published = parts[0]
+		case 3:
+			hostIP, published = parts[0], parts[1]
+		default:
+			return types.Endpoint{}, false
+		}
+		target = parts[len(parts)-1]
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value := composeVariableRegex.ReplaceAllString(node.Content[i+1].Value, "$1")
+			switch node.Content[i].Value {
+			case "target":
+				target = value
+			case "published":
+				published = value
+			case "host_ip":
+				hostIP = value
+			case "protocol":
+				protocol = value
+			}
+		}
+	}
+
+	port, err := strconv.Atoi(target)
+	if err != nil || port <= 0 {
+		return types.Endpoint{}, false
+	}
+	endpoint := types.Endpoint{Port: port, Protocol: strings.ToLower(protocol), Exposure: types.EndpointPublic, Source: "compose"}
+	if endpoint.Protocol == "" {
+		endpoint.Protocol = "tcp"
+	}
+	// Without published port the target port is published on a random host port
+	if publishedPort, err := strconv.Atoi(published); err == nil {
+		endpoint.PublishedPort = publishedPort
+	}
+	if isLoopbackAddress(hostIP) {
+		endpoint.Exposure = types.EndpointInternal
+	}
+	return endpoint, true
+}
+
+// exposedEndpoint returns the internal endpoint of an exposed port (EXPOSE 8080, expose: ["53/udp"])
+func exposedEndpoint(value, source string) (types.Endpoint, bool) {
+	match := exposedPortRegex.FindStringSubmatch(strings.ToLower(value))
+	if match == nil {
+		return types.Endpoint{}, false
+	}
+	port, err := strconv.Atoi(match[1])
+	if err != nil || port <= 0 {
+		return types.Endpoint{}, false
+	}
+	protocol := match[2]
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	return types.Endpoint{Port: port, Protocol: protocol, Exposure: types.EndpointInternal, Source: source}, true
+}
+
+// isLoopbackAddress reports whether a host address only accepts local connections
+func isLoopbackAddress(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // dockerComposeState holds the parsing state
 type dockerComposeState struct {
 	services           []DockerService
@@ -226,6 +402,11 @@ func (p *DockerParser) ParseDockerfile(content string) *DockerfileInfo {
 					info.ExposedPorts = append(info.ExposedPorts, port)
 				}
 			}
+			for _, field := range strings.Fields(portsStr) {
+				if endpoint, ok := exposedEndpoint(field, "dockerfile"); ok {
+					info.Endpoints = append(info.Endpoints, endpoint)
+				}
+			}
 		}
 	}
 
@@ -236,3 +417,18 @@ func (p *DockerParser) ParseDockerfile(content string) *DockerfileInfo {
 
 	return info
 }
+
+// SplitImageReference splits an image reference into name and tag (or digest)
+// Registry ports are kept in the name: "registry:5000/app:1.2" -> "registry:5000/app", "1.2"
+// Images without tag default to "latest"
+func SplitImageReference(image string) (string, string) {
+	if name, digest, found := strings.Cut(image, "@"); found {
+		return name, digest
+	}
+
+	lastSlash := strings.LastIndex(image, "/")
+	if colon := strings.LastIndex(image, ":"); colon > lastSlash {
+		return image[:colon], image[colon+1:]
+	}
+	return image, "latest"
+}
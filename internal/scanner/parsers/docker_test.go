@@ -3,6 +3,7 @@ package parsers
 import (
 	"testing"
 
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -454,3 +455,111 @@ func TestDockerParser_ErrorHandling(t *testing.T) {
 	assert.Equal(t, "web", services[0].Name)
 	assert.Equal(t, "db", services[1].Name)
 }
+
+func TestDockerParser_ParseComposeEnvironment(t *testing.T) {
+	content := `services:
+  api:
+    build: ./api/
+    environment:
+      DATABASE_URL: postgres://app@db:5432/app
+      REDIS_HOST: redis
+  worker:
+    build:
+      context: ../worker
+      dockerfile: Dockerfile.worker
+    environment:
+      - KAFKA_CONSUMER_GROUP_ID=orders
+  db:
+    image: postgres:16
+`
+	services := NewDockerParser().ParseComposeEnvironment(content)
+	require.Len(t, services, 3)
+
+	assert.Equal(t, ComposeServiceEnvironment{
+		Name:  "api",
+		Build: "api",
+		Environment: []EnvVariable{
+			{Name: "DATABASE_URL", Value: "postgres://app@db:5432/app"},
+			{Name: "REDIS_HOST", Value: "redis"},
+		},
+	}, services[0])
+	assert.Equal(t, "../worker", services[1].Build)
+	assert.Equal(t, []EnvVariable{{Name: "KAFKA_CONSUMER_GROUP_ID", Value: "orders"}}, services[1].Environment)
+	assert.Equal(t, ComposeServiceEnvironment{Name: "db"}, services[2])
+
+	assert.Nil(t, NewDockerParser().ParseComposeEnvironment("services: [\n"))
+}
+
+func TestDockerParser_ParseComposeEndpoints(t *testing.T) {
+	content := `services:
+  web:
+    image: nginx
+    ports:
+      - "80:8080"
+      - 3000
+      - "127.0.0.1:5000:5000"
+      - "[::1]:6001:6001"
+      - "6060:6060/udp"
+      - "${WEB_PORT:-8443}:443"
+      - "9000-9001:9000-9001"
+      - target: 8081
+        published: "8082"
+        host_ip: 0.0.0.0
+        protocol: tcp
+  api:
+    build: ./api
+    expose:
+      - "9090"
+      - "7000/udp"
+      - "8000-8010"
+`
+	endpoint := func(service string, port, published int, protocol, exposure string) types.Endpoint {
+		return types.Endpoint{Port: port, Protocol: protocol, Exposure: exposure, Source: "compose", Service: service, PublishedPort: published}
+	}
+	assert.Equal(t, []types.Endpoint{
+		endpoint("web", 8080, 80, "tcp", types.EndpointPublic),
+		endpoint("web", 3000, 0, "tcp", types.EndpointPublic),
+		endpoint("web", 5000, 5000, "tcp", types.EndpointInternal),
+		endpoint("web", 6001, 6001, "tcp", types.EndpointInternal),
+		endpoint("web", 6060, 6060, "udp", types.EndpointPublic),
+		endpoint("web", 443, 8443, "tcp", types.EndpointPublic),
+		endpoint("web", 8081, 8082, "tcp", types.EndpointPublic),
+		endpoint("api", 9090, 0, "tcp", types.EndpointInternal),
+		endpoint("api", 7000, 0, "udp", types.EndpointInternal),
+	}, NewDockerParser().ParseComposeEndpoints(content))
+
+	assert.Nil(t, NewDockerParser().ParseComposeEndpoints("services: [\n"))
+}
+
+func TestDockerParser_ParseDockerfile_Endpoints(t *testing.T) {
+	info := NewDockerParser().ParseDockerfile("FROM alpine\nEXPOSE 8080/tcp 53/udp $PORT\nexpose 9000-9010\n")
+	require.NotNil(t, info)
+
+	assert.Equal(t, []types.Endpoint{
+		{Port: 8080, Protocol: "tcp", Exposure: types.EndpointInternal, Source: "dockerfile"},
+		{Port: 53, Protocol: "udp", Exposure: types.EndpointInternal, Source: "dockerfile"},
+	}, info.Endpoints)
+}
+
+func TestSplitImageReference(t *testing.T) {
+	tests := []struct {
+		image   string
+		name    string
+		version string
+	}{
+		{"postgres:16", "postgres", "16"},
+		{"node", "node", "latest"},
+		{"bitnami/redis:7.2", "bitnami/redis", "7.2"},
+		{"registry.example.com:5000/team/app", "registry.example.com:5000/team/app", "latest"},
+		{"registry.example.com:5000/team/app:1.4", "registry.example.com:5000/team/app", "1.4"},
+		{"alpine@sha256:abc123", "alpine", "sha256:abc123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			name, version := SplitImageReference(tt.image)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
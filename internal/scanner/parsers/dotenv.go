@@ -2,53 +2,146 @@ package parsers
 
 import (
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"gopkg.in/yaml.v3"
 )
 
-// DotenvDetector handles .env.example file detection
+// DotenvDetector handles environment variable detection in dotenv files (.env, .env.*, *.env) and in the
+// environment blocks of docker-compose services, Kubernetes containers and GitHub Actions workflows.
+// Variable names are matched against the dotenv patterns of rules and values are only inspected for
+// connection schemes, values are never stored
 type DotenvDetector struct {
 	provider types.Provider
 	rules    []types.Rule
+	patterns map[string]*regexp.Regexp
 }
 
+// EnvVariable represents an environment variable declared in a dotenv file or an environment block
+type EnvVariable struct {
+	Name  string
+	Value string
+}
+
+var composeFileRegex = regexp.MustCompile(`^(?:docker-)?compose(?:[.-][\w.-]+)?\.ya?ml$`)
+
+// maxEnvFileSize skips generated or vendored files larger than 1MB
+const maxEnvFileSize = 1_000_000
+
 // NewDotenvDetector creates a new dotenv detector
 func NewDotenvDetector(provider types.Provider, rules []types.Rule) *DotenvDetector {
+	patterns := make(map[string]*regexp.Regexp)
+	for _, rule := range rules {
+		for _, pattern := range rule.DotEnv {
+			if regex, err := compileDotenvPattern(pattern); err == nil {
+				patterns[pattern] = regex
+			}
+		}
+	}
+
 	return &DotenvDetector{
 		provider: provider,
 		rules:    rules,
+		patterns: patterns,
 	}
 }
 
-// DetectInDotEnv detects technologies from .env.example files
-// Returns a virtual payload with the specific file path (like TypeScript: folderPath: file.fp)
-func (d *DotenvDetector) DetectInDotEnv(files []types.File, currentPath string, basePath string) *types.Payload {
-	file := d.findDotenvFile(files)
-	if file == nil {
-		return nil
+// compileDotenvPattern compiles a dotenv pattern of a rule:
+//   - "/regex/" is a regular expression matched against the variable name
+//   - "^PREFIX" matches variable names starting with PREFIX
+//   - "PREFIX" matches at the start of the name or of an underscore-separated segment (NEXT_PUBLIC_PREFIX...)
+//
+// Plain and anchored patterns are case-insensitive
+func compileDotenvPattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		return regexp.Compile(pattern[1 : len(pattern)-1])
+	}
+	if prefix, anchored := strings.CutPrefix(pattern, "^"); anchored {
+		return regexp.Compile(`(?i)^` + regexp.QuoteMeta(prefix))
 	}
+	return regexp.Compile(`(?i)(?:^|_)` + regexp.QuoteMeta(pattern))
+}
 
-	content, err := d.provider.ReadFile(filepath.Join(currentPath, file.Name))
-	if err != nil {
-		return nil
+// DetectInDotEnv detects technologies from dotenv files and environment blocks
+// Returns one virtual payload per file with the specific file path (like TypeScript: folderPath: file.fp)
+func (d *DotenvDetector) DetectInDotEnv(files []types.File, currentPath string, basePath string) []*types.Payload {
+	var results []*types.Payload
+	for _, file := range d.findDotenvFiles(files) {
+		content, err := d.provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil || len(content) > maxEnvFileSize {
+			continue
+		}
+		if payload := d.detectVariables(d.parseEnvFile(string(content)), currentPath, basePath, file.Name, true); payload != nil {
+			results = append(results, payload)
+		}
 	}
 
-	relativeFilePath := d.getRelativeFilePath(basePath, currentPath, file.Name)
+	for _, file := range files {
+		extension := filepath.Ext(file.Name)
+		if file.Type == "dir" || (extension != ".yml" && extension != ".yaml") {
+			continue
+		}
+		content, err := d.provider.ReadFile(filepath.Join(currentPath, file.Name))
+		if err != nil || len(content) > maxEnvFileSize {
+			continue
+		}
+		compose, workflow := composeFileRegex.MatchString(file.Name), d.isWorkflowDirectory(currentPath)
+		if !compose && !workflow && !IsKubernetesManifest(string(content)) {
+			continue
+		}
+		// Service references of compose files are recorded per service by the docker detector
+		if payload := d.detectVariables(ParseEnvBlocks(string(content)), currentPath, basePath, file.Name, !compose && !workflow); payload != nil {
+			results = append(results, payload)
+		}
+	}
+
+	return results
+}
+
+// detectVariables creates a virtual payload with the techs matched by the variables of a file and, if requested,
+// the services they reference, or nil
+func (d *DotenvDetector) detectVariables(variables []EnvVariable, currentPath, basePath, fileName string, collectRefs bool) *types.Payload {
+	relativeFilePath := d.getRelativeFilePath(basePath, currentPath, fileName)
 	payload := types.NewPayloadWithPath("virtual", relativeFilePath)
 
-	d.scanEnvVariables(string(content), payload)
+	for _, variable := range variables {
+		d.matchVarAgainstRules(variable.Name, payload)
+		if tech, _ := ConnectionTech(variable.Value); tech != "" {
+			payload.AddTech(tech, tech+" matched env value: "+variable.Name)
+		}
+		if collectRefs {
+			for _, ref := range ParseServiceRefs(variable.Name, variable.Value) {
+				payload.AddServiceRef(ref)
+			}
+		}
+	}
+
+	if len(payload.Techs) == 0 && len(payload.ServiceRefs) == 0 {
+		return nil
+	}
 	return payload
 }
 
-func (d *DotenvDetector) findDotenvFile(files []types.File) *types.File {
-	const dotenvFile = ".env.example"
+// findDotenvFiles returns the dotenv files of a directory: .env, .env.* variants, *.env files and .envrc
+func (d *DotenvDetector) findDotenvFiles(files []types.File) []types.File {
+	var dotenvFiles []types.File
 	for _, file := range files {
-		if file.Name == dotenvFile {
-			return &file
+		if file.Type == "dir" {
+			continue
+		}
+		if file.Name == ".env" || file.Name == ".envrc" || strings.HasPrefix(file.Name, ".env.") ||
+			(strings.HasSuffix(file.Name, ".env") && len(file.Name) > len(".env")) {
+			dotenvFiles = append(dotenvFiles, file)
 		}
 	}
-	return nil
+	return dotenvFiles
+}
+
+// isWorkflowDirectory reports whether a directory holds GitHub Actions workflows
+func (d *DotenvDetector) isWorkflowDirectory(currentPath string) bool {
+	return filepath.Base(currentPath) == "workflows" && filepath.Base(filepath.Dir(currentPath)) == ".github"
 }
 
 func (d *DotenvDetector) getRelativeFilePath(basePath, currentPath, fileName string) string {
@@ -56,52 +149,136 @@ func (d *DotenvDetector) getRelativeFilePath(basePath, currentPath, fileName str
 	if relativeFilePath == "." {
 		return "/"
 	}
-	return "/" + relativeFilePath
+	return "/" + filepath.ToSlash(relativeFilePath)
 }
 
-func (d *DotenvDetector) scanEnvVariables(content string, payload *types.Payload) {
-	lines := strings.Split(content, "\n")
-	for _, line := range lines {
-		varName := d.extractVarName(line)
-		if varName == "" {
-			continue
+// parseEnvFile returns the variables of a dotenv file
+func (d *DotenvDetector) parseEnvFile(content string) []EnvVariable {
+	var variables []EnvVariable
+	for _, line := range strings.Split(content, "\n") {
+		if name, value := d.extractVariable(line); name != "" {
+			variables = append(variables, EnvVariable{Name: name, Value: value})
 		}
-		d.matchVarAgainstRules(varName, payload)
 	}
+	return variables
 }
 
-func (d *DotenvDetector) extractVarName(line string) string {
+// extractVariable returns the name and unquoted value of a NAME=value line (optionally prefixed with export)
+func (d *DotenvDetector) extractVariable(line string) (string, string) {
 	line = strings.TrimSpace(line)
 	if line == "" || strings.HasPrefix(line, "#") {
-		return ""
+		return "", ""
 	}
 
 	parts := strings.SplitN(line, "=", 2)
 	if len(parts) < 2 {
-		return ""
+		return "", ""
 	}
-	return strings.TrimSpace(parts[0])
+	name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(parts[0]), "export "))
+	value := strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+	return name, value
 }
 
 func (d *DotenvDetector) matchVarAgainstRules(varName string, payload *types.Payload) {
-	lowerVarName := strings.ToLower(varName)
 	for _, rule := range d.rules {
-		if d.matchesRule(lowerVarName, varName, rule, payload) {
+		if d.matchesRule(varName, rule, payload) {
 			break
 		}
 	}
 }
 
-func (d *DotenvDetector) matchesRule(lowerVarName, varName string, rule types.Rule, payload *types.Payload) bool {
+func (d *DotenvDetector) matchesRule(varName string, rule types.Rule, payload *types.Payload) bool {
 	if len(rule.DotEnv) == 0 {
 		return false
 	}
 
 	for _, pattern := range rule.DotEnv {
-		if strings.Contains(lowerVarName, strings.ToLower(pattern)) {
+		regex, exists := d.patterns[pattern]
+		if !exists {
+			var err error
+			if regex, err = compileDotenvPattern(pattern); err != nil {
+				continue
+			}
+		}
+		if regex.MatchString(varName) {
 			payload.AddTech(rule.Tech, rule.Tech+" matched env: "+varName)
 			return true
 		}
 	}
 	return false
 }
+
+// ParseEnvBlocks returns the variables of env and environment blocks in (multi-document) YAML:
+// docker-compose environment maps and NAME=value lists, Kubernetes env lists of name/value entries
+// and GitHub Actions env maps at workflow, job and step level. Documents that fail to parse
+// (e.g. Helm templates) end the scan
+func ParseEnvBlocks(content string) []EnvVariable {
+	var variables []EnvVariable
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			return variables
+		}
+		collectEnvBlocks(&node, &variables)
+	}
+}
+
+// collectEnvBlocks walks a YAML node and appends the variables of env and environment keys
+func collectEnvBlocks(node *yaml.Node, variables *[]EnvVariable) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			collectEnvBlocks(child, variables)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if key == "env" || key == "environment" {
+				*variables = append(*variables, envBlockVariables(value)...)
+				continue
+			}
+			collectEnvBlocks(value, variables)
+		}
+	}
+}
+
+// envBlockVariables returns the variables of a single env block
+func envBlockVariables(node *yaml.Node) []EnvVariable {
+	var variables []EnvVariable
+	switch node.Kind {
+	case yaml.MappingNode:
+		// NAME: value (compose, GitHub Actions)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != "" && node.Content[i+1].Kind == yaml.ScalarNode {
+				variables = append(variables, EnvVariable{Name: node.Content[i].Value, Value: node.Content[i+1].Value})
+			}
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			switch item.Kind {
+			case yaml.ScalarNode:
+				// - NAME=value (compose)
+				name, value, _ := strings.Cut(item.Value, "=")
+				if name = strings.TrimSpace(name); name != "" {
+					variables = append(variables, EnvVariable{Name: name, Value: value})
+				}
+			case yaml.MappingNode:
+				// - name: NAME, value: value (Kubernetes)
+				var variable EnvVariable
+				for i := 0; i+1 < len(item.Content); i += 2 {
+					switch item.Content[i].Value {
+					case "name":
+						variable.Name = item.Content[i+1].Value
+					case "value":
+						variable.Value = item.Content[i+1].Value
+					}
+				}
+				if variable.Name != "" {
+					variables = append(variables, variable)
+				}
+			}
+		}
+	}
+	return variables
+}
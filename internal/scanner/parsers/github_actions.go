@@ -0,0 +1,324 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GitHubActionsParser handles GitHub Actions workflow and action metadata parsing
+type GitHubActionsParser struct{}
+
+// NewGitHubActionsParser creates a new GitHub Actions parser
+func NewGitHubActionsParser() *GitHubActionsParser {
+	return &GitHubActionsParser{}
+}
+
+// GitHubActionReference represents a "uses:" reference of a step or of a job (reusable workflow)
+type GitHubActionReference struct {
+	Uses   string `json:"uses"`
+	Name   string `json:"name"`          // owner/repo[/path], local path or docker image
+	Ref    string `json:"ref,omitempty"` // Tag, branch, commit SHA or image tag
+	Kind   string `json:"kind"`          // action, local, docker
+	Pinned bool   `json:"pinned"`        // Ref is a full commit SHA (or an image digest)
+}
+
+// GitHubActionsPermissions represents a permissions block
+type GitHubActionsPermissions struct {
+	All    string            `json:"all,omitempty"`    // read-all, write-all or none for "{}"
+	Scopes map[string]string `json:"scopes,omitempty"` // e.g. contents: read, id-token: write
+}
+
+// GitHubActionsJob represents a job of a workflow
+type GitHubActionsJob struct {
+	Name        string                    `json:"name"`
+	RunsOn      []string                  `json:"runs_on,omitempty"` // Matrix expressions are expanded
+	Uses        *GitHubActionReference    `json:"uses,omitempty"`    // Reusable workflow
+	Container   string                    `json:"container,omitempty"`
+	Services    []string                  `json:"services,omitempty"`
+	Permissions *GitHubActionsPermissions `json:"permissions,omitempty"`
+}
+
+// GitHubActionsWorkflow represents parsed information from a workflow or an action metadata file
+type GitHubActionsWorkflow struct {
+	File              string                    `json:"file,omitempty"`
+	Kind              string                    `json:"kind"` // workflow or action
+	Name              string                    `json:"name,omitempty"`
+	Triggers          []string                  `json:"triggers,omitempty"`
+	Using             string                    `json:"using,omitempty"` // Action runtime: composite, docker, node20
+	Permissions       *GitHubActionsPermissions `json:"permissions,omitempty"`
+	Runners           []string                  `json:"runners,omitempty"`
+	Jobs              []GitHubActionsJob        `json:"jobs,omitempty"`
+	Actions           []GitHubActionReference   `json:"actions,omitempty"`
+	ReusableWorkflows []GitHubActionReference   `json:"reusable_workflows,omitempty"`
+	Scripts           []string                  `json:"-"` // run: steps executed by a POSIX shell
+}
+
+// Images returns all distinct container and service images of the jobs
+func (w *GitHubActionsWorkflow) Images() []string {
+	var images []string
+	for _, job := range w.Jobs {
+		for _, image := range append([]string{job.Container}, job.Services...) {
+			if image != "" && !containsString(images, image) {
+				images = append(images, image)
+			}
+		}
+	}
+	return images
+}
+
+// UnpinnedActions returns the remote actions and reusable workflows not pinned to a full commit SHA
+func (w *GitHubActionsWorkflow) UnpinnedActions() []GitHubActionReference {
+	var unpinned []GitHubActionReference
+	for _, reference := range append(append([]GitHubActionReference{}, w.Actions...), w.ReusableWorkflows...) {
+		if reference.Kind != "local" && !reference.Pinned {
+			unpinned = append(unpinned, reference)
+		}
+	}
+	return unpinned
+}
+
+var (
+	githubCommitSHARegex      = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	githubMatrixVariableRegex = regexp.MustCompile(`^\$\{\{\s*matrix\.([\w-]+)\s*\}\}$`)
+)
+
+// ParseWorkflow parses a workflow file (.github/workflows/*.yml)
+func (p *GitHubActionsParser) ParseWorkflow(content string) *GitHubActionsWorkflow {
+	root := parseYAMLMapping(content)
+	if root == nil {
+		return nil
+	}
+	jobs := yamlMappingValue(root, "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	workflow := &GitHubActionsWorkflow{
+		Kind:        "workflow",
+		Name:        yamlScalar(root, "name"),
+		Triggers:    githubActionsTriggers(yamlMappingValue(root, "on")),
+		Permissions: parseGitHubActionsPermissions(yamlMappingValue(root, "permissions")),
+	}
+
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		job := p.parseJob(jobs.Content[i].Value, jobs.Content[i+1], workflow)
+		for _, runner := range job.RunsOn {
+			if !containsString(workflow.Runners, runner) {
+				workflow.Runners = append(workflow.Runners, runner)
+			}
+		}
+		workflow.Jobs = append(workflow.Jobs, job)
+	}
+
+	return workflow
+}
+
+// ParseAction parses an action metadata file (action.yml), e.g. a composite action below .github/actions
+func (p *GitHubActionsParser) ParseAction(content string) *GitHubActionsWorkflow {
+	root := parseYAMLMapping(content)
+	if root == nil {
+		return nil
+	}
+	runs := yamlMappingValue(root, "runs")
+	if runs == nil || runs.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	action := &GitHubActionsWorkflow{
+		Kind:  "action",
+		Name:  yamlScalar(root, "name"),
+		Using: yamlScalar(runs, "using"),
+	}
+
+	// Docker actions reference a Dockerfile or a docker://image
+	if image := yamlScalar(runs, "image"); strings.HasPrefix(image, "docker://") {
+		action.Actions = append(action.Actions, parseGitHubActionReference(image))
+	}
+	p.parseSteps(yamlMappingValue(runs, "steps"), action)
+
+	return action
+}
+
+func (p *GitHubActionsParser) parseJob(name string, node *yaml.Node, workflow *GitHubActionsWorkflow) GitHubActionsJob {
+	job := GitHubActionsJob{
+		Name:        name,
+		RunsOn:      githubActionsRunners(yamlMappingValue(node, "runs-on"), yamlMappingValue(yamlMappingValue(node, "strategy"), "matrix")),
+		Permissions: parseGitHubActionsPermissions(yamlMappingValue(node, "permissions")),
+	}
+
+	// Jobs calling a reusable workflow have uses: instead of steps
+	if uses := yamlScalar(node, "uses"); uses != "" {
+		reference := parseGitHubActionReference(uses)
+		job.Uses = &reference
+		workflow.ReusableWorkflows = appendUniqueReference(workflow.ReusableWorkflows, reference)
+	}
+
+	if container := yamlMappingValue(node, "container"); container != nil {
+		job.Container = githubActionsImage(container)
+	}
+	if services := yamlMappingValue(node, "services"); services != nil && services.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(services.Content); i += 2 {
+			if image := githubActionsImage(services.Content[i+1]); image != "" {
+				job.Services = append(job.Services, image)
+			}
+		}
+	}
+
+	p.parseSteps(yamlMappingValue(node, "steps"), workflow)
+	return job
+}
+
+func (p *GitHubActionsParser) parseSteps(steps *yaml.Node, workflow *GitHubActionsWorkflow) {
+	if steps == nil || steps.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, step := range steps.Content {
+		if uses := yamlScalar(step, "uses"); uses != "" {
+			workflow.Actions = appendUniqueReference(workflow.Actions, parseGitHubActionReference(uses))
+		}
+		// Scripts for pwsh, python or cmd are not shell scripts
+		if run := yamlScalar(step, "run"); run != "" && isPOSIXShell(yamlScalar(step, "shell")) {
+			workflow.Scripts = append(workflow.Scripts, run)
+		}
+	}
+}
+
+// parseGitHubActionReference splits owner/repo@ref, ./local/path and docker://image:tag
+func parseGitHubActionReference(uses string) GitHubActionReference {
+	reference := GitHubActionReference{Uses: uses}
+
+	switch {
+	case strings.HasPrefix(uses, "./"):
+		reference.Kind = "local"
+		reference.Name = uses
+	case strings.HasPrefix(uses, "docker://"):
+		reference.Kind = "docker"
+		reference.Name, reference.Ref = SplitImageReference(strings.TrimPrefix(uses, "docker://"))
+		reference.Pinned = strings.HasPrefix(reference.Ref, "sha256:")
+	default:
+		reference.Kind = "action"
+		reference.Name, reference.Ref, _ = strings.Cut(uses, "@")
+		reference.Pinned = githubCommitSHARegex.MatchString(reference.Ref)
+	}
+
+	return reference
+}
+
+// githubActionsTriggers returns the events of "on: push", "on: [push, pull_request]" or "on: {push: ...}"
+func githubActionsTriggers(node *yaml.Node) []string {
+	if node == nil {
+		return nil
+	}
+	if node.Kind == yaml.MappingNode {
+		var triggers []string
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			triggers = append(triggers, node.Content[i].Value)
+		}
+		return triggers
+	}
+	return scalarValues(node)
+}
+
+// githubActionsRunners returns the labels of runs-on, expanding ${{ matrix.<key> }} with the matrix values
+func githubActionsRunners(runsOn, matrix *yaml.Node) []string {
+	if runsOn == nil {
+		return nil
+	}
+
+	var labels []string
+	switch runsOn.Kind {
+	case yaml.ScalarNode, yaml.SequenceNode:
+		labels = scalarValues(runsOn)
+	case yaml.MappingNode:
+		// runs-on: {group: ..., labels: ...}
+		if group := yamlScalar(runsOn, "group"); group != "" {
+			labels = append(labels, group)
+		}
+		if groupLabels := yamlMappingValue(runsOn, "labels"); groupLabels != nil {
+			labels = append(labels, scalarValues(groupLabels)...)
+		}
+	}
+
+	var runners []string
+	for _, label := range labels {
+		values := []string{label}
+		if match := githubMatrixVariableRegex.FindStringSubmatch(label); match != nil {
+			if expanded := githubActionsMatrixValues(matrix, match[1]); len(expanded) > 0 {
+				values = expanded
+			}
+		}
+		for _, value := range values {
+			if !containsString(runners, value) {
+				runners = append(runners, value)
+			}
+		}
+	}
+	return runners
+}
+
+// githubActionsMatrixValues returns the values of a matrix key, including values added by include entries
+func githubActionsMatrixValues(matrix *yaml.Node, key string) []string {
+	if matrix == nil || matrix.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var values []string
+	if node := yamlMappingValue(matrix, key); node != nil && node.Kind == yaml.SequenceNode {
+		values = scalarValues(node)
+	}
+	if include := yamlMappingValue(matrix, "include"); include != nil && include.Kind == yaml.SequenceNode {
+		for _, entry := range include.Content {
+			if value := yamlScalar(entry, key); value != "" && !containsString(values, value) {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// parseGitHubActionsPermissions parses "permissions: read-all", "permissions: {}" and per scope permissions
+func parseGitHubActionsPermissions(node *yaml.Node) *GitHubActionsPermissions {
+	if node == nil {
+		return nil
+	}
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return &GitHubActionsPermissions{All: node.Value}
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			return &GitHubActionsPermissions{All: "none"}
+		}
+		permissions := &GitHubActionsPermissions{Scopes: make(map[string]string)}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			permissions.Scopes[node.Content[i].Value] = node.Content[i+1].Value
+		}
+		return permissions
+	}
+	return nil
+}
+
+// isPOSIXShell reports whether a step shell (empty for the default shell) is bash or sh
+func isPOSIXShell(shell string) bool {
+	fields := strings.Fields(shell)
+	return len(fields) == 0 || fields[0] == "bash" || fields[0] == "sh"
+}
+
+// githubActionsImage returns the image of "container: image" or "container: {image: ...}"
+func githubActionsImage(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return yamlScalar(node, "image")
+}
+
+func appendUniqueReference(references []GitHubActionReference, reference GitHubActionReference) []GitHubActionReference {
+	for _, existing := range references {
+		if existing == reference {
+			return references
+		}
+	}
+	return append(references, reference)
+}
@@ -0,0 +1,152 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkflow(t *testing.T) {
+	content := `name: CI
+on:
+  push:
+    branches: [main]
+  pull_request:
+  workflow_dispatch:
+
+permissions:
+  contents: read
+  id-token: write
+
+jobs:
+  test:
+    strategy:
+      matrix:
+        os: [ubuntu-24.04, windows-2022]
+        include:
+          - os: macos-14
+    runs-on: ${{ matrix.os }}
+    services:
+      postgres:
+        image: postgres:16
+    steps:
+      - uses: actions/checkout@11bd71901bbe5b1630ceea73d27597364c9af683
+      - uses: actions/setup-node@v4
+      - uses: ./.github/actions/setup
+      - uses: docker://alpine:3.20
+      - run: npm test
+  build:
+    runs-on: [self-hosted, linux, x64]
+    container:
+      image: node:20
+    permissions: read-all
+    steps:
+      - uses: actions/checkout@v4
+      - uses: github/codeql-action/init@v3
+  deploy:
+    needs: [test, build]
+    permissions: {}
+    uses: octo-org/shared/.github/workflows/deploy.yml@v1.2.0
+  release:
+    runs-on:
+      group: release-runners
+      labels: [linux]
+    uses: ./.github/workflows/release.yml
+`
+
+	workflow := NewGitHubActionsParser().ParseWorkflow(content)
+	require.NotNil(t, workflow)
+
+	assert.Equal(t, "workflow", workflow.Kind)
+	assert.Equal(t, "CI", workflow.Name)
+	assert.Equal(t, []string{"push", "pull_request", "workflow_dispatch"}, workflow.Triggers)
+	assert.Equal(t, &GitHubActionsPermissions{Scopes: map[string]string{"contents": "read", "id-token": "write"}}, workflow.Permissions)
+	assert.Equal(t, []string{"ubuntu-24.04", "windows-2022", "macos-14", "self-hosted", "linux", "x64", "release-runners"}, workflow.Runners)
+
+	assert.Equal(t, []GitHubActionReference{
+		{Uses: "actions/checkout@11bd71901bbe5b1630ceea73d27597364c9af683", Name: "actions/checkout", Ref: "11bd71901bbe5b1630ceea73d27597364c9af683", Kind: "action", Pinned: true},
+		{Uses: "actions/setup-node@v4", Name: "actions/setup-node", Ref: "v4", Kind: "action"},
+		{Uses: "./.github/actions/setup", Name: "./.github/actions/setup", Kind: "local"},
+		{Uses: "docker://alpine:3.20", Name: "alpine", Ref: "3.20", Kind: "docker"},
+		{Uses: "actions/checkout@v4", Name: "actions/checkout", Ref: "v4", Kind: "action"},
+		{Uses: "github/codeql-action/init@v3", Name: "github/codeql-action/init", Ref: "v3", Kind: "action"},
+	}, workflow.Actions)
+
+	assert.Equal(t, []GitHubActionReference{
+		{Uses: "octo-org/shared/.github/workflows/deploy.yml@v1.2.0", Name: "octo-org/shared/.github/workflows/deploy.yml", Ref: "v1.2.0", Kind: "action"},
+		{Uses: "./.github/workflows/release.yml", Name: "./.github/workflows/release.yml", Kind: "local"},
+	}, workflow.ReusableWorkflows)
+
+	require.Len(t, workflow.Jobs, 4)
+	assert.Equal(t, []string{"postgres:16"}, workflow.Jobs[0].Services)
+	assert.Equal(t, "node:20", workflow.Jobs[1].Container)
+	assert.Equal(t, &GitHubActionsPermissions{All: "read-all"}, workflow.Jobs[1].Permissions)
+	assert.Equal(t, &GitHubActionsPermissions{All: "none"}, workflow.Jobs[2].Permissions)
+	assert.Equal(t, "octo-org/shared/.github/workflows/deploy.yml", workflow.Jobs[2].Uses.Name)
+
+	assert.Equal(t, []string{"postgres:16", "node:20"}, workflow.Images())
+
+	var unpinned []string
+	for _, reference := range workflow.UnpinnedActions() {
+		unpinned = append(unpinned, reference.Uses)
+	}
+	assert.Equal(t, []string{
+		"actions/setup-node@v4",
+		"docker://alpine:3.20",
+		"actions/checkout@v4",
+		"github/codeql-action/init@v3",
+		"octo-org/shared/.github/workflows/deploy.yml@v1.2.0",
+	}, unpinned)
+}
+
+func TestParseWorkflow_TriggerForms(t *testing.T) {
+	parser := NewGitHubActionsParser()
+
+	workflow := parser.ParseWorkflow("on: push\njobs:\n  a:\n    runs-on: ubuntu-latest\n")
+	require.NotNil(t, workflow)
+	assert.Equal(t, []string{"push"}, workflow.Triggers)
+
+	workflow = parser.ParseWorkflow("on: [push, release]\njobs:\n  a:\n    runs-on: ${{ matrix.os }}\n")
+	require.NotNil(t, workflow)
+	assert.Equal(t, []string{"push", "release"}, workflow.Triggers)
+	// Unresolvable matrix expressions are kept as is
+	assert.Equal(t, []string{"${{ matrix.os }}"}, workflow.Runners)
+
+	assert.Nil(t, parser.ParseWorkflow("name: no jobs\n"))
+	assert.Nil(t, parser.ParseWorkflow("jobs: [unclosed\n"))
+}
+
+func TestParseAction(t *testing.T) {
+	parser := NewGitHubActionsParser()
+
+	action := parser.ParseAction(`name: Setup
+description: Install toolchain
+runs:
+  using: composite
+  steps:
+    - uses: actions/setup-go@0aaccfd150d50ccaeb58ebd88d36e91967a5f35b
+    - uses: hashicorp/setup-terraform@v3
+    - run: make deps
+      shell: bash
+    - run: Get-ChildItem
+      shell: pwsh
+`)
+	require.NotNil(t, action)
+	assert.Equal(t, "action", action.Kind)
+	assert.Equal(t, "composite", action.Using)
+	assert.Equal(t, []GitHubActionReference{
+		{Uses: "actions/setup-go@0aaccfd150d50ccaeb58ebd88d36e91967a5f35b", Name: "actions/setup-go", Ref: "0aaccfd150d50ccaeb58ebd88d36e91967a5f35b", Kind: "action", Pinned: true},
+		{Uses: "hashicorp/setup-terraform@v3", Name: "hashicorp/setup-terraform", Ref: "v3", Kind: "action"},
+	}, action.Actions)
+	// PowerShell steps are not shell scripts
+	assert.Equal(t, []string{"make deps"}, action.Scripts)
+
+	action = parser.ParseAction("runs:\n  using: docker\n  image: docker://ghcr.io/acme/linter@sha256:4f2b\n")
+	require.NotNil(t, action)
+	assert.Equal(t, []GitHubActionReference{
+		{Uses: "docker://ghcr.io/acme/linter@sha256:4f2b", Name: "ghcr.io/acme/linter", Ref: "sha256:4f2b", Kind: "docker", Pinned: true},
+	}, action.Actions)
+
+	assert.Nil(t, parser.ParseAction("name: not an action\n"))
+}
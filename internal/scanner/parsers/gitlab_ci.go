@@ -0,0 +1,270 @@
+package parsers
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GitLabCIParser handles GitLab CI/CD configuration parsing (.gitlab-ci.yml and included files)
+type GitLabCIParser struct{}
+
+// NewGitLabCIParser creates a new GitLab CI parser
+func NewGitLabCIParser() *GitLabCIParser {
+	return &GitLabCIParser{}
+}
+
+// GitLabCIInclude represents an entry of the include keyword
+type GitLabCIInclude struct {
+	Type  string   `json:"type"` // local, project, remote, template, component
+	Name  string   `json:"name"` // Path, project, URL, template or component path
+	Ref   string   `json:"ref,omitempty"`
+	Files []string `json:"files,omitempty"` // Files of a project include
+}
+
+// GitLabCIJob represents a job of a pipeline
+type GitLabCIJob struct {
+	Name     string   `json:"name"`
+	Stage    string   `json:"stage,omitempty"`
+	Image    string   `json:"image,omitempty"`
+	Services []string `json:"services,omitempty"`
+	Extends  []string `json:"extends,omitempty"`
+	Needs    []string `json:"needs,omitempty"`
+	Hidden   bool     `json:"hidden,omitempty"` // Template jobs starting with "."
+}
+
+// GitLabCIPipeline represents parsed information from a GitLab CI configuration file
+type GitLabCIPipeline struct {
+	File     string            `json:"file,omitempty"`
+	Stages   []string          `json:"stages,omitempty"`
+	Image    string            `json:"image,omitempty"` // Default image
+	Services []string          `json:"services,omitempty"`
+	Includes []GitLabCIInclude `json:"includes,omitempty"`
+	Jobs     []GitLabCIJob     `json:"jobs,omitempty"`
+	Scripts  []string          `json:"-"` // script, before_script and after_script lines
+}
+
+// IsEmpty reports whether the configuration defines neither jobs nor includes
+func (p *GitLabCIPipeline) IsEmpty() bool {
+	return len(p.Jobs) == 0 && len(p.Includes) == 0
+}
+
+// Images returns all distinct images used by the pipeline (default, job images and services)
+func (p *GitLabCIPipeline) Images() []string {
+	var images []string
+	add := func(image string) {
+		if image != "" && !containsString(images, image) {
+			images = append(images, image)
+		}
+	}
+
+	add(p.Image)
+	for _, service := range p.Services {
+		add(service)
+	}
+	for _, job := range p.Jobs {
+		add(job.Image)
+		for _, service := range job.Services {
+			add(service)
+		}
+	}
+	return images
+}
+
+// gitlabCIGlobalKeywords are top-level keys that are not jobs
+var gitlabCIGlobalKeywords = map[string]bool{
+	"default": true, "include": true, "stages": true, "variables": true, "workflow": true,
+	"image": true, "services": true, "cache": true, "before_script": true, "after_script": true,
+	"spec": true,
+}
+
+// ParseGitLabCI parses a GitLab CI configuration
+// Multi-document files (component templates with a spec header) are supported and
+// custom tags such as !reference are tolerated by walking the YAML node tree
+func (p *GitLabCIParser) ParseGitLabCI(content string) *GitLabCIPipeline {
+	pipeline := &GitLabCIPipeline{}
+
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+		p.parseDocument(doc.Content[0], pipeline)
+	}
+
+	return pipeline
+}
+
+func (p *GitLabCIParser) parseDocument(doc *yaml.Node, pipeline *GitLabCIPipeline) {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i].Value, doc.Content[i+1]
+		switch key {
+		case "stages":
+			pipeline.Stages = append(pipeline.Stages, scalarValues(value)...)
+		case "image":
+			pipeline.Image = gitlabCIImage(value)
+		case "services":
+			pipeline.Services = append(pipeline.Services, gitlabCIServices(value)...)
+		case "default":
+			if image := yamlMappingValue(value, "image"); image != nil {
+				pipeline.Image = gitlabCIImage(image)
+			}
+			if services := yamlMappingValue(value, "services"); services != nil {
+				pipeline.Services = append(pipeline.Services, gitlabCIServices(services)...)
+			}
+			pipeline.Scripts = append(pipeline.Scripts, gitlabCIJobScripts(value)...)
+		case "include":
+			pipeline.Includes = append(pipeline.Includes, parseGitLabCIIncludes(value)...)
+		case "before_script", "after_script":
+			pipeline.Scripts = append(pipeline.Scripts, gitlabCIScriptLines(value)...)
+		default:
+			if gitlabCIGlobalKeywords[key] || value.Kind != yaml.MappingNode {
+				continue
+			}
+			pipeline.Jobs = append(pipeline.Jobs, parseGitLabCIJob(key, value))
+			pipeline.Scripts = append(pipeline.Scripts, gitlabCIJobScripts(value)...)
+		}
+	}
+}
+
+func parseGitLabCIJob(name string, node *yaml.Node) GitLabCIJob {
+	job := GitLabCIJob{
+		Name:   name,
+		Stage:  yamlScalar(node, "stage"),
+		Hidden: strings.HasPrefix(name, "."),
+	}
+
+	if image := yamlMappingValue(node, "image"); image != nil {
+		job.Image = gitlabCIImage(image)
+	}
+	if services := yamlMappingValue(node, "services"); services != nil {
+		job.Services = gitlabCIServices(services)
+	}
+	if extends := yamlMappingValue(node, "extends"); extends != nil {
+		job.Extends = scalarValues(extends)
+	}
+
+	// needs entries are job names or {job: name, artifacts: ...}
+	if needs := yamlMappingValue(node, "needs"); needs != nil && needs.Kind == yaml.SequenceNode {
+		for _, need := range needs.Content {
+			if need.Kind == yaml.ScalarNode {
+				job.Needs = append(job.Needs, need.Value)
+			} else if jobName := yamlScalar(need, "job"); jobName != "" {
+				job.Needs = append(job.Needs, jobName)
+			}
+		}
+	}
+
+	return job
+}
+
+// gitlabCIJobScripts returns the script, before_script and after_script lines of a job
+func gitlabCIJobScripts(node *yaml.Node) []string {
+	var lines []string
+	for _, key := range []string{"before_script", "script", "after_script"} {
+		if script := yamlMappingValue(node, key); script != nil {
+			lines = append(lines, gitlabCIScriptLines(script)...)
+		}
+	}
+	return lines
+}
+
+// gitlabCIScriptLines flattens a script (string or nested lists), !reference tags are skipped
+func gitlabCIScriptLines(node *yaml.Node) []string {
+	if node.Tag == "!reference" {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []string{node.Value}
+	case yaml.SequenceNode:
+		var lines []string
+		for _, item := range node.Content {
+			lines = append(lines, gitlabCIScriptLines(item)...)
+		}
+		return lines
+	}
+	return nil
+}
+
+// gitlabCIImage returns the image of "image: name" or "image: {name: ...}"
+func gitlabCIImage(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return yamlScalar(node, "name")
+}
+
+// gitlabCIServices returns the images of a services list
+func gitlabCIServices(node *yaml.Node) []string {
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var services []string
+	for _, service := range node.Content {
+		if image := gitlabCIImage(service); image != "" {
+			services = append(services, image)
+		}
+	}
+	return services
+}
+
+// parseGitLabCIIncludes parses the include keyword (string, list of strings or mappings)
+func parseGitLabCIIncludes(node *yaml.Node) []GitLabCIInclude {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []GitLabCIInclude{gitlabCIIncludeFromString(node.Value)}
+	case yaml.MappingNode:
+		if include, ok := gitlabCIIncludeFromMapping(node); ok {
+			return []GitLabCIInclude{include}
+		}
+	case yaml.SequenceNode:
+		var includes []GitLabCIInclude
+		for _, item := range node.Content {
+			includes = append(includes, parseGitLabCIIncludes(item)...)
+		}
+		return includes
+	}
+	return nil
+}
+
+func gitlabCIIncludeFromString(value string) GitLabCIInclude {
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return GitLabCIInclude{Type: "remote", Name: value}
+	}
+	return GitLabCIInclude{Type: "local", Name: value}
+}
+
+func gitlabCIIncludeFromMapping(node *yaml.Node) (GitLabCIInclude, bool) {
+	if local := yamlScalar(node, "local"); local != "" {
+		return GitLabCIInclude{Type: "local", Name: local}, true
+	}
+	if project := yamlScalar(node, "project"); project != "" {
+		include := GitLabCIInclude{Type: "project", Name: project, Ref: yamlScalar(node, "ref")}
+		if files := yamlMappingValue(node, "file"); files != nil {
+			include.Files = scalarValues(files)
+		}
+		return include, true
+	}
+	if remote := yamlScalar(node, "remote"); remote != "" {
+		return GitLabCIInclude{Type: "remote", Name: remote}, true
+	}
+	if template := yamlScalar(node, "template"); template != "" {
+		return GitLabCIInclude{Type: "template", Name: template}, true
+	}
+	if component := yamlScalar(node, "component"); component != "" {
+		// $CI_SERVER_FQDN/group/project/component@version
+		name, ref, _ := strings.Cut(component, "@")
+		return GitLabCIInclude{Type: "component", Name: name, Ref: ref}, true
+	}
+	return GitLabCIInclude{}, false
+}
@@ -0,0 +1,148 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitLabCI(t *testing.T) {
+	content := `stages: [build, test, deploy]
+
+default:
+  image: node:20-alpine
+  services:
+    - name: postgres:16
+      alias: db
+
+include:
+  - local: .gitlab/ci/deploy.yml
+  - project: platform/ci-templates
+    ref: v2.1.0
+    file:
+      - /templates/docker.yml
+      - /templates/helm.yml
+  - template: Security/SAST.gitlab-ci.yml
+  - remote: https://example.com/ci/lint.yml
+  - component: $CI_SERVER_FQDN/components/secret-detection/secret-detection@1.2.0
+
+variables:
+  NODE_ENV: test
+
+.node:
+  before_script:
+    - npm ci
+  cache:
+    paths: [node_modules]
+
+build:
+  stage: build
+  extends: .node
+  script:
+    - npm run build
+
+test:
+  stage: test
+  extends: [.node]
+  image:
+    name: registry.example.com:5000/tools/node:20
+  services:
+    - redis:7
+  needs:
+    - build
+    - job: lint
+      artifacts: false
+  script:
+    - !reference [.node, before_script]
+    - npm test
+
+deploy:
+  stage: deploy
+  image: $DEPLOY_IMAGE
+  script: ./deploy.sh
+`
+
+	pipeline := NewGitLabCIParser().ParseGitLabCI(content)
+	require.NotNil(t, pipeline)
+
+	assert.Equal(t, []string{"build", "test", "deploy"}, pipeline.Stages)
+	assert.Equal(t, "node:20-alpine", pipeline.Image)
+	assert.Equal(t, []string{"postgres:16"}, pipeline.Services)
+
+	assert.Equal(t, []GitLabCIInclude{
+		{Type: "local", Name: ".gitlab/ci/deploy.yml"},
+		{Type: "project", Name: "platform/ci-templates", Ref: "v2.1.0", Files: []string{"/templates/docker.yml", "/templates/helm.yml"}},
+		{Type: "template", Name: "Security/SAST.gitlab-ci.yml"},
+		{Type: "remote", Name: "https://example.com/ci/lint.yml"},
+		{Type: "component", Name: "$CI_SERVER_FQDN/components/secret-detection/secret-detection", Ref: "1.2.0"},
+	}, pipeline.Includes)
+
+	require.Len(t, pipeline.Jobs, 4)
+	assert.Equal(t, GitLabCIJob{Name: ".node", Hidden: true}, pipeline.Jobs[0])
+	assert.Equal(t, GitLabCIJob{Name: "build", Stage: "build", Extends: []string{".node"}}, pipeline.Jobs[1])
+	assert.Equal(t, GitLabCIJob{
+		Name:     "test",
+		Stage:    "test",
+		Image:    "registry.example.com:5000/tools/node:20",
+		Services: []string{"redis:7"},
+		Extends:  []string{".node"},
+		Needs:    []string{"build", "lint"},
+	}, pipeline.Jobs[2])
+
+	assert.Equal(t, []string{
+		"node:20-alpine",
+		"postgres:16",
+		"registry.example.com:5000/tools/node:20",
+		"redis:7",
+		"$DEPLOY_IMAGE",
+	}, pipeline.Images())
+
+	// !reference tags are resolved by GitLab and skipped
+	assert.Equal(t, []string{"npm ci", "npm run build", "npm test", "./deploy.sh"}, pipeline.Scripts)
+}
+
+func TestParseGitLabCI_ComponentTemplate(t *testing.T) {
+	content := `spec:
+  inputs:
+    stage:
+      default: test
+---
+secret-detection:
+  stage: $[[ inputs.stage ]]
+  image: registry.gitlab.com/security-products/secrets:6
+  script: /analyzer run
+`
+
+	pipeline := NewGitLabCIParser().ParseGitLabCI(content)
+	require.NotNil(t, pipeline)
+	require.Len(t, pipeline.Jobs, 1)
+	assert.Equal(t, "secret-detection", pipeline.Jobs[0].Name)
+	assert.Equal(t, "registry.gitlab.com/security-products/secrets:6", pipeline.Jobs[0].Image)
+}
+
+func TestParseGitLabCI_IncludeForms(t *testing.T) {
+	parser := NewGitLabCIParser()
+
+	pipeline := parser.ParseGitLabCI("include: '/templates/.gitlab-ci-template.yml'\n")
+	require.NotNil(t, pipeline)
+	assert.Equal(t, []GitLabCIInclude{{Type: "local", Name: "/templates/.gitlab-ci-template.yml"}}, pipeline.Includes)
+	assert.False(t, pipeline.IsEmpty())
+
+	pipeline = parser.ParseGitLabCI("include:\n  - https://example.com/a.yml\n  - b.yml\n")
+	require.NotNil(t, pipeline)
+	assert.Equal(t, []GitLabCIInclude{
+		{Type: "remote", Name: "https://example.com/a.yml"},
+		{Type: "local", Name: "b.yml"},
+	}, pipeline.Includes)
+}
+
+func TestParseGitLabCI_Empty(t *testing.T) {
+	parser := NewGitLabCIParser()
+
+	pipeline := parser.ParseGitLabCI("variables:\n  A: b\n")
+	require.NotNil(t, pipeline)
+	assert.True(t, pipeline.IsEmpty())
+
+	assert.Nil(t, parser.ParseGitLabCI("build: [unclosed\n"))
+}
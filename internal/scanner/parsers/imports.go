@@ -0,0 +1,237 @@
+package parsers
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ImportParser extracts the modules imported or required by source files of Python, JavaScript/TypeScript,
+// Go, Java/Kotlin, C# and Ruby. Relative imports and standard library modules are skipped where they can be
+// told apart syntactically
+type ImportParser struct{}
+
+// NewImportParser creates a new import parser
+func NewImportParser() *ImportParser {
+	return &ImportParser{}
+}
+
+// Import represents a module imported by a source file with the package names it may belong to
+type Import struct {
+	Module     string   // Module as written in the import statement
+	Candidates []string // Package names matched against dependency rules, most specific first
+}
+
+// importLanguages maps source file extensions to the dependency type of their imports
+var importLanguages = map[string]string{
+	".py":   "python",
+	".js":   "npm",
+	".jsx":  "npm",
+	".mjs":  "npm",
+	".cjs":  "npm",
+	".ts":   "npm",
+	".tsx":  "npm",
+	".mts":  "npm",
+	".cts":  "npm",
+	".go":   "golang",
+	".java": "maven",
+	".kt":   "maven",
+	".kts":  "maven",
+	".cs":   "nuget",
+	".rb":   "ruby",
+}
+
+var (
+	pythonImportRegex     = regexp.MustCompile(`(?m)^[ \t]*import[ \t]+([\w., \t]+?)[ \t]*(?:#.*)?$`)
+	pythonFromImportRegex = regexp.MustCompile(`(?m)^[ \t]*from[ \t]+([\w.]+)[ \t]+import\b`)
+
+	jsImportRegex = regexp.MustCompile(`(?:\bimport|\bexport)\s[^'";]*?\bfrom\s*['"]([^'"\s]+)['"]|\bimport\s*['"]([^'"\s]+)['"]|\b(?:require|import)\s*\(\s*['"]([^'"\s]+)['"]\s*\)`)
+
+	javaImportRegex = regexp.MustCompile(`(?m)^\s*import\s+(?:static\s+)?([\w.]+?)(?:\.\*)?\s*(?:;|$|\bas\b)`)
+
+	csharpUsingRegex = regexp.MustCompile(`(?m)^\s*(?:global\s+)?using\s+(?:static\s+)?(?:\w+\s*=\s*)?([\w.]+)\s*;`)
+
+	rubyRequireRegex = regexp.MustCompile(`(?m)^\s*(?:require|gem)\s*\(?\s*['"]([^'"]+)['"]`)
+)
+
+// ImportDependencyType returns the dependency type of the imports of a source file, or an empty string
+func (p *ImportParser) ImportDependencyType(fileName string) string {
+	if strings.HasSuffix(fileName, ".d.ts") || strings.Contains(fileName, ".min.") {
+		return ""
+	}
+	return importLanguages[strings.ToLower(filepath.Ext(fileName))]
+}
+
+// ParseImports returns the distinct imports of a source file
+func (p *ImportParser) ParseImports(fileName, content string) []Import {
+	var imports []Import
+	switch p.ImportDependencyType(fileName) {
+	case "python":
+		imports = p.parsePythonImports(content)
+	case "npm":
+		imports = p.parseJSImports(content)
+	case "golang":
+		imports = p.parseGoImports(content)
+	case "maven":
+		imports = p.parseJavaImports(content)
+	case "nuget":
+		imports = p.parseCSharpUsings(content)
+	case "ruby":
+		imports = p.parseRubyRequires(content)
+	}
+	return uniqueImports(imports)
+}
+
+func (p *ImportParser) parsePythonImports(content string) []Import {
+	var modules []string
+	for _, match := range pythonImportRegex.FindAllStringSubmatch(content, -1) {
+		for _, part := range strings.Split(match[1], ",") {
+			module, _, _ := strings.Cut(strings.TrimSpace(part), " ")
+			modules = append(modules, module)
+		}
+	}
+	for _, match := range pythonFromImportRegex.FindAllStringSubmatch(content, -1) {
+		modules = append(modules, match[1])
+	}
+
+	var imports []Import
+	for _, module := range modules {
+		// Relative imports (from . import x) belong to the project
+		if module == "" || strings.HasPrefix(module, ".") {
+			continue
+		}
+		// google.cloud.storage is distributed as google-cloud-storage, azure.storage.blob as azure-storage-blob
+		segments := strings.Split(module, ".")
+		var candidates []string
+		for i := len(segments); i >= 2; i-- {
+			candidates = append(candidates, strings.Join(segments[:i], "-"))
+		}
+		top := segments[0]
+		candidates = append(candidates, top)
+		if strings.Contains(top, "_") {
+			candidates = append(candidates, strings.ReplaceAll(top, "_", "-"))
+		}
+		imports = append(imports, Import{Module: module, Candidates: candidates})
+	}
+	return imports
+}
+
+func (p *ImportParser) parseJSImports(content string) []Import {
+	var imports []Import
+	for _, match := range jsImportRegex.FindAllStringSubmatch(content, -1) {
+		specifier := match[1] + match[2] + match[3]
+		// Relative, absolute, URL and node: builtin specifiers are not packages
+		if specifier == "" || strings.HasPrefix(specifier, ".") || strings.HasPrefix(specifier, "/") ||
+			strings.Contains(specifier, ":") {
+			continue
+		}
+		segments := strings.Split(specifier, "/")
+		name := segments[0]
+		if strings.HasPrefix(name, "@") && len(segments) > 1 {
+			name += "/" + segments[1]
+		}
+		imports = append(imports, Import{Module: specifier, Candidates: []string{name}})
+	}
+	return imports
+}
+
+func (p *ImportParser) parseGoImports(content string) []Import {
+	// The import declarations precede all other declarations, so the Go parser stops early
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, parser.ImportsOnly)
+	if err != nil || file == nil {
+		return nil
+	}
+	var paths []string
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil {
+			paths = append(paths, path)
+		}
+	}
+
+	var imports []Import
+	for _, path := range paths {
+		segments := strings.Split(path, "/")
+		// Standard library packages have no domain in their first path element
+		if !strings.Contains(segments[0], ".") {
+			continue
+		}
+		// The module path is a prefix of the package path (github.com/aws/aws-sdk-go-v2/service/s3)
+		var candidates []string
+		for i := len(segments); i >= 2; i-- {
+			candidates = append(candidates, strings.Join(segments[:i], "/"))
+		}
+		imports = append(imports, Import{Module: path, Candidates: candidates})
+	}
+	return imports
+}
+
+func (p *ImportParser) parseJavaImports(content string) []Import {
+	var imports []Import
+	for _, match := range javaImportRegex.FindAllStringSubmatch(content, -1) {
+		module := match[1]
+		if strings.HasPrefix(module, "java.") || strings.HasPrefix(module, "javax.") || strings.HasPrefix(module, "kotlin.") {
+			continue
+		}
+		// Packages are matched against Maven group ids (org.apache.kafka.clients.producer -> org.apache.kafka)
+		segments := strings.Split(module, ".")
+		var candidates []string
+		for i := len(segments); i >= 2; i-- {
+			candidates = append(candidates, strings.Join(segments[:i], "."))
+		}
+		imports = append(imports, Import{Module: module, Candidates: candidates})
+	}
+	return imports
+}
+
+func (p *ImportParser) parseCSharpUsings(content string) []Import {
+	var imports []Import
+	for _, match := range csharpUsingRegex.FindAllStringSubmatch(content, -1) {
+		module := match[1]
+		if module == "System" || strings.HasPrefix(module, "System.") {
+			continue
+		}
+		// NuGet package ids usually are a namespace prefix (Azure.Messaging.ServiceBus.Administration)
+		segments := strings.Split(module, ".")
+		var candidates []string
+		for i := len(segments); i >= 1; i-- {
+			candidates = append(candidates, strings.Join(segments[:i], "."))
+		}
+		imports = append(imports, Import{Module: module, Candidates: candidates})
+	}
+	return imports
+}
+
+func (p *ImportParser) parseRubyRequires(content string) []Import {
+	var imports []Import
+	for _, match := range rubyRequireRegex.FindAllStringSubmatch(content, -1) {
+		module := match[1]
+		if strings.HasPrefix(module, ".") || strings.HasPrefix(module, "/") {
+			continue
+		}
+		// aws-sdk-s3 is required as is, active_record/railtie belongs to activerecord
+		segments := strings.Split(module, "/")
+		candidates := []string{strings.ReplaceAll(module, "/", "-"), segments[0]}
+		if strings.Contains(segments[0], "_") {
+			candidates = append(candidates, strings.ReplaceAll(segments[0], "_", ""), strings.ReplaceAll(segments[0], "_", "-"))
+		}
+		imports = append(imports, Import{Module: module, Candidates: candidates})
+	}
+	return imports
+}
+
+// uniqueImports removes repeated modules, keeping the first occurrence
+func uniqueImports(imports []Import) []Import {
+	var unique []Import
+	seen := make(map[string]bool)
+	for _, imp := range imports {
+		if seen[imp.Module] {
+			continue
+		}
+		seen[imp.Module] = true
+		unique = append(unique, imp)
+	}
+	return unique
+}
@@ -0,0 +1,123 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// importModules returns the modules of imports
+func importModules(imports []Import) []string {
+	modules := make([]string, 0, len(imports))
+	for _, imp := range imports {
+		modules = append(modules, imp.Module)
+	}
+	return modules
+}
+
+func TestImportParser_ImportDependencyType(t *testing.T) {
+	parser := NewImportParser()
+	assert.Equal(t, "python", parser.ImportDependencyType("sync.py"))
+	assert.Equal(t, "npm", parser.ImportDependencyType("App.tsx"))
+	assert.Equal(t, "golang", parser.ImportDependencyType("main.go"))
+	assert.Equal(t, "maven", parser.ImportDependencyType("Consumer.kt"))
+	assert.Equal(t, "nuget", parser.ImportDependencyType("Program.cs"))
+	assert.Equal(t, "ruby", parser.ImportDependencyType("worker.rb"))
+	assert.Empty(t, parser.ImportDependencyType("index.d.ts"))
+	assert.Empty(t, parser.ImportDependencyType("vendor.min.js"))
+	assert.Empty(t, parser.ImportDependencyType("README.md"))
+}
+
+func TestImportParser_Python(t *testing.T) {
+	content := `import os, sys
+import boto3  # AWS
+import google.cloud.storage as gcs
+from azure.storage.blob import BlobServiceClient
+from . import models
+from .utils import helper
+    import confluent_kafka
+`
+	imports := NewImportParser().ParseImports("sync.py", content)
+	assert.Equal(t, []string{"os", "sys", "boto3", "google.cloud.storage", "confluent_kafka", "azure.storage.blob"}, importModules(imports))
+	assert.Equal(t, []string{"google-cloud-storage", "google-cloud", "google"}, imports[3].Candidates)
+	assert.Equal(t, []string{"confluent_kafka", "confluent-kafka"}, imports[4].Candidates)
+}
+
+func TestImportParser_JavaScript(t *testing.T) {
+	content := `import express from "express";
+import { S3Client } from '@aws-sdk/client-s3';
+import type { Config } from "./config";
+import "dotenv/config";
+export * from "lodash/fp";
+const Redis = require('ioredis');
+const fs = require("node:fs");
+const lazy = await import("kafkajs");
+`
+	imports := NewImportParser().ParseImports("server.ts", content)
+	assert.Equal(t, []string{"express", "@aws-sdk/client-s3", "dotenv/config", "lodash/fp", "ioredis", "kafkajs"}, importModules(imports))
+	assert.Equal(t, []string{"dotenv"}, imports[2].Candidates)
+	assert.Equal(t, []string{"@aws-sdk/client-s3"}, imports[1].Candidates)
+}
+
+func TestImportParser_Go(t *testing.T) {
+	content := `package main
+
+import "fmt"
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	redis "github.com/redis/go-redis/v9"
+	_ "github.com/lib/pq"
+)
+`
+	imports := NewImportParser().ParseImports("main.go", content)
+	assert.Equal(t, []string{"github.com/aws/aws-sdk-go-v2/service/s3", "github.com/redis/go-redis/v9", "github.com/lib/pq"}, importModules(imports))
+	assert.Equal(t, []string{
+		"github.com/aws/aws-sdk-go-v2/service/s3",
+		"github.com/aws/aws-sdk-go-v2/service",
+		"github.com/aws/aws-sdk-go-v2",
+		"github.com/aws",
+	}, imports[0].Candidates)
+}
+
+func TestImportParser_JavaAndKotlin(t *testing.T) {
+	java := `package com.acme.orders;
+
+import java.util.List;
+import static org.junit.jupiter.api.Assertions.assertEquals;
+import org.apache.kafka.clients.producer.KafkaProducer;
+import redis.clients.jedis.*;
+`
+	imports := NewImportParser().ParseImports("Orders.java", java)
+	assert.Equal(t, []string{"org.junit.jupiter.api.Assertions.assertEquals", "org.apache.kafka.clients.producer.KafkaProducer", "redis.clients.jedis"}, importModules(imports))
+	assert.Contains(t, imports[1].Candidates, "org.apache.kafka")
+
+	kotlin := "import io.lettuce.core.RedisClient\nimport kotlinx.coroutines.flow.Flow as F\n"
+	assert.Equal(t, []string{"io.lettuce.core.RedisClient", "kotlinx.coroutines.flow.Flow"}, importModules(NewImportParser().ParseImports("Cache.kt", kotlin)))
+}
+
+func TestImportParser_CSharp(t *testing.T) {
+	content := `using System;
+using System.Text.Json;
+global using Azure.Messaging.ServiceBus;
+using static StackExchange.Redis.CommandFlags;
+using Json = Newtonsoft.Json;
+using (var scope = provider.CreateScope()) { }
+`
+	imports := NewImportParser().ParseImports("Program.cs", content)
+	assert.Equal(t, []string{"Azure.Messaging.ServiceBus", "StackExchange.Redis.CommandFlags", "Newtonsoft.Json"}, importModules(imports))
+	assert.Equal(t, []string{"Azure.Messaging.ServiceBus", "Azure.Messaging", "Azure"}, imports[0].Candidates)
+}
+
+func TestImportParser_Ruby(t *testing.T) {
+	content := `require "aws-sdk-s3"
+require 'active_record/railtie'
+require_relative "lib/helper"
+require "./local"
+`
+	imports := NewImportParser().ParseImports("worker.rb", content)
+	assert.Equal(t, []string{"aws-sdk-s3", "active_record/railtie"}, importModules(imports))
+	assert.Equal(t, []string{"active_record-railtie", "active_record", "activerecord", "active-record"}, imports[1].Candidates)
+}
@@ -0,0 +1,155 @@
+package parsers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// JenkinsfileParser handles Jenkins pipeline parsing (Jenkinsfile)
+type JenkinsfileParser struct{}
+
+// NewJenkinsfileParser creates a new Jenkinsfile parser
+func NewJenkinsfileParser() *JenkinsfileParser {
+	return &JenkinsfileParser{}
+}
+
+// JenkinsLibrary represents a shared library reference such as @Library('pipeline-lib@v2')
+type JenkinsLibrary struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// JenkinsTool represents an entry of a tools block such as maven 'Maven 3.9'
+type JenkinsTool struct {
+	Type string `json:"type"` // maven, jdk, gradle, nodejs, ...
+	Name string `json:"name"` // Name of the tool installation
+}
+
+// JenkinsPipeline represents parsed information from a Jenkinsfile
+type JenkinsPipeline struct {
+	File        string           `json:"file,omitempty"`
+	Declarative bool             `json:"declarative"`
+	Libraries   []JenkinsLibrary `json:"libraries,omitempty"`
+	Images      []string         `json:"images,omitempty"` // Docker agent images
+	Tools       []JenkinsTool    `json:"tools,omitempty"`
+	Stages      []string         `json:"stages,omitempty"`
+	Scripts     []string         `json:"-"` // sh steps
+}
+
+var (
+	jenkinsCommentRegex     = regexp.MustCompile(`(?m)^\s*//.*$`)
+	jenkinsDeclarativeRegex = regexp.MustCompile(`(?m)^\s*pipeline\s*\{`)
+	// @Library('lib@1.0') _ and @Library(['lib-a', 'lib-b@main']) _
+	jenkinsLibraryAnnotationRegex = regexp.MustCompile(`@Library\s*\(\s*\[?([^)\]]*)\]?\s*\)`)
+	// library 'lib@1.0', library('lib@1.0') and library identifier: 'lib@1.0', retriever: ...
+	jenkinsLibraryStepRegex = regexp.MustCompile(`(?m)^\s*library\s*\(?\s*(?:identifier\s*:\s*)?['"]([^'"]+)['"]`)
+	jenkinsQuotedRegex      = regexp.MustCompile(`['"]([^'"]+)['"]`)
+	// agent { docker 'maven:3' }, agent { docker { image 'maven:3' } } and docker.image('maven:3')
+	jenkinsDockerShortRegex = regexp.MustCompile(`\bdocker\s+['"]([^'"]+)['"]`)
+	jenkinsDockerBlockRegex = regexp.MustCompile(`\bdocker\s*\{[^}]*?\bimage\s*:?\s*['"]([^'"]+)['"]`)
+	jenkinsDockerImageRegex = regexp.MustCompile(`\bdocker\.image\(\s*['"]([^'"]+)['"]\s*\)`)
+	jenkinsToolsBlockRegex  = regexp.MustCompile(`\btools\s*\{`)
+	jenkinsToolRegex        = regexp.MustCompile(`(?m)^\s*(\w+)\s*\(?\s*['"]([^'"]+)['"]`)
+	jenkinsStageRegex       = regexp.MustCompile(`\bstage\s*\(\s*['"]([^'"]+)['"]`)
+	// sh '...', sh """...""", sh(script: '...') and sh label: 'x', script: '...'
+	jenkinsShTripleRegex = regexp.MustCompile(`(?s)\bsh\s*\(?\s*(?:[\w\s:'",]*?script\s*:\s*)?(?:'{3}(.*?)'{3}|"{3}(.*?)"{3})`)
+	jenkinsShRegex       = regexp.MustCompile(`\bsh\s*\(?\s*(?:label\s*:\s*(?:'[^']*'|"[^"]*")\s*,\s*)?(?:script\s*:\s*)?(?:'([^'\n]*)'|"([^"\n]*)")`)
+)
+
+// ParseJenkinsfile parses a declarative or scripted Jenkinsfile
+// Groovy is not evaluated, only the common literal forms of libraries, docker agents, tools and stages are recognised
+func (p *JenkinsfileParser) ParseJenkinsfile(content string) *JenkinsPipeline {
+	content = jenkinsCommentRegex.ReplaceAllString(content, "")
+
+	pipeline := &JenkinsPipeline{
+		Declarative: jenkinsDeclarativeRegex.MatchString(content),
+	}
+
+	for _, match := range jenkinsLibraryAnnotationRegex.FindAllStringSubmatch(content, -1) {
+		for _, reference := range jenkinsQuotedRegex.FindAllStringSubmatch(match[1], -1) {
+			pipeline.addLibrary(reference[1])
+		}
+	}
+	for _, match := range jenkinsLibraryStepRegex.FindAllStringSubmatch(content, -1) {
+		pipeline.addLibrary(match[1])
+	}
+
+	for _, regex := range []*regexp.Regexp{jenkinsDockerShortRegex, jenkinsDockerBlockRegex, jenkinsDockerImageRegex} {
+		for _, match := range regex.FindAllStringSubmatch(content, -1) {
+			if !containsString(pipeline.Images, match[1]) {
+				pipeline.Images = append(pipeline.Images, match[1])
+			}
+		}
+	}
+
+	for _, location := range jenkinsToolsBlockRegex.FindAllStringIndex(content, -1) {
+		block := jenkinsBlockBody(content, location[1])
+		for _, match := range jenkinsToolRegex.FindAllStringSubmatch(block, -1) {
+			tool := JenkinsTool{Type: match[1], Name: match[2]}
+			if !containsTool(pipeline.Tools, tool) {
+				pipeline.Tools = append(pipeline.Tools, tool)
+			}
+		}
+	}
+
+	// Triple quoted scripts are removed before single line sh steps are matched
+	content = jenkinsShTripleRegex.ReplaceAllStringFunc(content, func(step string) string {
+		if match := jenkinsShTripleRegex.FindStringSubmatch(step); match != nil {
+			pipeline.Scripts = append(pipeline.Scripts, match[1]+match[2])
+		}
+		return ""
+	})
+	for _, match := range jenkinsShRegex.FindAllStringSubmatch(content, -1) {
+		pipeline.Scripts = append(pipeline.Scripts, match[1]+match[2])
+	}
+
+	for _, match := range jenkinsStageRegex.FindAllStringSubmatch(content, -1) {
+		if !containsString(pipeline.Stages, match[1]) {
+			pipeline.Stages = append(pipeline.Stages, match[1])
+		}
+	}
+
+	return pipeline
+}
+
+// IsEmpty reports whether nothing pipeline specific was found
+func (p *JenkinsPipeline) IsEmpty() bool {
+	return !p.Declarative && len(p.Libraries) == 0 && len(p.Images) == 0 && len(p.Tools) == 0 && len(p.Stages) == 0 && len(p.Scripts) == 0
+}
+
+func (p *JenkinsPipeline) addLibrary(reference string) {
+	name, version, _ := strings.Cut(strings.TrimSpace(reference), "@")
+	library := JenkinsLibrary{Name: name, Version: version}
+	for _, existing := range p.Libraries {
+		if existing == library {
+			return
+		}
+	}
+	p.Libraries = append(p.Libraries, library)
+}
+
+// jenkinsBlockBody returns the content between an opening brace (ending at start) and its closing brace
+func jenkinsBlockBody(content string, start int) string {
+	depth := 1
+	for i := start; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return content[start:i]
+			}
+		}
+	}
+	return content[start:]
+}
+
+func containsTool(tools []JenkinsTool, tool JenkinsTool) bool {
+	for _, existing := range tools {
+		if existing == tool {
+			return true
+		}
+	}
+	return false
+}
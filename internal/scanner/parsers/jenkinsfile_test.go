@@ -0,0 +1,87 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJenkinsfile_Declarative(t *testing.T) {
+	content := `@Library(['pipeline-utils@v2.3', 'notifications']) _
+library identifier: 'deploy-lib@main', retriever: modernSCM([$class: 'GitSCMSource', remote: 'https://git.example.com/deploy-lib.git'])
+
+pipeline {
+    agent {
+        docker {
+            image 'maven:3.9-eclipse-temurin-21'
+            args '-v $HOME/.m2:/root/.m2'
+        }
+    }
+    tools {
+        maven 'Maven 3.9'
+        jdk 'temurin-21'
+    }
+    stages {
+        stage('Build') {
+            steps {
+                sh 'mvn -B package'
+            }
+        }
+        stage('Frontend') {
+            agent { docker 'node:20-alpine' }
+            tools { nodejs 'node20' }
+            steps {
+                sh 'npm ci'
+            }
+        }
+        // stage('Disabled') { agent { docker 'busybox' } }
+    }
+}
+`
+
+	pipeline := NewJenkinsfileParser().ParseJenkinsfile(content)
+
+	assert.True(t, pipeline.Declarative)
+	assert.Equal(t, []JenkinsLibrary{
+		{Name: "pipeline-utils", Version: "v2.3"},
+		{Name: "notifications"},
+		{Name: "deploy-lib", Version: "main"},
+	}, pipeline.Libraries)
+	assert.Equal(t, []string{"node:20-alpine", "maven:3.9-eclipse-temurin-21"}, pipeline.Images)
+	assert.Equal(t, []JenkinsTool{
+		{Type: "maven", Name: "Maven 3.9"},
+		{Type: "jdk", Name: "temurin-21"},
+		{Type: "nodejs", Name: "node20"},
+	}, pipeline.Tools)
+	assert.Equal(t, []string{"Build", "Frontend"}, pipeline.Stages)
+	assert.Equal(t, []string{"mvn -B package", "npm ci"}, pipeline.Scripts)
+}
+
+func TestParseJenkinsfile_Scripted(t *testing.T) {
+	content := `@Library('shared-lib') _
+
+node {
+    stage('Test') {
+        docker.image('python:3.12-slim').inside {
+            sh 'pytest'
+        }
+    }
+    stage('Deploy') {
+        sh """
+            kubectl apply -f k8s/
+        """
+    }
+}
+`
+
+	pipeline := NewJenkinsfileParser().ParseJenkinsfile(content)
+
+	assert.False(t, pipeline.Declarative)
+	assert.Equal(t, []JenkinsLibrary{{Name: "shared-lib"}}, pipeline.Libraries)
+	assert.Equal(t, []string{"python:3.12-slim"}, pipeline.Images)
+	assert.Equal(t, []string{"Test", "Deploy"}, pipeline.Stages)
+	assert.Equal(t, []string{"\n            kubectl apply -f k8s/\n        ", "pytest"}, pipeline.Scripts)
+	assert.False(t, pipeline.IsEmpty())
+
+	assert.True(t, NewJenkinsfileParser().ParseJenkinsfile("println 'hello'\n").IsEmpty())
+}
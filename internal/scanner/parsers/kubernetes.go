@@ -0,0 +1,183 @@
+package parsers
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	k8sManifestRegex = regexp.MustCompile(`(?m)^apiVersion:\s*\S+`)
+	k8sKindRegex     = regexp.MustCompile(`(?m)^kind:\s*\w+`)
+)
+
+// KubernetesParser handles Kubernetes manifest parsing
+type KubernetesParser struct{}
+
+// NewKubernetesParser creates a new Kubernetes manifest parser
+func NewKubernetesParser() *KubernetesParser {
+	return &KubernetesParser{}
+}
+
+// IsKubernetesManifest reports whether YAML content declares Kubernetes resources
+func IsKubernetesManifest(content string) bool {
+	return k8sManifestRegex.MatchString(content) && k8sKindRegex.MatchString(content)
+}
+
+// k8sResource is the part of a Kubernetes resource declaring ports
+type k8sResource struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec k8sResourceSpec `yaml:"spec"`
+}
+
+// k8sResourceSpec holds the fields of Service, Pod and workload specs (pod templates) declaring ports
+type k8sResourceSpec struct {
+	// Service
+	Type        string           `yaml:"type"`
+	ExternalIPs []string         `yaml:"externalIPs"`
+	Ports       []k8sServicePort `yaml:"ports"`
+
+	// Pod, and pod templates of workloads (Deployment, StatefulSet, DaemonSet, ReplicaSet, Job) and CronJobs
+	k8sPodSpec  `yaml:",inline"`
+	Template    *k8sPodTemplate `yaml:"template"`
+	JobTemplate *struct {
+		Spec struct {
+			Template *k8sPodTemplate `yaml:"template"`
+		} `yaml:"spec"`
+	} `yaml:"jobTemplate"`
+}
+
+type k8sPodTemplate struct {
+	Spec k8sPodSpec `yaml:"spec"`
+}
+
+type k8sPodSpec struct {
+	HostNetwork    bool           `yaml:"hostNetwork"`
+	Containers     []k8sContainer `yaml:"containers"`
+	InitContainers []k8sContainer `yaml:"initContainers"`
+}
+
+type k8sContainer struct {
+	Ports []struct {
+		ContainerPort int    `yaml:"containerPort"`
+		HostPort      int    `yaml:"hostPort"`
+		Protocol      string `yaml:"protocol"`
+	} `yaml:"ports"`
+}
+
+type k8sServicePort struct {
+	Port       int    `yaml:"port"`
+	TargetPort string `yaml:"targetPort"` // Port number or container port name
+	NodePort   int    `yaml:"nodePort"`
+	Protocol   string `yaml:"protocol"`
+}
+
+// ParseEndpoints returns the endpoints declared by the Services and pod specs of a multi-document manifest, in
+// file order. Container ports are internal unless bound to the node (hostPort, hostNetwork). Service ports are
+// public for LoadBalancer and NodePort Services and Services with external IPs, internal otherwise. Documents
+// that are not valid YAML (e.g. Helm templates) end the parsing, documents of unexpected shape are skipped
+func (p *KubernetesParser) ParseEndpoints(content string) []types.Endpoint {
+	var endpoints []types.Endpoint
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(content)))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			break
+		}
+		var resource k8sResource
+		if err := node.Decode(&resource); err != nil {
+			continue
+		}
+
+		switch resource.Kind {
+		case "Service":
+			endpoints = append(endpoints, serviceEndpoints(resource)...)
+		case "Pod":
+			endpoints = append(endpoints, podEndpoints(resource.Metadata.Name, &resource.Spec.k8sPodSpec)...)
+		case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+			if resource.Spec.Template != nil {
+				endpoints = append(endpoints, podEndpoints(resource.Metadata.Name, &resource.Spec.Template.Spec)...)
+			}
+		case "CronJob":
+			if jobTemplate := resource.Spec.JobTemplate; jobTemplate != nil && jobTemplate.Spec.Template != nil {
+				endpoints = append(endpoints, podEndpoints(resource.Metadata.Name, &jobTemplate.Spec.Template.Spec)...)
+			}
+		}
+	}
+	return endpoints
+}
+
+// serviceEndpoints returns the endpoints of a Service: the target port (or the port for named target ports),
+// published on the node port for NodePort Services and on the port otherwise
+func serviceEndpoints(resource k8sResource) []types.Endpoint {
+	exposure := types.EndpointInternal
+	if resource.Spec.Type == "LoadBalancer" || resource.Spec.Type == "NodePort" || len(resource.Spec.ExternalIPs) > 0 {
+		exposure = types.EndpointPublic
+	}
+
+	var endpoints []types.Endpoint
+	for _, port := range resource.Spec.Ports {
+		if port.Port == 0 {
+			continue
+		}
+		endpoint := types.Endpoint{
+			Port:          port.Port,
+			Protocol:      k8sProtocol(port.Protocol),
+			Exposure:      exposure,
+			Source:        "kubernetes",
+			Service:       resource.Metadata.Name,
+			PublishedPort: port.Port,
+		}
+		if target, err := strconv.Atoi(port.TargetPort); err == nil && target > 0 {
+			endpoint.Port = target
+		}
+		if resource.Spec.Type == "NodePort" && port.NodePort > 0 {
+			endpoint.PublishedPort = port.NodePort
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+// podEndpoints returns the container ports of a pod spec
+func podEndpoints(name string, spec *k8sPodSpec) []types.Endpoint {
+	var endpoints []types.Endpoint
+	for _, container := range append(spec.Containers, spec.InitContainers...) {
+		for _, port := range container.Ports {
+			if port.ContainerPort == 0 {
+				continue
+			}
+			endpoint := types.Endpoint{
+				Port:     port.ContainerPort,
+				Protocol: k8sProtocol(port.Protocol),
+				Exposure: types.EndpointInternal,
+				Source:   "kubernetes",
+				Service:  name,
+			}
+			if port.HostPort > 0 || spec.HostNetwork {
+				endpoint.Exposure = types.EndpointPublic
+				endpoint.PublishedPort = port.HostPort
+				if endpoint.PublishedPort == 0 {
+					endpoint.PublishedPort = port.ContainerPort
+				}
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// k8sProtocol returns the lower case protocol of a port, TCP by default
+func k8sProtocol(protocol string) string {
+	if protocol == "" {
+		return "tcp"
+	}
+	return strings.ToLower(protocol)
+}
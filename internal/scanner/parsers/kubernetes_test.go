@@ -0,0 +1,126 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsKubernetesManifest(t *testing.T) {
+	assert.True(t, IsKubernetesManifest("apiVersion: v1\nkind: Service\n"))
+	assert.False(t, IsKubernetesManifest("services:\n  web:\n    image: nginx\n"))
+	assert.False(t, IsKubernetesManifest("kind: Config\n"))
+}
+
+func TestKubernetesParser_ParseEndpoints(t *testing.T) {
+	content := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: orders
+spec:
+  template:
+    spec:
+      containers:
+        - name: orders
+          image: orders:1.0
+          ports:
+            - containerPort: 8080
+            - name: metrics
+              containerPort: 9090
+              protocol: TCP
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: orders
+spec:
+  type: LoadBalancer
+  ports:
+    - port: 443
+      targetPort: 8080
+    - port: 9090
+      targetPort: metrics
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: dns
+spec:
+  type: NodePort
+  ports:
+    - port: 53
+      protocol: UDP
+      nodePort: 30053
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: cache
+spec:
+  ports:
+    - port: 6379
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+        - name: agent
+          ports:
+            - containerPort: 8125
+              protocol: UDP
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: report
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: report
+              ports:
+                - containerPort: 8000
+                  hostPort: 18000
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  ports: "8080"
+`
+	endpoint := func(service string, port, published int, protocol, exposure string) types.Endpoint {
+		return types.Endpoint{Port: port, Protocol: protocol, Exposure: exposure, Source: "kubernetes", Service: service, PublishedPort: published}
+	}
+	assert.Equal(t, []types.Endpoint{
+		endpoint("orders", 8080, 0, "tcp", types.EndpointInternal),
+		endpoint("orders", 9090, 0, "tcp", types.EndpointInternal),
+		endpoint("orders", 8080, 443, "tcp", types.EndpointPublic),
+		endpoint("orders", 9090, 9090, "tcp", types.EndpointPublic),
+		endpoint("dns", 53, 30053, "udp", types.EndpointPublic),
+		endpoint("cache", 6379, 6379, "tcp", types.EndpointInternal),
+		endpoint("agent", 8125, 8125, "udp", types.EndpointPublic),
+		endpoint("report", 8000, 18000, "tcp", types.EndpointPublic),
+	}, NewKubernetesParser().ParseEndpoints(content))
+}
+
+func TestKubernetesParser_ParseEndpoints_Template(t *testing.T) {
+	// Helm templates are not valid YAML
+	content := `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ include "app.fullname" . }}
+spec:
+  ports:
+    - port: {{ .Values.service.port }}
+`
+	assert.Empty(t, NewKubernetesParser().ParseEndpoints(content))
+}
@@ -0,0 +1,337 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Migration tools recognized by the migration parser
+const (
+	MigrationToolFlyway        = "flyway"
+	MigrationToolLiquibase     = "liquibase"
+	MigrationToolAlembic       = "alembic"
+	MigrationToolPrisma        = "prisma"
+	MigrationToolRails         = "rails"
+	MigrationToolEFCore        = "efcore"
+	MigrationToolGolangMigrate = "golang-migrate"
+)
+
+// SQL dialects inferred from migration statements
+const (
+	SQLDialectPostgreSQL = "postgresql"
+	SQLDialectMySQL      = "mysql"
+	SQLDialectSQLServer  = "sqlserver"
+	SQLDialectOracle     = "oracle"
+	SQLDialectSQLite     = "sqlite"
+)
+
+// MigrationParser handles schema migration files of the supported migration tools
+type MigrationParser struct{}
+
+// NewMigrationParser creates a new migration parser
+func NewMigrationParser() *MigrationParser {
+	return &MigrationParser{}
+}
+
+// MigrationSet represents the migrations of one tool found in a directory
+type MigrationSet struct {
+	Directory string `json:"directory"`
+	Tool      string `json:"tool"`
+	Count     int    `json:"count"`
+	Latest    string `json:"latest,omitempty"`   // Identifier of the most recent migration
+	Dialect   string `json:"dialect,omitempty"`  // SQL dialect inferred from statements or tool configuration
+	Database  string `json:"database,omitempty"` // Database tech targeted by the migrations
+}
+
+var (
+	flywayFileRegex        = regexp.MustCompile(`^V(\d+(?:[._]\d+)*)__.+\.sql$`)
+	golangMigrateFileRegex = regexp.MustCompile(`^(\d+)_.+\.(up|down)\.sql$`)
+	railsMigrationRegex    = regexp.MustCompile(`^\d+_\w+\.rb$`)
+	prismaMigrationRegex   = regexp.MustCompile(`^\d+_`)
+
+	liquibaseXMLChangeSetRegex = regexp.MustCompile(`<changeSet\b[^>]*?\sid\s*=\s*"([^"]+)"`)
+	liquibaseSQLChangeSetRegex = regexp.MustCompile(`(?m)^--\s*changeset\s+[^:\s]+:(\S+)`)
+	liquibaseDBMSRegex         = regexp.MustCompile(`dbms\s*[=:]\s*["']?([\w,! ]+)`)
+
+	alembicRevisionRegex     = regexp.MustCompile(`(?m)^revision\s*(?::\s*str\s*)?=\s*['"]([^'"]+)['"]`)
+	alembicDownRevisionRegex = regexp.MustCompile(`(?m)^down_revision\s*(?::[^=\n]+)?=\s*(.+)$`)
+	alembicDialectRegex      = regexp.MustCompile(`sqlalchemy\.dialects(?:\s+import\s+|\.)(postgresql|mysql|mssql|oracle|sqlite)\b`)
+	quotedStringRegex        = regexp.MustCompile(`['"]([^'"]+)['"]`)
+
+	efMigrationIDRegex = regexp.MustCompile(`\[Migration\(\s*"([^"]+)"\s*\)\]`)
+	efMigrationRegex   = regexp.MustCompile(`class\s+\w+\s*:\s*Migration\b`)
+)
+
+// sqlDialectMarkers lists statements and types specific to one SQL dialect
+var sqlDialectMarkers = map[string][]*regexp.Regexp{
+	SQLDialectPostgreSQL: {
+		regexp.MustCompile(`(?i)\b(?:big|small)?serial\b`),
+		regexp.MustCompile(`(?i)\bjsonb\b`),
+		regexp.MustCompile(`(?i)\bcreate\s+extension\b`),
+		regexp.MustCompile(`(?i)\btimestamptz\b`),
+		regexp.MustCompile(`(?i)\bplpgsql\b`),
+		regexp.MustCompile(`(?i)\bbytea\b`),
+		regexp.MustCompile(`(?i)\bgen_random_uuid\s*\(`),
+		regexp.MustCompile(`::\s*(?:text|int|integer|jsonb?|uuid|regclass|timestamp)\b`),
+	},
+	SQLDialectMySQL: {
+		regexp.MustCompile(`(?i)\bauto_increment\b`),
+		regexp.MustCompile(`(?i)\bengine\s*=\s*\w+`),
+		regexp.MustCompile(`(?i)\bdefault\s+charset\b`),
+		regexp.MustCompile(`(?i)\bunsigned\b`),
+		regexp.MustCompile("(?i)\\bcreate\\s+table\\s+(?:if\\s+not\\s+exists\\s+)?`"),
+	},
+	SQLDialectSQLServer: {
+		regexp.MustCompile(`(?i)\bidentity\s*\(\s*\d+\s*,\s*\d+\s*\)`),
+		regexp.MustCompile(`(?i)\bnvarchar\s*\(\s*max\s*\)`),
+		regexp.MustCompile(`(?i)\[dbo\]`),
+		regexp.MustCompile(`(?im)^\s*go\s*$`),
+		regexp.MustCompile(`(?i)\bdatetime2\b`),
+		regexp.MustCompile(`(?i)\buniqueidentifier\b`),
+		regexp.MustCompile(`(?i)\bnewid\s*\(\s*\)`),
+	},
+	SQLDialectOracle: {
+		regexp.MustCompile(`(?i)\bvarchar2\b`),
+		regexp.MustCompile(`(?i)\bnumber\s*\(\s*\d+`),
+		regexp.MustCompile(`(?i)\bsysdate\b`),
+		regexp.MustCompile(`(?i)\bexecute\s+immediate\b`),
+		regexp.MustCompile(`(?i)\bnocache\b`),
+	},
+	SQLDialectSQLite: {
+		regexp.MustCompile(`(?i)\bautoincrement\b`),
+		regexp.MustCompile(`(?i)\bpragma\s+\w+`),
+		regexp.MustCompile(`(?i)\bwithout\s+rowid\b`),
+	},
+}
+
+// dialectAliases normalizes provider names used by the migration tools
+var dialectAliases = map[string]string{
+	"postgres":  SQLDialectPostgreSQL,
+	"mssql":     SQLDialectSQLServer,
+	"sqlserver": SQLDialectSQLServer,
+}
+
+// efDialectAnnotations maps EF Core provider annotations to SQL dialects
+var efDialectAnnotations = []struct {
+	marker  string
+	dialect string
+}{
+	{`"Npgsql:`, SQLDialectPostgreSQL},
+	{`"SqlServer:`, SQLDialectSQLServer},
+	{`"MySql:`, SQLDialectMySQL},
+	{`"Oracle:`, SQLDialectOracle},
+	{`"Sqlite:`, SQLDialectSQLite},
+}
+
+// NormalizeDialect maps provider names such as "postgres" or "mssql" to a dialect
+func NormalizeDialect(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if dialect, exists := dialectAliases[name]; exists {
+		return dialect
+	}
+	return name
+}
+
+// InferSQLDialect returns the dialect whose specific statements appear most often in the given contents
+// An empty string is returned when no dialect-specific statement is found or the result is ambiguous
+func (p *MigrationParser) InferSQLDialect(contents ...string) string {
+	scores := make(map[string]int)
+	for _, content := range contents {
+		for dialect, markers := range sqlDialectMarkers {
+			for _, marker := range markers {
+				if marker.MatchString(content) {
+					scores[dialect]++
+				}
+			}
+		}
+	}
+
+	best, bestScore, tie := "", 0, false
+	for dialect, score := range scores {
+		switch {
+		case score > bestScore:
+			best, bestScore, tie = dialect, score, false
+		case score == bestScore:
+			tie = true
+		}
+	}
+	if tie {
+		return ""
+	}
+	return best
+}
+
+// FlywayVersion returns the version of a Flyway versioned migration file (V1_2__name.sql)
+func (p *MigrationParser) FlywayVersion(fileName string) ([]int, bool) {
+	match := flywayFileRegex.FindStringSubmatch(fileName)
+	if match == nil {
+		return nil, false
+	}
+	return parseVersionParts(strings.FieldsFunc(match[1], func(r rune) bool { return r == '.' || r == '_' })), true
+}
+
+// GolangMigrateVersion returns the version and direction of a golang-migrate file (1_name.up.sql)
+func (p *MigrationParser) GolangMigrateVersion(fileName string) (uint64, string, bool) {
+	match := golangMigrateFileRegex.FindStringSubmatch(fileName)
+	if match == nil {
+		return 0, "", false
+	}
+	version, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return version, match[2], true
+}
+
+// IsRailsMigration reports whether a file name follows the Active Record migration naming
+func (p *MigrationParser) IsRailsMigration(fileName string) bool {
+	return railsMigrationRegex.MatchString(fileName)
+}
+
+// IsPrismaMigration reports whether a directory name follows the Prisma migration naming
+func (p *MigrationParser) IsPrismaMigration(dirName string) bool {
+	return prismaMigrationRegex.MatchString(dirName)
+}
+
+// ParsePrismaLock returns the dialect of a Prisma migration_lock.toml file
+func (p *MigrationParser) ParsePrismaLock(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		key, value, found := strings.Cut(line, "=")
+		if found && strings.TrimSpace(key) == "provider" {
+			return NormalizeDialect(strings.Trim(strings.TrimSpace(value), `"'`))
+		}
+	}
+	return ""
+}
+
+// IsLiquibaseChangelog reports whether content is a Liquibase changelog (XML, YAML, JSON or formatted SQL)
+func (p *MigrationParser) IsLiquibaseChangelog(content string) bool {
+	return strings.Contains(content, "databaseChangeLog") || strings.HasPrefix(strings.TrimSpace(content), "--liquibase formatted sql")
+}
+
+// ParseLiquibaseChangelog returns the changeSet identifiers in document order and the dialect named by dbms attributes
+func (p *MigrationParser) ParseLiquibaseChangelog(fileName, content string) ([]string, string) {
+	var ids []string
+	switch strings.ToLower(fileName[strings.LastIndex(fileName, ".")+1:]) {
+	case "xml":
+		for _, match := range liquibaseXMLChangeSetRegex.FindAllStringSubmatch(content, -1) {
+			ids = append(ids, match[1])
+		}
+	case "sql":
+		for _, match := range liquibaseSQLChangeSetRegex.FindAllStringSubmatch(content, -1) {
+			ids = append(ids, match[1])
+		}
+	default:
+		ids = parseLiquibaseDocument(content)
+	}
+
+	// Only a single positive dbms value identifies the dialect (e.g. dbms="postgresql")
+	dialect := ""
+	for _, match := range liquibaseDBMSRegex.FindAllStringSubmatch(content, -1) {
+		value := strings.TrimSpace(match[1])
+		if strings.ContainsAny(value, ",!") || value == "all" || value == "none" {
+			continue
+		}
+		if dialect != "" && dialect != NormalizeDialect(value) {
+			return ids, ""
+		}
+		dialect = NormalizeDialect(value)
+	}
+	return ids, dialect
+}
+
+// parseLiquibaseDocument returns the changeSet identifiers of a YAML or JSON changelog
+func parseLiquibaseDocument(content string) []string {
+	var changelog struct {
+		DatabaseChangeLog []struct {
+			ChangeSet struct {
+				ID yaml.Node `yaml:"id"`
+			} `yaml:"changeSet"`
+		} `yaml:"databaseChangeLog"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &changelog); err != nil {
+		return nil
+	}
+
+	var ids []string
+	for _, entry := range changelog.DatabaseChangeLog {
+		if entry.ChangeSet.ID.Kind == yaml.ScalarNode {
+			ids = append(ids, entry.ChangeSet.ID.Value)
+		}
+	}
+	return ids
+}
+
+// ParseAlembicRevision returns the revision and down revisions of an Alembic migration script
+func (p *MigrationParser) ParseAlembicRevision(content string) (string, []string, bool) {
+	match := alembicRevisionRegex.FindStringSubmatch(content)
+	if match == nil || !alembicDownRevisionRegex.MatchString(content) {
+		return "", nil, false
+	}
+
+	var downRevisions []string
+	down := alembicDownRevisionRegex.FindStringSubmatch(content)[1]
+	for _, quoted := range quotedStringRegex.FindAllStringSubmatch(down, -1) {
+		downRevisions = append(downRevisions, quoted[1])
+	}
+	return match[1], downRevisions, true
+}
+
+// InferAlembicDialect returns the dialect of SQLAlchemy dialect imports in an Alembic script
+func (p *MigrationParser) InferAlembicDialect(content string) string {
+	if match := alembicDialectRegex.FindStringSubmatch(content); match != nil {
+		return NormalizeDialect(match[1])
+	}
+	return ""
+}
+
+// ParseEFMigration returns the identifier of an EF Core migration ([Migration("...")] attribute)
+// Migrations without designer file are recognized by their Migration base class and reported with an empty identifier
+func (p *MigrationParser) ParseEFMigration(content string) (string, bool) {
+	if match := efMigrationIDRegex.FindStringSubmatch(content); match != nil {
+		return match[1], true
+	}
+	return "", efMigrationRegex.MatchString(content)
+}
+
+// InferEFDialect returns the dialect of provider annotations in EF Core migrations and model snapshots
+func (p *MigrationParser) InferEFDialect(content string) string {
+	for _, annotation := range efDialectAnnotations {
+		if strings.Contains(content, annotation.marker) {
+			return annotation.dialect
+		}
+	}
+	return ""
+}
+
+// CompareVersionParts compares two numeric versions part by part, missing parts count as zero
+func CompareVersionParts(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseVersionParts(parts []string) []int {
+	version := make([]int, 0, len(parts))
+	for _, part := range parts {
+		number, _ := strconv.Atoi(part)
+		version = append(version, number)
+	}
+	return version
+}
@@ -0,0 +1,231 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationParser_InferSQLDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents []string
+		expected string
+	}{
+		{
+			name:     "postgresql",
+			contents: []string{"CREATE TABLE users (id BIGSERIAL PRIMARY KEY, data JSONB, created_at TIMESTAMPTZ DEFAULT now());"},
+			expected: SQLDialectPostgreSQL,
+		},
+		{
+			name:     "postgresql extension and casts",
+			contents: []string{"CREATE EXTENSION IF NOT EXISTS pgcrypto;", "UPDATE t SET v = '1'::int;"},
+			expected: SQLDialectPostgreSQL,
+		},
+		{
+			name:     "mysql",
+			contents: []string{"CREATE TABLE `users` (`id` INT UNSIGNED NOT NULL AUTO_INCREMENT) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"},
+			expected: SQLDialectMySQL,
+		},
+		{
+			name:     "sql server",
+			contents: []string{"CREATE TABLE [dbo].[Users] ([Id] INT IDENTITY(1,1) NOT NULL, [Name] NVARCHAR(MAX))\nGO\n"},
+			expected: SQLDialectSQLServer,
+		},
+		{
+			name:     "oracle",
+			contents: []string{"CREATE TABLE users (id NUMBER(10) NOT NULL, name VARCHAR2(100), created DATE DEFAULT SYSDATE);"},
+			expected: SQLDialectOracle,
+		},
+		{
+			name:     "sqlite",
+			contents: []string{"PRAGMA foreign_keys = ON;\nCREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT);"},
+			expected: SQLDialectSQLite,
+		},
+		{
+			name:     "portable sql",
+			contents: []string{"CREATE TABLE users (id INTEGER PRIMARY KEY, name VARCHAR(100) NOT NULL);"},
+			expected: "",
+		},
+		{
+			name:     "ambiguous",
+			contents: []string{"CREATE TABLE a (id SERIAL);", "CREATE TABLE b (id INT AUTO_INCREMENT);"},
+			expected: "",
+		},
+	}
+
+	parser := NewMigrationParser()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parser.InferSQLDialect(tt.contents...))
+		})
+	}
+}
+
+func TestMigrationParser_FileNames(t *testing.T) {
+	parser := NewMigrationParser()
+
+	version, ok := parser.FlywayVersion("V2_1__add_index.sql")
+	assert.True(t, ok)
+	assert.Equal(t, []int{2, 1}, version)
+	version, ok = parser.FlywayVersion("V10.3.1__orders.sql")
+	assert.True(t, ok)
+	assert.Equal(t, []int{10, 3, 1}, version)
+	_, ok = parser.FlywayVersion("R__refresh_views.sql")
+	assert.False(t, ok)
+	_, ok = parser.FlywayVersion("V1_init.sql")
+	assert.False(t, ok)
+
+	assert.Equal(t, 1, CompareVersionParts([]int{10}, []int{9, 9}))
+	assert.Equal(t, 0, CompareVersionParts([]int{2}, []int{2, 0}))
+	assert.Equal(t, -1, CompareVersionParts([]int{2, 0, 1}, []int{2, 1}))
+
+	migrateVersion, direction, ok := parser.GolangMigrateVersion("20240105120000_add_orders.down.sql")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(20240105120000), migrateVersion)
+	assert.Equal(t, "down", direction)
+	_, _, ok = parser.GolangMigrateVersion("000001_init.sql")
+	assert.False(t, ok)
+
+	assert.True(t, parser.IsRailsMigration("20240105120000_create_orders.rb"))
+	assert.False(t, parser.IsRailsMigration("schema.rb"))
+	assert.True(t, parser.IsPrismaMigration("20240105120000_init"))
+	assert.False(t, parser.IsPrismaMigration("migration_lock.toml"))
+}
+
+func TestMigrationParser_ParsePrismaLock(t *testing.T) {
+	parser := NewMigrationParser()
+	assert.Equal(t, SQLDialectPostgreSQL, parser.ParsePrismaLock("# Please do not edit this file manually\nprovider = \"postgresql\"\n"))
+	assert.Equal(t, SQLDialectSQLServer, parser.ParsePrismaLock(`provider = "sqlserver"`))
+	assert.Equal(t, "", parser.ParsePrismaLock("# empty\n"))
+	assert.Equal(t, "mariadb", DatabaseTech(NormalizeDialect("MariaDB")))
+	assert.Equal(t, "mssql", DatabaseTech(SQLDialectSQLServer))
+	assert.Equal(t, "", DatabaseTech("h2"))
+}
+
+func TestMigrationParser_ParseLiquibaseChangelog(t *testing.T) {
+	parser := NewMigrationParser()
+
+	xml := `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog xmlns="http://www.liquibase.org/xml/ns/dbchangelog">
+    <changeSet author="alice" id="1-create-users" dbms="postgresql">
+        <createTable tableName="users"/>
+    </changeSet>
+    <changeSet id="2-add-email"
+               author="bob">
+        <addColumn tableName="users"/>
+    </changeSet>
+</databaseChangeLog>`
+	assert.True(t, parser.IsLiquibaseChangelog(xml))
+	ids, dialect := parser.ParseLiquibaseChangelog("db.changelog-1.0.xml", xml)
+	assert.Equal(t, []string{"1-create-users", "2-add-email"}, ids)
+	assert.Equal(t, SQLDialectPostgreSQL, dialect)
+
+	yamlChangelog := `databaseChangeLog:
+  - include:
+      file: db/changelog/base.yaml
+  - changeSet:
+      author: alice
+      id: 3
+      changes:
+        - createTable:
+            tableName: orders
+  - changeSet:
+      id: 4-orders-index
+      author: alice
+      dbms: mysql, mariadb
+`
+	ids, dialect = parser.ParseLiquibaseChangelog("changelog.yaml", yamlChangelog)
+	assert.Equal(t, []string{"3", "4-orders-index"}, ids)
+	assert.Equal(t, "", dialect, "multiple dbms values do not identify a dialect")
+
+	sql := `--liquibase formatted sql
+
+--changeset alice:1
+CREATE TABLE users (id SERIAL PRIMARY KEY);
+
+--changeset bob:2 dbms:postgresql
+ALTER TABLE users ADD COLUMN data JSONB;
+`
+	assert.True(t, parser.IsLiquibaseChangelog(sql))
+	ids, dialect = parser.ParseLiquibaseChangelog("changelog.sql", sql)
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, SQLDialectPostgreSQL, dialect)
+
+	assert.False(t, parser.IsLiquibaseChangelog("CREATE TABLE users (id INT);"))
+}
+
+func TestMigrationParser_ParseAlembicRevision(t *testing.T) {
+	parser := NewMigrationParser()
+
+	content := `"""add orders
+
+Revision ID: 3f2a1b
+Revises: 9c8d7e
+"""
+from alembic import op
+import sqlalchemy as sa
+from sqlalchemy.dialects import postgresql
+
+# revision identifiers, used by Alembic.
+revision: str = "3f2a1b"
+down_revision: Union[str, None] = "9c8d7e"
+`
+	revision, down, ok := parser.ParseAlembicRevision(content)
+	assert.True(t, ok)
+	assert.Equal(t, "3f2a1b", revision)
+	assert.Equal(t, []string{"9c8d7e"}, down)
+	assert.Equal(t, SQLDialectPostgreSQL, parser.InferAlembicDialect(content))
+
+	revision, down, ok = parser.ParseAlembicRevision("revision = 'base1'\ndown_revision = None\n")
+	assert.True(t, ok)
+	assert.Equal(t, "base1", revision)
+	assert.Empty(t, down)
+
+	_, down, ok = parser.ParseAlembicRevision("revision = 'merge1'\ndown_revision = ('a1', 'b2')\n")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a1", "b2"}, down)
+
+	_, _, ok = parser.ParseAlembicRevision("def upgrade():\n    pass\n")
+	assert.False(t, ok)
+}
+
+func TestMigrationParser_ParseEFMigration(t *testing.T) {
+	parser := NewMigrationParser()
+
+	designer := `[DbContext(typeof(ShopContext))]
+[Migration("20240105120000_AddOrders")]
+partial class AddOrders
+{
+    protected override void BuildTargetModel(ModelBuilder modelBuilder)
+    {
+        modelBuilder.HasAnnotation("ProductVersion", "8.0.0")
+            .HasAnnotation("Relational:MaxIdentifierLength", 63);
+        NpgsqlModelBuilderExtensions.UseIdentityByDefaultColumns(modelBuilder);
+    }
+}`
+	id, ok := parser.ParseEFMigration(designer)
+	assert.True(t, ok)
+	assert.Equal(t, "20240105120000_AddOrders", id)
+
+	migration := `public partial class AddOrders : Migration
+{
+    protected override void Up(MigrationBuilder migrationBuilder)
+    {
+        migrationBuilder.CreateTable(
+            name: "Orders",
+            columns: table => new
+            {
+                Id = table.Column<int>(type: "int", nullable: false)
+                    .Annotation("SqlServer:Identity", "1, 1"),
+            });
+    }
+}`
+	id, ok = parser.ParseEFMigration(migration)
+	assert.True(t, ok)
+	assert.Empty(t, id)
+	assert.Equal(t, SQLDialectSQLServer, parser.InferEFDialect(migration))
+
+	_, ok = parser.ParseEFMigration("public class OrderService { }")
+	assert.False(t, ok)
+}
@@ -0,0 +1,298 @@
+package parsers
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Monorepo orchestrator tools
+const (
+	MonorepoNx        = "nx"
+	MonorepoTurborepo = "turborepo"
+	MonorepoLerna     = "lerna"
+	MonorepoRush      = "rush"
+)
+
+// MonorepoParser parses the workspace configuration of monorepo orchestrators (nx.json, project.json,
+// turbo.json, lerna.json, rush.json) and the workspace projects (package.json, pnpm-workspace.yaml)
+type MonorepoParser struct{}
+
+// NewMonorepoParser creates a new monorepo parser
+func NewMonorepoParser() *MonorepoParser {
+	return &MonorepoParser{}
+}
+
+// MonorepoConfig represents the root configuration of a monorepo orchestrator
+type MonorepoConfig struct {
+	File           string            `json:"file"`
+	Tool           string            `json:"tool"`
+	Version        string            `json:"version,omitempty"`         // Lerna version (or "independent"), Rush version
+	PackageManager string            `json:"package_manager,omitempty"` // Lerna npmClient, Rush pnpm/npm/yarn version
+	Packages       []string          `json:"packages,omitempty"`        // Lerna package globs, Nx apps/libs directories
+	Plugins        []string          `json:"plugins,omitempty"`         // Nx plugins
+	Projects       []MonorepoProject `json:"projects,omitempty"`        // Rush projects
+	Tasks          []MonorepoTask    `json:"tasks,omitempty"`           // Nx target defaults, Turborepo tasks
+}
+
+// MonorepoProject represents a project declared in the root configuration
+type MonorepoProject struct {
+	Name   string `json:"name"`
+	Folder string `json:"folder"`
+}
+
+// MonorepoTask represents a task (target) of the pipeline. "^build" in dependsOn refers to the build task of
+// the project's dependencies, "web#build" (Turborepo) to the build task of the web package
+type MonorepoTask struct {
+	Name      string   `json:"name"`
+	Executor  string   `json:"executor,omitempty"`
+	DependsOn []string `json:"depends_on,omitempty"`
+	Outputs   []string `json:"outputs,omitempty"`
+}
+
+// NxProject represents an Nx project configuration (project.json)
+type NxProject struct {
+	File                 string         `json:"file"`
+	Name                 string         `json:"name"`
+	ProjectType          string         `json:"project_type,omitempty"` // application or library
+	Tags                 []string       `json:"tags,omitempty"`
+	ImplicitDependencies []string       `json:"implicit_dependencies,omitempty"`
+	Targets              []MonorepoTask `json:"targets,omitempty"`
+}
+
+// WorkspaceProject represents a project of a Rush, Lerna or Turborepo workspace (package.json)
+type WorkspaceProject struct {
+	File         string   `json:"file"`
+	Tool         string   `json:"tool"`
+	Name         string   `json:"name"`
+	Dependencies []string `json:"dependencies,omitempty"` // Projects of the workspace the project depends on
+}
+
+// WorkspacePackage represents the name and the declared dependencies of a package.json
+type WorkspacePackage struct {
+	Name         string
+	Dependencies []string // Names of dependencies, devDependencies, peerDependencies and optionalDependencies
+	Workspaces   []string // Workspace globs of a workspace root
+}
+
+// monorepoTaskDocument is a task of nx.json targetDefaults, project.json targets or turbo.json tasks
+type monorepoTaskDocument struct {
+	Executor  string            `json:"executor"`
+	DependsOn []json.RawMessage `json:"dependsOn"`
+	Outputs   []string          `json:"outputs"`
+}
+
+// ParseNxJSON parses an nx.json workspace configuration
+func (p *MonorepoParser) ParseNxJSON(content []byte) *MonorepoConfig {
+	var doc struct {
+		TargetDefaults  map[string]monorepoTaskDocument `json:"targetDefaults"`
+		Plugins         []json.RawMessage               `json:"plugins"`
+		WorkspaceLayout struct {
+			AppsDir string `json:"appsDir"`
+			LibsDir string `json:"libsDir"`
+		} `json:"workspaceLayout"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+
+	config := &MonorepoConfig{Tool: MonorepoNx, Tasks: parseMonorepoTasks(doc.TargetDefaults)}
+	for _, dir := range []string{doc.WorkspaceLayout.AppsDir, doc.WorkspaceLayout.LibsDir} {
+		if dir != "" {
+			config.Packages = appendUnique(config.Packages, dir)
+		}
+	}
+	// Plugins are either a package name or {"plugin": "@nx/vite/plugin", "options": {...}}
+	for _, raw := range doc.Plugins {
+		var plugin struct {
+			Plugin string `json:"plugin"`
+		}
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			if json.Unmarshal(raw, &plugin) == nil {
+				name = plugin.Plugin
+			}
+		}
+		if name != "" {
+			config.Plugins = appendUnique(config.Plugins, name)
+		}
+	}
+	return config
+}
+
+// ParseNxProject parses an Nx project.json, returning nil for project.json files of other tools
+func (p *MonorepoParser) ParseNxProject(content []byte) *NxProject {
+	var doc struct {
+		Schema               string                          `json:"$schema"`
+		Name                 string                          `json:"name"`
+		ProjectType          string                          `json:"projectType"`
+		SourceRoot           string                          `json:"sourceRoot"`
+		Tags                 []string                        `json:"tags"`
+		ImplicitDependencies []string                        `json:"implicitDependencies"`
+		Targets              map[string]monorepoTaskDocument `json:"targets"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+	if !strings.Contains(doc.Schema, "nx") && doc.Targets == nil && doc.ProjectType == "" && doc.SourceRoot == "" {
+		return nil
+	}
+
+	project := &NxProject{
+		Name:        doc.Name,
+		ProjectType: doc.ProjectType,
+		Tags:        doc.Tags,
+		Targets:     parseMonorepoTasks(doc.Targets),
+	}
+	// "!name" removes an inferred dependency and "*" depends on all projects, neither is a declared dependency
+	for _, dependency := range doc.ImplicitDependencies {
+		if dependency != "" && !strings.HasPrefix(dependency, "!") && !strings.Contains(dependency, "*") {
+			project.ImplicitDependencies = appendUnique(project.ImplicitDependencies, dependency)
+		}
+	}
+	return project
+}
+
+// ParseTurboJSON parses a turbo.json, returning nil for package configurations extending the root one
+func (p *MonorepoParser) ParseTurboJSON(content []byte) *MonorepoConfig {
+	var doc struct {
+		Extends  []string                        `json:"extends"`
+		Tasks    map[string]monorepoTaskDocument `json:"tasks"`
+		Pipeline map[string]monorepoTaskDocument `json:"pipeline"` // Turborepo 1.x
+	}
+	if err := json.Unmarshal([]byte(stripJSONComments(string(content))), &doc); err != nil || len(doc.Extends) > 0 {
+		return nil
+	}
+
+	tasks := doc.Tasks
+	if tasks == nil {
+		tasks = doc.Pipeline
+	}
+	return &MonorepoConfig{Tool: MonorepoTurborepo, Tasks: parseMonorepoTasks(tasks)}
+}
+
+// ParseLernaJSON parses a lerna.json
+func (p *MonorepoParser) ParseLernaJSON(content []byte) *MonorepoConfig {
+	var doc struct {
+		Version   string   `json:"version"`
+		Packages  []string `json:"packages"`
+		NpmClient string   `json:"npmClient"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+	return &MonorepoConfig{Tool: MonorepoLerna, Version: doc.Version, PackageManager: doc.NpmClient, Packages: doc.Packages}
+}
+
+// ParseRushJSON parses a rush.json (JSON with comments)
+func (p *MonorepoParser) ParseRushJSON(content []byte) *MonorepoConfig {
+	var doc struct {
+		RushVersion string `json:"rushVersion"`
+		PnpmVersion string `json:"pnpmVersion"`
+		NpmVersion  string `json:"npmVersion"`
+		YarnVersion string `json:"yarnVersion"`
+		Projects    []struct {
+			PackageName   string `json:"packageName"`
+			ProjectFolder string `json:"projectFolder"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal([]byte(stripJSONComments(string(content))), &doc); err != nil {
+		return nil
+	}
+
+	config := &MonorepoConfig{Tool: MonorepoRush, Version: doc.RushVersion}
+	switch {
+	case doc.PnpmVersion != "":
+		config.PackageManager = "pnpm@" + doc.PnpmVersion
+	case doc.NpmVersion != "":
+		config.PackageManager = "npm@" + doc.NpmVersion
+	case doc.YarnVersion != "":
+		config.PackageManager = "yarn@" + doc.YarnVersion
+	}
+	for _, project := range doc.Projects {
+		if project.PackageName != "" {
+			config.Projects = append(config.Projects, MonorepoProject{Name: project.PackageName, Folder: project.ProjectFolder})
+		}
+	}
+	return config
+}
+
+// parseMonorepoTasks converts task documents into tasks sorted by name
+func parseMonorepoTasks(documents map[string]monorepoTaskDocument) []MonorepoTask {
+	var tasks []MonorepoTask
+	for name, document := range documents {
+		task := MonorepoTask{Name: name, Executor: document.Executor, Outputs: document.Outputs}
+		for _, raw := range document.DependsOn {
+			if dependency := parseTaskDependency(raw); dependency != "" {
+				task.DependsOn = appendUnique(task.DependsOn, dependency)
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Name < tasks[j].Name })
+	return tasks
+}
+
+// parseTaskDependency returns a dependsOn entry in string form. Nx objects ({"target": "build", "projects":
+// "dependencies"}) are converted to the "^build" shorthand, Turborepo 1.x environment entries ($VAR) are skipped
+func parseTaskDependency(raw json.RawMessage) string {
+	var dependency string
+	if err := json.Unmarshal(raw, &dependency); err == nil {
+		if strings.HasPrefix(dependency, "$") {
+			return ""
+		}
+		return dependency
+	}
+
+	var target struct {
+		Target       string          `json:"target"`
+		Projects     json.RawMessage `json:"projects"`
+		Dependencies bool            `json:"dependencies"`
+	}
+	if err := json.Unmarshal(raw, &target); err != nil || target.Target == "" {
+		return ""
+	}
+	if target.Dependencies || strings.Trim(string(target.Projects), `"`) == "dependencies" {
+		return "^" + target.Target
+	}
+	return target.Target
+}
+
+// ParseWorkspacePackage parses the name, dependency names and workspace globs of a package.json. Workspaces
+// are either a list of globs or {"packages": [...]} (Yarn 1)
+func (p *MonorepoParser) ParseWorkspacePackage(content []byte) *WorkspacePackage {
+	var doc struct {
+		Name                 string            `json:"name"`
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+		Workspaces           json.RawMessage   `json:"workspaces"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+
+	pkg := &WorkspacePackage{Name: doc.Name}
+	for _, dependencies := range []map[string]string{doc.Dependencies, doc.DevDependencies, doc.PeerDependencies, doc.OptionalDependencies} {
+		for name := range dependencies {
+			pkg.Dependencies = appendUnique(pkg.Dependencies, name)
+		}
+	}
+	sort.Strings(pkg.Dependencies)
+
+	if err := json.Unmarshal(doc.Workspaces, &pkg.Workspaces); err != nil {
+		var workspaces struct {
+			Packages []string `json:"packages"`
+		}
+		if json.Unmarshal(doc.Workspaces, &workspaces) == nil {
+			pkg.Workspaces = workspaces.Packages
+		}
+	}
+	return pkg
+}
+
+// ParsePnpmWorkspace parses the package globs of a pnpm-workspace.yaml
+func (p *MonorepoParser) ParsePnpmWorkspace(content []byte) []string {
+	return yamlScalars(yamlMappingValue(parseYAMLMapping(string(content)), "packages"))
+}
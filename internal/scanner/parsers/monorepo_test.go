@@ -0,0 +1,165 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonorepoParser_ParseNxJSON(t *testing.T) {
+	content := `{
+  "$schema": "./node_modules/nx/schemas/nx-schema.json",
+  "workspaceLayout": {"appsDir": "apps", "libsDir": "libs"},
+  "targetDefaults": {
+    "test": {"dependsOn": [{"target": "build", "projects": "dependencies"}, "lint"]},
+    "build": {"dependsOn": ["^build"], "cache": true, "outputs": ["{projectRoot}/dist"]}
+  },
+  "plugins": ["@nx/eslint/plugin", {"plugin": "@nx/vite/plugin", "options": {"buildTargetName": "build"}}]
+}`
+	config := NewMonorepoParser().ParseNxJSON([]byte(content))
+	require.NotNil(t, config)
+
+	assert.Equal(t, MonorepoNx, config.Tool)
+	assert.Equal(t, []string{"apps", "libs"}, config.Packages)
+	assert.Equal(t, []string{"@nx/eslint/plugin", "@nx/vite/plugin"}, config.Plugins)
+	assert.Equal(t, []MonorepoTask{
+		{Name: "build", DependsOn: []string{"^build"}, Outputs: []string{"{projectRoot}/dist"}},
+		{Name: "test", DependsOn: []string{"^build", "lint"}},
+	}, config.Tasks)
+}
+
+func TestMonorepoParser_ParseNxProject(t *testing.T) {
+	content := `{
+  "name": "web",
+  "$schema": "../../node_modules/nx/schemas/project-schema.json",
+  "projectType": "application",
+  "tags": ["scope:web", "type:app"],
+  "implicitDependencies": ["ui", "!api", "shared-*", "ui"],
+  "targets": {
+    "serve": {"executor": "@nx/vite:dev-server"},
+    "build": {"executor": "@nx/vite:build", "dependsOn": ["^build"]}
+  }
+}`
+	project := NewMonorepoParser().ParseNxProject([]byte(content))
+	require.NotNil(t, project)
+
+	assert.Equal(t, "web", project.Name)
+	assert.Equal(t, "application", project.ProjectType)
+	assert.Equal(t, []string{"scope:web", "type:app"}, project.Tags)
+	assert.Equal(t, []string{"ui"}, project.ImplicitDependencies)
+	assert.Equal(t, []MonorepoTask{
+		{Name: "build", Executor: "@nx/vite:build", DependsOn: []string{"^build"}},
+		{Name: "serve", Executor: "@nx/vite:dev-server"},
+	}, project.Targets)
+}
+
+func TestMonorepoParser_ParseNxProject_NotNx(t *testing.T) {
+	// project.json of other tools (e.g. ASP.NET Core 1.0, Google Apps Script) have no Nx fields
+	assert.Nil(t, NewMonorepoParser().ParseNxProject([]byte(`{"dependencies": {"Microsoft.NETCore.App": "1.0.0"}}`)))
+	assert.Nil(t, NewMonorepoParser().ParseNxProject([]byte(`not json`)))
+}
+
+func TestMonorepoParser_ParseTurboJSON(t *testing.T) {
+	content := `{
+  // Turborepo 2.x
+  "$schema": "https://turbo.build/schema.json",
+  "tasks": {
+    "build": {"dependsOn": ["^build"], "outputs": [".next/**", "!.next/cache/**"]},
+    "deploy": {"dependsOn": ["build", "web#test"]},
+    "test": {}
+  }
+}`
+	config := NewMonorepoParser().ParseTurboJSON([]byte(content))
+	require.NotNil(t, config)
+
+	assert.Equal(t, MonorepoTurborepo, config.Tool)
+	assert.Equal(t, []MonorepoTask{
+		{Name: "build", DependsOn: []string{"^build"}, Outputs: []string{".next/**", "!.next/cache/**"}},
+		{Name: "deploy", DependsOn: []string{"build", "web#test"}},
+		{Name: "test"},
+	}, config.Tasks)
+}
+
+func TestMonorepoParser_ParseTurboJSON_Pipeline(t *testing.T) {
+	content := `{"pipeline": {"build": {"dependsOn": ["^build", "$API_URL"]}}}`
+	config := NewMonorepoParser().ParseTurboJSON([]byte(content))
+	require.NotNil(t, config)
+	assert.Equal(t, []MonorepoTask{{Name: "build", DependsOn: []string{"^build"}}}, config.Tasks)
+
+	// Package configurations extend the root configuration
+	assert.Nil(t, NewMonorepoParser().ParseTurboJSON([]byte(`{"extends": ["//"], "tasks": {"build": {}}}`)))
+}
+
+func TestMonorepoParser_ParseLernaJSON(t *testing.T) {
+	content := `{"version": "independent", "npmClient": "yarn", "packages": ["packages/*", "tools/*"]}`
+	config := NewMonorepoParser().ParseLernaJSON([]byte(content))
+	require.NotNil(t, config)
+
+	assert.Equal(t, MonorepoLerna, config.Tool)
+	assert.Equal(t, "independent", config.Version)
+	assert.Equal(t, "yarn", config.PackageManager)
+	assert.Equal(t, []string{"packages/*", "tools/*"}, config.Packages)
+}
+
+func TestMonorepoParser_ParseRushJSON(t *testing.T) {
+	content := `/**
+ * This is the main configuration file for Rush.
+ */
+{
+  "$schema": "https://developer.microsoft.com/json-schemas/rush/v5/rush.schema.json",
+  "rushVersion": "5.112.2",
+  "pnpmVersion": "8.15.1",
+  // "npmVersion": "6.14.15",
+  "projects": [
+    {"packageName": "@acme/web", "projectFolder": "apps/web"},
+    {"packageName": "@acme/ui", "projectFolder": "libraries/ui", "reviewCategory": "libraries"}
+  ]
+}`
+	config := NewMonorepoParser().ParseRushJSON([]byte(content))
+	require.NotNil(t, config)
+
+	assert.Equal(t, MonorepoRush, config.Tool)
+	assert.Equal(t, "5.112.2", config.Version)
+	assert.Equal(t, "pnpm@8.15.1", config.PackageManager)
+	assert.Equal(t, []MonorepoProject{
+		{Name: "@acme/web", Folder: "apps/web"},
+		{Name: "@acme/ui", Folder: "libraries/ui"},
+	}, config.Projects)
+}
+
+func TestMonorepoParser_ParseWorkspacePackage(t *testing.T) {
+	content := `{
+  "name": "@acme/web",
+  "workspaces": ["apps/*", "packages/*"],
+  "dependencies": {"@acme/ui": "workspace:*", "react": "^18.2.0"},
+  "devDependencies": {"@acme/config": "*", "react": "^18.2.0"},
+  "peerDependencies": {"next": ">=14"}
+}`
+	pkg := NewMonorepoParser().ParseWorkspacePackage([]byte(content))
+	require.NotNil(t, pkg)
+
+	assert.Equal(t, "@acme/web", pkg.Name)
+	assert.Equal(t, []string{"@acme/config", "@acme/ui", "next", "react"}, pkg.Dependencies)
+	assert.Equal(t, []string{"apps/*", "packages/*"}, pkg.Workspaces)
+
+	// Yarn 1 workspaces with nohoist
+	pkg = NewMonorepoParser().ParseWorkspacePackage([]byte(`{"private": true, "workspaces": {"packages": ["packages/*"], "nohoist": ["**/react-native"]}}`))
+	require.NotNil(t, pkg)
+	assert.Empty(t, pkg.Name)
+	assert.Equal(t, []string{"packages/*"}, pkg.Workspaces)
+
+	assert.Nil(t, NewMonorepoParser().ParseWorkspacePackage([]byte(`not json`)))
+}
+
+func TestMonorepoParser_ParsePnpmWorkspace(t *testing.T) {
+	content := `packages:
+  - "apps/*"
+  - 'packages/**'
+  - "!**/test/**"
+catalog:
+  react: ^18.2.0
+`
+	assert.Equal(t, []string{"apps/*", "packages/**", "!**/test/**"}, NewMonorepoParser().ParsePnpmWorkspace([]byte(content)))
+	assert.Empty(t, NewMonorepoParser().ParsePnpmWorkspace([]byte(`catalog: {}`)))
+}
@@ -0,0 +1,222 @@
+package parsers
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// NotebookParser extracts the kernel, code cells, package installs and imports of Jupyter notebooks (.ipynb)
+type NotebookParser struct {
+	importParser *ImportParser
+}
+
+// NewNotebookParser creates a new notebook parser
+func NewNotebookParser() *NotebookParser {
+	return &NotebookParser{importParser: NewImportParser()}
+}
+
+// Notebook represents the code content of a Jupyter notebook
+type Notebook struct {
+	File            string            `json:"file"`
+	Kernel          NotebookKernel    `json:"kernel"`
+	LanguageVersion string            `json:"language_version,omitempty"`
+	Cells           int               `json:"cells"`      // Number of cells of any type
+	CodeCells       int               `json:"code_cells"` // Number of non-empty code cells
+	Packages        []NotebookPackage `json:"packages,omitempty"`
+	Imports         []Import          `json:"-"` // Imports of the code cells, only for Python kernels
+}
+
+// NotebookKernel represents the kernel spec of a notebook (metadata.kernelspec)
+type NotebookKernel struct {
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Language    string `json:"language,omitempty"`
+}
+
+// NotebookPackage represents a package installed by a %pip or !pip magic
+type NotebookPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// notebookDocument is the subset of the nbformat 4 (cells) and nbformat 3 (worksheets) schemas used by the parser
+type notebookDocument struct {
+	Metadata struct {
+		Kernelspec struct {
+			Name        string `json:"name"`
+			DisplayName string `json:"display_name"`
+			Language    string `json:"language"`
+		} `json:"kernelspec"`
+		LanguageInfo struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"language_info"`
+	} `json:"metadata"`
+	Cells      []notebookCell `json:"cells"`
+	Worksheets []struct {
+		Cells []notebookCell `json:"cells"`
+	} `json:"worksheets"`
+}
+
+type notebookCell struct {
+	CellType string          `json:"cell_type"`
+	Source   json.RawMessage `json:"source"`
+	Input    json.RawMessage `json:"input"` // nbformat 3
+}
+
+var (
+	// pipInstallRegex matches %pip install, !pip install, !pip3 install and !python -m pip install magics
+	pipInstallRegex = regexp.MustCompile(`^[%!]\s*(?:python3?\s+-m\s+)?pip3?\s+install\s+(.+)$`)
+
+	// pipRequirementRegex splits a requirement specifier into name, extras and version constraint
+	pipRequirementRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)(?:\[[^\]]*\])?\s*(?:(==|>=|<=|~=|!=|>|<)\s*([^\s,;]+))?`)
+)
+
+// pipOptionsWithValue are pip install options whose next argument is not a package
+var pipOptionsWithValue = map[string]bool{
+	"-r": true, "--requirement": true, "-c": true, "--constraint": true, "-e": true, "--editable": true,
+	"-i": true, "--index-url": true, "--extra-index-url": true, "-f": true, "--find-links": true,
+	"-t": true, "--target": true, "--prefix": true, "--trusted-host": true, "--platform": true,
+}
+
+// ParseNotebook parses the JSON content of a notebook, returning nil if it is not a notebook
+func (p *NotebookParser) ParseNotebook(content []byte) *Notebook {
+	var doc notebookDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil
+	}
+	cells := doc.Cells
+	for _, worksheet := range doc.Worksheets {
+		cells = append(cells, worksheet.Cells...)
+	}
+	if cells == nil && doc.Metadata.Kernelspec.Name == "" {
+		return nil
+	}
+
+	notebook := &Notebook{
+		Kernel: NotebookKernel{
+			Name:        doc.Metadata.Kernelspec.Name,
+			DisplayName: doc.Metadata.Kernelspec.DisplayName,
+			Language:    strings.ToLower(doc.Metadata.Kernelspec.Language),
+		},
+		LanguageVersion: doc.Metadata.LanguageInfo.Version,
+		Cells:           len(cells),
+	}
+	if notebook.Kernel.Language == "" {
+		notebook.Kernel.Language = strings.ToLower(doc.Metadata.LanguageInfo.Name)
+	}
+
+	var code []string
+	for _, cell := range cells {
+		if cell.CellType != "code" {
+			continue
+		}
+		source := cellSource(cell.Source)
+		if source == "" {
+			source = cellSource(cell.Input)
+		}
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+		notebook.CodeCells++
+		code = append(code, p.parseCellMagics(notebook, source))
+	}
+
+	// Kernels without a language (old notebooks) are most likely IPython
+	if notebook.Kernel.Language == "" || notebook.Kernel.Language == "python" {
+		notebook.Imports = p.importParser.ParseImports("notebook.py", strings.Join(code, "\n"))
+	}
+
+	return notebook
+}
+
+// parseCellMagics collects the packages installed by a cell and returns the cell without magic and shell lines
+func (p *NotebookParser) parseCellMagics(notebook *Notebook, source string) string {
+	// Cell magics (%%bash, %%sql) switch the whole cell to another language
+	if strings.HasPrefix(strings.TrimSpace(source), "%%") {
+		return ""
+	}
+
+	var code []string
+	for _, line := range strings.Split(source, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "%") && !strings.HasPrefix(trimmed, "!") {
+			code = append(code, line)
+			continue
+		}
+		if match := pipInstallRegex.FindStringSubmatch(trimmed); match != nil {
+			notebook.Packages = appendNotebookPackages(notebook.Packages, parsePipArguments(match[1]))
+		}
+	}
+	return strings.Join(code, "\n")
+}
+
+// parsePipArguments returns the packages of pip install arguments, skipping options, files and URLs
+func parsePipArguments(arguments string) []NotebookPackage {
+	// Shell comments and chained commands end the pip arguments
+	if index := strings.IndexAny(arguments, "#;&|"); index >= 0 {
+		arguments = arguments[:index]
+	}
+
+	var packages []NotebookPackage
+	skipNext := false
+	for _, argument := range strings.Fields(arguments) {
+		argument = strings.Trim(argument, `"'`)
+		if skipNext {
+			skipNext = false
+			continue
+		}
+		if strings.HasPrefix(argument, "-") {
+			skipNext = pipOptionsWithValue[argument]
+			continue
+		}
+		if strings.Contains(argument, "://") || strings.Contains(argument, "/") || strings.HasPrefix(argument, "$") ||
+			strings.HasPrefix(argument, "{") {
+			continue
+		}
+		match := pipRequirementRegex.FindStringSubmatch(argument)
+		if match == nil {
+			continue
+		}
+		pkg := NotebookPackage{Name: strings.ToLower(match[1])}
+		if match[2] == "==" {
+			pkg.Version = match[3]
+		}
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// appendNotebookPackages appends packages not installed before
+func appendNotebookPackages(packages []NotebookPackage, added []NotebookPackage) []NotebookPackage {
+	for _, pkg := range added {
+		exists := false
+		for _, existing := range packages {
+			if existing.Name == pkg.Name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			packages = append(packages, pkg)
+		}
+	}
+	return packages
+}
+
+// cellSource returns the source of a cell, which is either a string or an array of lines
+func cellSource(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var source string
+	if err := json.Unmarshal(raw, &source); err == nil {
+		return source
+	}
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err == nil {
+		return strings.Join(lines, "")
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotebookParser_ParseNotebook(t *testing.T) {
+	content := `{
+  "cells": [
+    {"cell_type": "markdown", "source": ["# Churn model\n", "import nothing\n"]},
+    {"cell_type": "code", "source": ["%pip install -q pandas==2.2.1 'scikit-learn>=1.4' -r requirements.txt\n", "!pip3 install --upgrade boto3[crt] git+https://github.com/acme/lib.git\n", "import pandas as pd\n", "from sklearn.linear_model import LogisticRegression\n"]},
+    {"cell_type": "code", "source": "import boto3\n!python -m pip install pandas openai  # again\n"},
+    {"cell_type": "code", "source": "%%bash\nimport notpython\n"},
+    {"cell_type": "code", "source": []}
+  ],
+  "metadata": {
+    "kernelspec": {"name": "python3", "display_name": "Python 3 (ipykernel)", "language": "python"},
+    "language_info": {"name": "python", "version": "3.11.6"}
+  },
+  "nbformat": 4,
+  "nbformat_minor": 5
+}`
+	notebook := NewNotebookParser().ParseNotebook([]byte(content))
+	require.NotNil(t, notebook)
+
+	assert.Equal(t, NotebookKernel{Name: "python3", DisplayName: "Python 3 (ipykernel)", Language: "python"}, notebook.Kernel)
+	assert.Equal(t, "3.11.6", notebook.LanguageVersion)
+	assert.Equal(t, 5, notebook.Cells)
+	assert.Equal(t, 3, notebook.CodeCells)
+	assert.Equal(t, []NotebookPackage{
+		{Name: "pandas", Version: "2.2.1"},
+		{Name: "scikit-learn"},
+		{Name: "boto3"},
+		{Name: "openai"},
+	}, notebook.Packages)
+	assert.Equal(t, []string{"pandas", "boto3", "sklearn.linear_model"}, importModules(notebook.Imports))
+}
+
+func TestNotebookParser_NonPythonKernel(t *testing.T) {
+	content := `{
+  "cells": [{"cell_type": "code", "source": ["library(ggplot2)\n", "import foo\n"]}],
+  "metadata": {"kernelspec": {"name": "ir", "display_name": "R", "language": "R"}},
+  "nbformat": 4
+}`
+	notebook := NewNotebookParser().ParseNotebook([]byte(content))
+	require.NotNil(t, notebook)
+	assert.Equal(t, "r", notebook.Kernel.Language)
+	assert.Empty(t, notebook.Imports)
+}
+
+func TestNotebookParser_Nbformat3(t *testing.T) {
+	content := `{
+  "metadata": {"name": "legacy"},
+  "nbformat": 3,
+  "worksheets": [{"cells": [{"cell_type": "code", "language": "python", "input": ["import numpy\n"]}]}]
+}`
+	notebook := NewNotebookParser().ParseNotebook([]byte(content))
+	require.NotNil(t, notebook)
+	assert.Equal(t, 1, notebook.CodeCells)
+	assert.Equal(t, []string{"numpy"}, importModules(notebook.Imports))
+}
+
+func TestNotebookParser_Invalid(t *testing.T) {
+	parser := NewNotebookParser()
+	assert.Nil(t, parser.ParseNotebook([]byte("not json")))
+	assert.Nil(t, parser.ParseNotebook([]byte(`{"name": "package"}`)))
+}
@@ -0,0 +1,124 @@
+package parsers
+
+import (
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PulumiParser handles Pulumi project and stack file parsing
+type PulumiParser struct{}
+
+// NewPulumiParser creates a new Pulumi parser
+func NewPulumiParser() *PulumiParser {
+	return &PulumiParser{}
+}
+
+// PulumiProject represents information from Pulumi.yaml and its stack files
+// Only configuration keys are recorded, values (including secrets) are never stored
+type PulumiProject struct {
+	File        string   `json:"file,omitempty"`
+	Name        string   `json:"name"`
+	Runtime     string   `json:"runtime,omitempty"` // nodejs, python, go, dotnet, java, yaml
+	Description string   `json:"description,omitempty"`
+	Backend     string   `json:"backend,omitempty"` // Scheme of backend.url, e.g. "s3", "file"
+	Stacks      []string `json:"stacks,omitempty"`
+	ConfigKeys  []string `json:"config_keys,omitempty"`
+	Providers   []string `json:"providers,omitempty"` // Namespaces of provider config keys, e.g. "aws"
+}
+
+// pulumiProjectFile mirrors the fields of Pulumi.yaml used by the parser
+type pulumiProjectFile struct {
+	Name        string                 `yaml:"name"`
+	Runtime     yaml.Node              `yaml:"runtime"`
+	Description string                 `yaml:"description"`
+	Backend     struct{ URL string }   `yaml:"backend"`
+	Config      map[string]interface{} `yaml:"config"`
+}
+
+// pulumiStackFile mirrors the fields of Pulumi.<stack>.yaml used by the parser
+type pulumiStackFile struct {
+	Config map[string]interface{} `yaml:"config"`
+}
+
+// IsPulumiStackFile reports whether a file name is a Pulumi stack file and returns the stack name
+func (p *PulumiParser) IsPulumiStackFile(fileName string) (string, bool) {
+	name, found := strings.CutPrefix(fileName, "Pulumi.")
+	if !found {
+		return "", false
+	}
+	for _, ext := range []string{".yaml", ".yml"} {
+		if stack, found := strings.CutSuffix(name, ext); found && stack != "" {
+			return stack, true
+		}
+	}
+	return "", false
+}
+
+// ParseProject parses Pulumi.yaml content
+func (p *PulumiParser) ParseProject(content string) *PulumiProject {
+	var file pulumiProjectFile
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil || file.Name == "" {
+		return nil
+	}
+
+	project := &PulumiProject{
+		Name:        file.Name,
+		Description: file.Description,
+	}
+
+	// runtime is either a string or {name: nodejs, options: {...}}
+	switch file.Runtime.Kind {
+	case yaml.ScalarNode:
+		project.Runtime = file.Runtime.Value
+	case yaml.MappingNode:
+		var runtime struct{ Name string }
+		if err := file.Runtime.Decode(&runtime); err == nil {
+			project.Runtime = runtime.Name
+		}
+	}
+
+	if scheme, _, found := strings.Cut(file.Backend.URL, "://"); found {
+		project.Backend = scheme
+	}
+
+	p.addConfigKeys(project, file.Config)
+
+	return project
+}
+
+// AddStack records a stack and the configuration keys of its Pulumi.<stack>.yaml file
+func (p *PulumiParser) AddStack(project *PulumiProject, stack, content string) {
+	project.Stacks = appendUniqueSorted(project.Stacks, stack)
+
+	var file pulumiStackFile
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil {
+		return
+	}
+	p.addConfigKeys(project, file.Config)
+}
+
+// addConfigKeys records configuration keys and provider namespaces ("aws:region" -> "aws")
+func (p *PulumiParser) addConfigKeys(project *PulumiProject, config map[string]interface{}) {
+	for key := range config {
+		project.ConfigKeys = appendUniqueSorted(project.ConfigKeys, key)
+
+		namespace, _, found := strings.Cut(key, ":")
+		if found && namespace != project.Name && namespace != "pulumi" {
+			project.Providers = appendUniqueSorted(project.Providers, namespace)
+		}
+	}
+}
+
+// appendUniqueSorted adds a value to a sorted slice if not already present
+func appendUniqueSorted(values []string, value string) []string {
+	index := sort.SearchStrings(values, value)
+	if index < len(values) && values[index] == value {
+		return values
+	}
+	values = append(values, "")
+	copy(values[index+1:], values[index:])
+	values[index] = value
+	return values
+}
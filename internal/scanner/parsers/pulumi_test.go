@@ -0,0 +1,94 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePulumiProject(t *testing.T) {
+	parser := NewPulumiParser()
+
+	t.Run("runtime as string", func(t *testing.T) {
+		project := parser.ParseProject(`name: shop-infra
+runtime: python
+description: Shop infrastructure
+backend:
+  url: s3://state-bucket/shop
+config:
+  aws:region:
+    default: eu-west-1
+`)
+		require.NotNil(t, project)
+		assert.Equal(t, "shop-infra", project.Name)
+		assert.Equal(t, "python", project.Runtime)
+		assert.Equal(t, "Shop infrastructure", project.Description)
+		assert.Equal(t, "s3", project.Backend)
+		assert.Equal(t, []string{"aws:region"}, project.ConfigKeys)
+		assert.Equal(t, []string{"aws"}, project.Providers)
+	})
+
+	t.Run("runtime with options", func(t *testing.T) {
+		project := parser.ParseProject(`name: web
+runtime:
+  name: nodejs
+  options:
+    typescript: true
+`)
+		require.NotNil(t, project)
+		assert.Equal(t, "nodejs", project.Runtime)
+		assert.Empty(t, project.Backend)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Nil(t, parser.ParseProject("runtime: go\n"))
+		assert.Nil(t, parser.ParseProject("name: [unclosed\n"))
+	})
+}
+
+func TestPulumiParser_AddStack(t *testing.T) {
+	parser := NewPulumiParser()
+	project := &PulumiProject{Name: "shop"}
+
+	parser.AddStack(project, "prod", `config:
+  aws:region: eu-west-1
+  shop:dbPassword:
+    secure: AAABAHc2Zm9vYmFy
+  gcp:project: shop-prod
+`)
+	parser.AddStack(project, "dev", `config:
+  aws:region: eu-central-1
+  shop:replicas: "1"
+`)
+
+	assert.Equal(t, []string{"dev", "prod"}, project.Stacks)
+	assert.Equal(t, []string{"aws:region", "gcp:project", "shop:dbPassword", "shop:replicas"}, project.ConfigKeys)
+	// Project-scoped keys are not providers
+	assert.Equal(t, []string{"aws", "gcp"}, project.Providers)
+}
+
+func TestPulumiParser_IsPulumiStackFile(t *testing.T) {
+	parser := NewPulumiParser()
+
+	tests := []struct {
+		fileName string
+		stack    string
+		isStack  bool
+	}{
+		{"Pulumi.prod.yaml", "prod", true},
+		{"Pulumi.dev-eu.yml", "dev-eu", true},
+		{"Pulumi.yaml", "", false},
+		{"Pulumi.yml", "", false},
+		{"pulumi.prod.yaml", "", false},
+		{"Pulumi.prod.json", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			stack, isStack := parser.IsPulumiStackFile(tt.fileName)
+			assert.Equal(t, tt.isStack, isStack)
+			assert.Equal(t, tt.stack, stack)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package parsers
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// RuntimeParser handles runtime version declarations: version files (.nvmrc, .python-version, global.json, ...),
+// tool managers (.tool-versions, mise.toml) and the runtime fields of manifests (engines, requires-python, go, ...)
+type RuntimeParser struct{}
+
+// NewRuntimeParser creates a new runtime parser
+func NewRuntimeParser() *RuntimeParser {
+	return &RuntimeParser{}
+}
+
+// RuntimeVersion represents the version of a language runtime or toolchain targeted by a component
+type RuntimeVersion struct {
+	Version string `json:"version"` // Exact version, constraint (>=3.11) or alias (lts/iron) as declared
+	Source  string `json:"source"`  // File the version was read from, with the field for manifests (package.json#engines.node)
+}
+
+// runtimeToolAliases maps asdf and mise tool names to runtime names
+// The dotnet tools install an SDK, the runtime targeted by a project is its target framework
+var runtimeToolAliases = map[string]string{
+	"nodejs":      "node",
+	"golang":      "go",
+	"dotnet":      "dotnet-sdk",
+	"dotnet-core": "dotnet-sdk",
+}
+
+var (
+	tomlSectionRegex = regexp.MustCompile(`^\[\[?\s*([^\]]+?)\s*\]\]?$`)
+	tomlKeyRegex     = regexp.MustCompile(`^["']?([\w.:/@-]+)["']?\s*=\s*(.+)$`)
+	tomlQuotedRegex  = regexp.MustCompile(`["']([^"']*)["']`)
+	tomlVersionRegex = regexp.MustCompile(`\bversion\s*=\s*["']([^"']+)["']`)
+
+	goDirectiveRegex = regexp.MustCompile(`(?m)^go\s+(\S+)\s*$`)
+	// <release>17</release> of the maven-compiler-plugin configuration
+	mavenCompilerReleaseRegex = regexp.MustCompile(`<release>\s*([^<\s]+)\s*</release>`)
+	// languageVersion = JavaLanguageVersion.of(21), languageVersion.set(JavaLanguageVersion.of(21)) and jvmToolchain(21)
+	gradleToolchainRegex = regexp.MustCompile(`(?:JavaLanguageVersion\.of|jvmToolchain)\s*\(\s*["']?(\d+)["']?\s*\)`)
+	// sourceCompatibility = JavaVersion.VERSION_17, = '17' and = 17
+	gradleCompatibilityRegex = regexp.MustCompile(`\b(?:sourceCompatibility|targetCompatibility)\s*=\s*(?:JavaVersion\.VERSION_|["'])?([\d._]+)`)
+	gemfileRubyRegex         = regexp.MustCompile(`(?m)^\s*ruby\s+["']([^"']+)["']`)
+)
+
+// ParseVersionFile returns the version pinned by a single runtime version file
+// (.nvmrc, .node-version, .python-version, .ruby-version, rust-toolchain, rust-toolchain.toml, global.json)
+func (p *RuntimeParser) ParseVersionFile(name, content string) string {
+	switch name {
+	case "global.json":
+		var global struct {
+			SDK struct {
+				Version string `json:"version"`
+			} `json:"sdk"`
+		}
+		if err := json.Unmarshal([]byte(content), &global); err != nil {
+			return ""
+		}
+		return global.SDK.Version
+	case "rust-toolchain.toml":
+		return tomlString(parseTOMLSections(content)["toolchain"]["channel"])
+	case "rust-toolchain":
+		// Legacy files hold the channel or are TOML as well
+		if channel := tomlString(parseTOMLSections(content)["toolchain"]["channel"]); channel != "" {
+			return channel
+		}
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// pyenv allows several versions per line, the first one is the default
+		version := strings.Fields(line)[0]
+		return normalizeRuntimeVersion(strings.TrimPrefix(version, "ruby-"))
+	}
+	return ""
+}
+
+// ParseToolVersions parses an asdf .tool-versions file ("nodejs 20.11.0") into versions by runtime name
+func (p *RuntimeParser) ParseToolVersions(content string) map[string]string {
+	versions := make(map[string]string)
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		// Fallback versions may follow, the first one is used
+		versions[runtimeToolName(fields[0])] = normalizeRuntimeVersion(fields[1])
+	}
+	return versions
+}
+
+// ParseMiseToml parses the [tools] section of mise.toml into versions by runtime name
+// Tools are declared as node = "20", python = ["3.12", "3.11"] or java = { version = "temurin-21" }
+func (p *RuntimeParser) ParseMiseToml(content string) map[string]string {
+	versions := make(map[string]string)
+	for tool, value := range parseTOMLSections(content)["tools"] {
+		if version := tomlString(value); version != "" {
+			versions[runtimeToolName(tool)] = normalizeRuntimeVersion(version)
+		}
+	}
+	return versions
+}
+
+// ParsePackageJSONRuntimes returns the engines and the packageManager of a package.json
+func (p *RuntimeParser) ParsePackageJSONRuntimes(content []byte) map[string]RuntimeVersion {
+	var packageJSON struct {
+		Engines        map[string]interface{} `json:"engines"`
+		PackageManager string                 `json:"packageManager"`
+	}
+	if err := json.Unmarshal(content, &packageJSON); err != nil {
+		return nil
+	}
+
+	runtimes := make(map[string]RuntimeVersion)
+	for engine, value := range packageJSON.Engines {
+		// engines must hold strings, anything else is ignored by npm as well
+		if version, ok := value.(string); ok && version != "" {
+			runtimes[engine] = RuntimeVersion{Version: version, Source: "package.json#engines." + engine}
+		}
+	}
+
+	// pnpm@9.1.0+sha512.abc pins the package manager used by corepack
+	if name, version, ok := strings.Cut(packageJSON.PackageManager, "@"); ok && name != "" {
+		version, _, _ = strings.Cut(version, "+")
+		runtimes[name] = RuntimeVersion{Version: version, Source: "package.json#packageManager"}
+	}
+	return runtimes
+}
+
+// ParsePyprojectRuntime returns requires-python of [project] or the python constraint of Poetry
+func (p *RuntimeParser) ParsePyprojectRuntime(content string) map[string]RuntimeVersion {
+	sections := parseTOMLSections(content)
+	if version := tomlString(sections["project"]["requires-python"]); version != "" {
+		return map[string]RuntimeVersion{"python": {Version: version, Source: "pyproject.toml#requires-python"}}
+	}
+	if version := tomlString(sections["tool.poetry.dependencies"]["python"]); version != "" {
+		return map[string]RuntimeVersion{"python": {Version: version, Source: "pyproject.toml#tool.poetry.dependencies.python"}}
+	}
+	return nil
+}
+
+// ParseGoModRuntime returns the go directive of a go.mod
+func (p *RuntimeParser) ParseGoModRuntime(content string) map[string]RuntimeVersion {
+	if match := goDirectiveRegex.FindStringSubmatch(content); match != nil {
+		return map[string]RuntimeVersion{"go": {Version: match[1], Source: "go.mod#go"}}
+	}
+	return nil
+}
+
+// ParsePomRuntime returns the Java release targeted by a pom.xml
+// maven.compiler.release wins over maven.compiler.target, maven.compiler.source and java.version (Spring Boot)
+func (p *RuntimeParser) ParsePomRuntime(content string) map[string]RuntimeVersion {
+	javaParser := NewJavaParser()
+	properties := javaParser.extractProperties(content)
+
+	for _, property := range []string{"maven.compiler.release", "maven.compiler.target", "maven.compiler.source", "java.version"} {
+		if value, exists := properties[property]; exists {
+			if version := javaParser.resolveVersion(value, properties); !strings.HasPrefix(version, "${") {
+				return map[string]RuntimeVersion{"java": {Version: version, Source: "pom.xml#" + property}}
+			}
+		}
+	}
+
+	if match := mavenCompilerReleaseRegex.FindStringSubmatch(content); match != nil {
+		if version := javaParser.resolveVersion(match[1], properties); !strings.HasPrefix(version, "${") {
+			return map[string]RuntimeVersion{"java": {Version: version, Source: "pom.xml#maven-compiler-plugin.release"}}
+		}
+	}
+	return nil
+}
+
+// ParseGradleRuntime returns the Java toolchain or source compatibility of a Gradle build file
+func (p *RuntimeParser) ParseGradleRuntime(name, content string) map[string]RuntimeVersion {
+	if match := gradleToolchainRegex.FindStringSubmatch(content); match != nil {
+		return map[string]RuntimeVersion{"java": {Version: match[1], Source: name + "#toolchain"}}
+	}
+	if match := gradleCompatibilityRegex.FindStringSubmatch(content); match != nil {
+		// JavaVersion.VERSION_1_8 is Java 8
+		version := strings.TrimPrefix(strings.ReplaceAll(match[1], "_", "."), "1.")
+		return map[string]RuntimeVersion{"java": {Version: version, Source: name + "#sourceCompatibility"}}
+	}
+	return nil
+}
+
+// ParseCargoRuntime returns the rust-version (minimum supported Rust version) of a Cargo.toml
+func (p *RuntimeParser) ParseCargoRuntime(content string) map[string]RuntimeVersion {
+	sections := parseTOMLSections(content)
+	for _, section := range []string{"package", "workspace.package"} {
+		if version := tomlString(sections[section]["rust-version"]); version != "" {
+			return map[string]RuntimeVersion{"rust": {Version: version, Source: "Cargo.toml#rust-version"}}
+		}
+	}
+	return nil
+}
+
+// ParseGemfileRuntime returns the ruby directive of a Gemfile
+func (p *RuntimeParser) ParseGemfileRuntime(content string) map[string]RuntimeVersion {
+	if match := gemfileRubyRegex.FindStringSubmatch(content); match != nil {
+		return map[string]RuntimeVersion{"ruby": {Version: match[1], Source: "Gemfile#ruby"}}
+	}
+	return nil
+}
+
+// runtimeToolName returns the runtime name of an asdf or mise tool
+func runtimeToolName(tool string) string {
+	if name, exists := runtimeToolAliases[tool]; exists {
+		return name
+	}
+	return tool
+}
+
+// normalizeRuntimeVersion removes the "v" prefix of versions such as v20.11.0
+func normalizeRuntimeVersion(version string) string {
+	if len(version) > 1 && version[0] == 'v' && version[1] >= '0' && version[1] <= '9' {
+		return version[1:]
+	}
+	return version
+}
+
+// parseTOMLSections returns the raw values of the keys of each TOML section (top level keys use "")
+// Only single line values are supported, which covers the version fields read by the runtime parser
+func parseTOMLSections(content string) map[string]map[string]string {
+	sections := map[string]map[string]string{"": {}}
+	section := ""
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if match := tomlSectionRegex.FindStringSubmatch(line); match != nil {
+			section = match[1]
+			if sections[section] == nil {
+				sections[section] = make(map[string]string)
+			}
+			continue
+		}
+		if match := tomlKeyRegex.FindStringSubmatch(line); match != nil {
+			sections[section][match[1]] = strings.TrimSpace(match[2])
+		}
+	}
+	return sections
+}
+
+// tomlString returns a string value, the first entry of an array or the version of an inline table
+func tomlString(value string) string {
+	if strings.HasPrefix(value, "{") {
+		if match := tomlVersionRegex.FindStringSubmatch(value); match != nil {
+			return match[1]
+		}
+		return ""
+	}
+	if match := tomlQuotedRegex.FindStringSubmatch(value); match != nil {
+		return match[1]
+	}
+	return ""
+}
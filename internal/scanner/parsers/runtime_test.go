@@ -0,0 +1,115 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersionFile(t *testing.T) {
+	parser := NewRuntimeParser()
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+	}{
+		{name: "nvmrc with v prefix", file: ".nvmrc", content: "v20.11.0\n", expected: "20.11.0"},
+		{name: "nvmrc alias", file: ".nvmrc", content: "lts/iron\n", expected: "lts/iron"},
+		{name: "node-version", file: ".node-version", content: "# pinned\n22\n", expected: "22"},
+		{name: "python-version with fallbacks", file: ".python-version", content: "3.12.2 3.11.8\n", expected: "3.12.2"},
+		{name: "ruby-version with prefix", file: ".ruby-version", content: "ruby-3.3.0\n", expected: "3.3.0"},
+		{name: "legacy rust-toolchain", file: "rust-toolchain", content: "1.76.0\n", expected: "1.76.0"},
+		{name: "rust-toolchain.toml", file: "rust-toolchain.toml", content: "[toolchain]\nchannel = \"1.77.2\"\ncomponents = [\"clippy\"]\n", expected: "1.77.2"},
+		{name: "global.json", file: "global.json", content: `{"sdk": {"version": "8.0.204", "rollForward": "latestFeature"}}`, expected: "8.0.204"},
+		{name: "invalid global.json", file: "global.json", content: `{"sdk":`, expected: ""},
+		{name: "empty file", file: ".nvmrc", content: "\n", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parser.ParseVersionFile(tt.file, tt.content))
+		})
+	}
+}
+
+func TestParseToolVersionsAndMise(t *testing.T) {
+	parser := NewRuntimeParser()
+
+	toolVersions := parser.ParseToolVersions("# asdf\nnodejs 20.11.0 18.19.0\npython 3.12.2\ngolang 1.22.1 # team default\ndotnet 8.0.204\nterraform 1.7.5\n")
+	assert.Equal(t, map[string]string{"node": "20.11.0", "python": "3.12.2", "go": "1.22.1", "dotnet-sdk": "8.0.204", "terraform": "1.7.5"}, toolVersions)
+
+	mise := parser.ParseMiseToml(`[env]
+NODE_ENV = "production"
+
+[tools]
+node = "22"
+python = ["3.12", "3.11"]
+java = { version = "temurin-21", os = ["linux"] }
+"npm:prettier" = "3"
+`)
+	assert.Equal(t, map[string]string{"node": "22", "python": "3.12", "java": "temurin-21", "npm:prettier": "3"}, mise)
+}
+
+func TestParseManifestRuntimes(t *testing.T) {
+	parser := NewRuntimeParser()
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"node": {Version: ">=20", Source: "package.json#engines.node"},
+		"npm":  {Version: ">=10", Source: "package.json#engines.npm"},
+		"pnpm": {Version: "9.1.0", Source: "package.json#packageManager"},
+	}, parser.ParsePackageJSONRuntimes([]byte(`{"engines": {"node": ">=20", "npm": ">=10", "vscode": {}}, "packageManager": "pnpm@9.1.0+sha512.abc"}`)))
+	assert.Empty(t, parser.ParsePackageJSONRuntimes([]byte(`{"name": "app"}`)))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"python": {Version: ">=3.11", Source: "pyproject.toml#requires-python"},
+	}, parser.ParsePyprojectRuntime("[project]\nname = \"app\"\nrequires-python = \">=3.11\"\n"))
+	assert.Equal(t, map[string]RuntimeVersion{
+		"python": {Version: "^3.10", Source: "pyproject.toml#tool.poetry.dependencies.python"},
+	}, parser.ParsePyprojectRuntime("[tool.poetry.dependencies]\npython = \"^3.10\"\nfastapi = \"^0.110\"\n"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"go": {Version: "1.22.1", Source: "go.mod#go"},
+	}, parser.ParseGoModRuntime("module example.com/app\n\ngo 1.22.1\n\ntoolchain go1.22.3\n"))
+	assert.Nil(t, parser.ParseGoModRuntime("module example.com/app\n"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"rust": {Version: "1.74", Source: "Cargo.toml#rust-version"},
+	}, parser.ParseCargoRuntime("[package]\nname = \"app\"\nrust-version = \"1.74\"\n"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"ruby": {Version: "3.3.0", Source: "Gemfile#ruby"},
+	}, parser.ParseGemfileRuntime("source \"https://rubygems.org\"\nruby \"3.3.0\"\ngem \"rails\"\n"))
+}
+
+func TestParseJavaRuntimes(t *testing.T) {
+	parser := NewRuntimeParser()
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"java": {Version: "21", Source: "pom.xml#maven.compiler.release"},
+	}, parser.ParsePomRuntime(`<project>
+  <properties>
+    <java.version>21</java.version>
+    <maven.compiler.release>${java.version}</maven.compiler.release>
+  </properties>
+</project>`))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"java": {Version: "17", Source: "pom.xml#java.version"},
+	}, parser.ParsePomRuntime("<project><properties><java.version>17</java.version></properties></project>"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"java": {Version: "11", Source: "pom.xml#maven-compiler-plugin.release"},
+	}, parser.ParsePomRuntime("<project><build><plugins><plugin><configuration><release>11</release></configuration></plugin></plugins></build></project>"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"java": {Version: "21", Source: "build.gradle.kts#toolchain"},
+	}, parser.ParseGradleRuntime("build.gradle.kts", "java {\n    toolchain {\n        languageVersion.set(JavaLanguageVersion.of(21))\n    }\n}\n"))
+
+	assert.Equal(t, map[string]RuntimeVersion{
+		"java": {Version: "8", Source: "build.gradle#sourceCompatibility"},
+	}, parser.ParseGradleRuntime("build.gradle", "sourceCompatibility = JavaVersion.VERSION_1_8\n"))
+
+	assert.Nil(t, parser.ParseGradleRuntime("build.gradle", "plugins { id 'java' }\n"))
+}
@@ -0,0 +1,201 @@
+package parsers
+
+import (
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ServerlessParser handles Serverless Framework configuration parsing (serverless.yml)
+type ServerlessParser struct{}
+
+// NewServerlessParser creates a new Serverless Framework parser
+func NewServerlessParser() *ServerlessParser {
+	return &ServerlessParser{}
+}
+
+// ServerlessFunction represents an entry of the functions section
+type ServerlessFunction struct {
+	Name    string   `json:"name"`
+	Handler string   `json:"handler,omitempty"`
+	Image   string   `json:"image,omitempty"`
+	Runtime string   `json:"runtime,omitempty"` // Only set when overriding the provider runtime
+	Events  []string `json:"events,omitempty"`
+}
+
+// ServerlessService represents parsed information from a serverless.yml file
+type ServerlessService struct {
+	File             string               `json:"file,omitempty"`
+	Service          string               `json:"service"`
+	FrameworkVersion string               `json:"framework_version,omitempty"`
+	Provider         string               `json:"provider,omitempty"` // aws, azure, google, ...
+	Runtime          string               `json:"runtime,omitempty"`
+	Functions        []ServerlessFunction `json:"functions,omitempty"`
+	Events           map[string]int       `json:"events,omitempty"`          // Event source type -> number of bindings
+	EventFunctions   map[string][]string  `json:"event_functions,omitempty"` // Event source type -> functions it triggers
+	Plugins          []string             `json:"plugins,omitempty"`
+}
+
+// EventTypes returns the distinct event source types in sorted order
+func (s *ServerlessService) EventTypes() []string {
+	types := make([]string, 0, len(s.Events))
+	for eventType := range s.Events {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ParseServerless parses serverless.yml content
+// Variables (${self:...}) and CloudFormation intrinsic tags are tolerated by walking the YAML node tree
+func (p *ServerlessParser) ParseServerless(content string) *ServerlessService {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil {
+		return nil
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	doc := root.Content[0]
+
+	service := &ServerlessService{}
+
+	// service is either a name or {name: ...} in older versions
+	if node := yamlMappingValue(doc, "service"); node != nil {
+		if node.Kind == yaml.MappingNode {
+			node = yamlMappingValue(node, "name")
+		}
+		if node != nil && node.Kind == yaml.ScalarNode {
+			service.Service = node.Value
+		}
+	}
+	if service.Service == "" {
+		return nil
+	}
+
+	if node := yamlMappingValue(doc, "frameworkVersion"); node != nil {
+		service.FrameworkVersion = node.Value
+	}
+
+	// provider is either a name or a mapping with name and runtime
+	if node := yamlMappingValue(doc, "provider"); node != nil {
+		if node.Kind == yaml.ScalarNode {
+			service.Provider = node.Value
+		} else {
+			service.Provider = yamlScalar(node, "name")
+			service.Runtime = yamlScalar(node, "runtime")
+		}
+	}
+
+	if node := yamlMappingValue(doc, "functions"); node != nil {
+		service.Functions, service.Events = parseServerlessFunctions(node)
+		service.EventFunctions = serverlessEventFunctions(service.Functions)
+	}
+
+	// plugins is either a list or {localPath: ..., modules: [...]}
+	if node := yamlMappingValue(doc, "plugins"); node != nil {
+		if node.Kind == yaml.MappingNode {
+			node = yamlMappingValue(node, "modules")
+		}
+		if node != nil {
+			service.Plugins = scalarValues(node)
+		}
+	}
+
+	return service
+}
+
+// serverlessEventFunctions maps each event source type to the functions it triggers, in declaration order
+func serverlessEventFunctions(functions []ServerlessFunction) map[string][]string {
+	var eventFunctions map[string][]string
+	for _, function := range functions {
+		for _, eventType := range function.Events {
+			if eventFunctions == nil {
+				eventFunctions = make(map[string][]string)
+			}
+			eventFunctions[eventType] = append(eventFunctions[eventType], function.Name)
+		}
+	}
+	return eventFunctions
+}
+
+// parseServerlessFunctions extracts functions and counts their event sources
+func parseServerlessFunctions(node *yaml.Node) ([]ServerlessFunction, map[string]int) {
+	if node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	functions := make([]ServerlessFunction, 0, len(node.Content)/2)
+	events := make(map[string]int)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		function := ServerlessFunction{Name: node.Content[i].Value}
+		body := node.Content[i+1]
+		if body.Kind == yaml.MappingNode {
+			function.Handler = yamlScalar(body, "handler")
+			function.Image = yamlScalar(body, "image")
+			function.Runtime = yamlScalar(body, "runtime")
+
+			if eventsNode := yamlMappingValue(body, "events"); eventsNode != nil && eventsNode.Kind == yaml.SequenceNode {
+				for _, event := range eventsNode.Content {
+					if event.Kind != yaml.MappingNode || len(event.Content) < 2 {
+						continue
+					}
+					eventType := serverlessEventType(event.Content[0].Value, event.Content[1])
+					if !containsString(function.Events, eventType) {
+						function.Events = append(function.Events, eventType)
+					}
+					events[eventType]++
+				}
+			}
+		}
+		functions = append(functions, function)
+	}
+
+	if len(events) == 0 {
+		events = nil
+	}
+	return functions, events
+}
+
+// serverlessEventType returns the event source type of an event entry
+// Stream events are qualified by their source: "stream.dynamodb" or "stream.kinesis"
+func serverlessEventType(name string, value *yaml.Node) string {
+	if name != "stream" {
+		return name
+	}
+
+	var arn string
+	switch value.Kind {
+	case yaml.ScalarNode:
+		arn = value.Value
+	case yaml.MappingNode:
+		if streamType := yamlScalar(value, "type"); streamType != "" {
+			return "stream." + streamType
+		}
+		if arnNode := yamlMappingValue(value, "arn"); arnNode != nil {
+			arn = nodeText(arnNode)
+		}
+	}
+
+	switch {
+	case strings.Contains(arn, "dynamodb") || strings.Contains(arn, "StreamArn"):
+		return "stream.dynamodb"
+	case strings.Contains(arn, "kinesis"):
+		return "stream.kinesis"
+	}
+	return name
+}
+
+// nodeText returns the concatenated scalar values of a node, e.g. for "!GetAtt [Table, StreamArn]"
+func nodeText(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	var parts []string
+	for _, child := range node.Content {
+		parts = append(parts, nodeText(child))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,117 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerless(t *testing.T) {
+	content := `service: orders
+frameworkVersion: "3"
+
+provider:
+  name: aws
+  runtime: nodejs20.x
+  stage: ${opt:stage, 'dev'}
+
+plugins:
+  - serverless-offline
+  - serverless-esbuild
+
+functions:
+  api:
+    handler: src/api.handler
+    events:
+      - httpApi:
+          path: /orders
+          method: post
+      - httpApi:
+          path: /orders/{id}
+          method: get
+  worker:
+    handler: src/worker.handler
+    runtime: python3.12
+    events:
+      - sqs:
+          arn: !GetAtt OrdersQueue.Arn
+      - stream:
+          type: dynamodb
+          arn: !GetAtt OrdersTable.StreamArn
+  archive:
+    handler: src/archive.handler
+    events:
+      - s3: ${self:custom.bucket}
+      - schedule: rate(1 day)
+      - stream: arn:aws:kinesis:eu-west-1:123456789012:stream/orders
+
+resources:
+  Resources:
+    OrdersQueue:
+      Type: AWS::SQS::Queue
+`
+
+	parser := NewServerlessParser()
+	service := parser.ParseServerless(content)
+	require.NotNil(t, service)
+
+	assert.Equal(t, "orders", service.Service)
+	assert.Equal(t, "3", service.FrameworkVersion)
+	assert.Equal(t, "aws", service.Provider)
+	assert.Equal(t, "nodejs20.x", service.Runtime)
+	assert.Equal(t, []string{"serverless-offline", "serverless-esbuild"}, service.Plugins)
+
+	require.Len(t, service.Functions, 3)
+	assert.Equal(t, ServerlessFunction{Name: "api", Handler: "src/api.handler", Events: []string{"httpApi"}}, service.Functions[0])
+	assert.Equal(t, "python3.12", service.Functions[1].Runtime)
+	assert.Equal(t, []string{"sqs", "stream.dynamodb"}, service.Functions[1].Events)
+	assert.Equal(t, []string{"s3", "schedule", "stream.kinesis"}, service.Functions[2].Events)
+
+	assert.Equal(t, map[string]int{
+		"httpApi":         2,
+		"sqs":             1,
+		"stream.dynamodb": 1,
+		"s3":              1,
+		"schedule":        1,
+		"stream.kinesis":  1,
+	}, service.Events)
+	assert.Equal(t, []string{"httpApi", "s3", "schedule", "sqs", "stream.dynamodb", "stream.kinesis"}, service.EventTypes())
+	assert.Equal(t, map[string][]string{
+		"httpApi":         {"api"},
+		"sqs":             {"worker"},
+		"stream.dynamodb": {"worker"},
+		"s3":              {"archive"},
+		"schedule":        {"archive"},
+		"stream.kinesis":  {"archive"},
+	}, service.EventFunctions)
+}
+
+func TestParseServerless_LegacyForms(t *testing.T) {
+	content := `service:
+  name: legacy
+provider: aws
+plugins:
+  localPath: ./plugins
+  modules:
+    - serverless-python-requirements
+functions:
+  hello:
+    handler: handler.hello
+`
+
+	service := NewServerlessParser().ParseServerless(content)
+	require.NotNil(t, service)
+	assert.Equal(t, "legacy", service.Service)
+	assert.Equal(t, "aws", service.Provider)
+	assert.Empty(t, service.Runtime)
+	assert.Equal(t, []string{"serverless-python-requirements"}, service.Plugins)
+	assert.Nil(t, service.Events)
+}
+
+func TestParseServerless_Invalid(t *testing.T) {
+	parser := NewServerlessParser()
+	assert.Nil(t, parser.ParseServerless("provider: aws\n"))
+	assert.Nil(t, parser.ParseServerless("service: [unclosed\n"))
+	assert.Nil(t, parser.ParseServerless("- a\n- b\n"))
+}
@@ -0,0 +1,234 @@
+package parsers
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ShellParser extracts the commands invoked by shell scripts (CI run steps, Makefile recipes, .sh files)
+type ShellParser struct{}
+
+// NewShellParser creates a new shell parser
+func NewShellParser() *ShellParser {
+	return &ShellParser{}
+}
+
+// ShellCommand represents an invoked program and its first subcommand (e.g. docker buildx)
+type ShellCommand struct {
+	Name       string `json:"name"`
+	Subcommand string `json:"subcommand,omitempty"`
+}
+
+var (
+	// ${{ expr }} (GitHub Actions), $[[ inputs.x ]] (GitLab) and $(VAR) (Azure Pipelines, make)
+	shellTemplateExpressionRegex = regexp.MustCompile(`\$\{\{.*?\}\}|\$\[\[.*?\]\]|\$\([A-Za-z_][\w.]*\)`)
+	// << and <<- open a heredoc, <<< is a here-string
+	shellHeredocRegex     = regexp.MustCompile(`(?:^|[^<])<<-?\s*['"]?(\w+)['"]?`)
+	shellAssignmentRegex  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*=`)
+	shellCommandNameRegex = regexp.MustCompile(`^[A-Za-z0-9][\w.+-]*$`)
+)
+
+// shellKeywords are reserved words that can precede a command
+var shellKeywords = map[string]bool{
+	"if": true, "then": true, "else": true, "elif": true, "fi": true, "for": true, "while": true,
+	"until": true, "do": true, "done": true, "case": true, "esac": true, "in": true, "function": true,
+	"select": true, "!": true, "{": true, "}": true,
+}
+
+// shellWrappers run the command given as their arguments
+var shellWrappers = map[string]bool{
+	"sudo": true, "env": true, "exec": true, "time": true, "nohup": true, "command": true, "npx": true,
+	"nice": true, "timeout": true,
+}
+
+// shellWrapperOptionArguments lists the wrapper options taking a separate argument (sudo -u deploy)
+var shellWrapperOptionArguments = map[string]map[string]bool{
+	"sudo":    {"-u": true, "-g": true, "-C": true, "-D": true, "-h": true, "-p": true, "-r": true, "-t": true, "-U": true, "-T": true},
+	"env":     {"-u": true, "-C": true, "-S": true},
+	"nice":    {"-n": true},
+	"timeout": {"-s": true, "-k": true},
+	"exec":    {"-a": true},
+}
+
+// shellWrapperOperands is the number of operands preceding the command of a wrapper (timeout <duration>)
+var shellWrapperOperands = map[string]int{
+	"timeout": 1,
+}
+
+// ExtractCommands returns the distinct commands invoked by a shell script, in order of appearance
+// Quoting, heredocs, line continuations and command substitutions are handled, everything else is
+// a best effort: variables are never expanded and functions are not resolved
+func (p *ShellParser) ExtractCommands(script string) []ShellCommand {
+	script = strings.ReplaceAll(script, "\\\r\n", " ")
+	script = strings.ReplaceAll(script, "\\\n", " ")
+	script = shellTemplateExpressionRegex.ReplaceAllString(script, "$EXPR")
+
+	var commands []ShellCommand
+	for _, tokens := range splitSimpleCommands(stripHeredocs(script)) {
+		command, ok := shellCommandFromTokens(tokens)
+		if !ok {
+			continue
+		}
+		if !containsCommand(commands, command) {
+			commands = append(commands, command)
+		}
+	}
+	return commands
+}
+
+// ParseMakefileRecipes returns the recipe lines of a Makefile as a shell script
+func (p *ShellParser) ParseMakefileRecipes(content string) string {
+	var recipes []string
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.HasPrefix(line, "\t") {
+			continue
+		}
+		recipe := strings.TrimLeft(strings.TrimSpace(line), "@-+")
+		// $$ escapes a shell variable, $(VAR) and ${VAR} are make variables
+		recipe = strings.ReplaceAll(recipe, "$$", "$")
+		recipes = append(recipes, recipe)
+	}
+	return strings.Join(recipes, "\n")
+}
+
+// stripHeredocs removes the bodies of heredocs (e.g. SQL passed to psql)
+func stripHeredocs(script string) string {
+	lines := strings.Split(script, "\n")
+	var result []string
+	for i := 0; i < len(lines); i++ {
+		result = append(result, lines[i])
+		match := shellHeredocRegex.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+		for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != match[1] {
+			i++
+		}
+		i++ // Skip the delimiter line
+	}
+	return strings.Join(result, "\n")
+}
+
+// splitSimpleCommands tokenizes a script and splits it on operators, newlines and substitutions
+func splitSimpleCommands(script string) [][]string {
+	var commands [][]string
+	var tokens []string
+	var token strings.Builder
+	inToken := false
+
+	flushToken := func() {
+		if inToken {
+			tokens = append(tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+	flushCommand := func() {
+		flushToken()
+		if len(tokens) > 0 {
+			commands = append(commands, tokens)
+			tokens = nil
+		}
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '\'':
+			// Single quotes are literal
+			end := strings.IndexByte(script[i+1:], '\'')
+			if end < 0 {
+				end = len(script) - i - 1
+			}
+			token.WriteString(script[i+1 : i+1+end])
+			inToken = true
+			i += end + 1
+		case c == '"':
+			end := i + 1
+			for end < len(script) && script[end] != '"' {
+				if script[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end > len(script) {
+				end = len(script)
+			}
+			token.WriteString(script[i+1 : end])
+			inToken = true
+			i = end
+		case c == '#' && !inToken:
+			// Comment until end of line
+			for i+1 < len(script) && script[i+1] != '\n' {
+				i++
+			}
+		case c == '$' && i+1 < len(script) && script[i+1] == '(':
+			// Command substitution starts a new command
+			flushCommand()
+			i++
+		case c == '\n' || c == ';' || c == '|' || c == '&' || c == '(' || c == ')' || c == '`':
+			flushCommand()
+		case c == ' ' || c == '\t' || c == '\r':
+			flushToken()
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+	flushCommand()
+
+	return commands
+}
+
+// shellCommandFromTokens returns the program invoked by a simple command
+func shellCommandFromTokens(tokens []string) (ShellCommand, bool) {
+	i := 0
+	for i < len(tokens) {
+		token := tokens[i]
+		switch {
+		case token == "for" || token == "select" || token == "case":
+			// The loop variable and word list (or case subject) are not commands
+			return ShellCommand{}, false
+		case shellKeywords[token], shellAssignmentRegex.MatchString(token):
+			i++
+		case shellWrappers[token]:
+			i++
+			// Skip options of the wrapper with their arguments (sudo -u user, env -i, nice -n 10)
+			for i < len(tokens) && strings.HasPrefix(tokens[i], "-") {
+				if shellWrapperOptionArguments[token][tokens[i]] {
+					i++
+				}
+				i++
+			}
+			// Skip operands preceding the command (timeout 30s), env assignments are skipped as assignments
+			i += shellWrapperOperands[token]
+		default:
+			name := path.Base(token)
+			if !shellCommandNameRegex.MatchString(name) {
+				return ShellCommand{}, false
+			}
+			command := ShellCommand{Name: name}
+			for _, argument := range tokens[i+1:] {
+				if strings.HasPrefix(argument, "-") {
+					continue
+				}
+				if shellCommandNameRegex.MatchString(argument) {
+					command.Subcommand = argument
+				}
+				break
+			}
+			return command, true
+		}
+	}
+	return ShellCommand{}, false
+}
+
+func containsCommand(commands []ShellCommand, command ShellCommand) bool {
+	for _, existing := range commands {
+		if existing == command {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractCommands(t *testing.T) {
+	script := `#!/bin/bash
+set -euo pipefail
+# kubectl apply -f commented.yaml
+export IMAGE=registry.example.com/app:${{ github.sha }}
+docker buildx build \
+  --platform linux/amd64,linux/arm64 \
+  -t "$IMAGE" --push .
+AWS_REGION=eu-west-1 aws ecr get-login-password | docker login --username AWS --password-stdin
+if [ -n "$KUBECONFIG" ]; then
+  sudo -E kubectl apply -f k8s/ && helm upgrade --install app ./chart
+fi
+VERSION=$(git describe --tags)
+psql "$DATABASE_URL" <<SQL
+SELECT version();
+DROP TABLE terraform;
+SQL
+echo "terraform apply; gcloud deploy"
+/usr/local/bin/terraform -chdir=infra apply -auto-approve
+`
+
+	parser := NewShellParser()
+	commands := parser.ExtractCommands(script)
+
+	assert.Equal(t, []ShellCommand{
+		{Name: "set", Subcommand: "pipefail"},
+		{Name: "export"},
+		{Name: "docker", Subcommand: "buildx"},
+		{Name: "aws", Subcommand: "ecr"},
+		{Name: "docker", Subcommand: "login"},
+		{Name: "kubectl", Subcommand: "apply"},
+		{Name: "helm", Subcommand: "upgrade"},
+		{Name: "git", Subcommand: "describe"},
+		{Name: "psql"},
+		{Name: "echo"},
+		{Name: "terraform", Subcommand: "apply"},
+	}, commands)
+}
+
+func TestExtractCommands_HereString(t *testing.T) {
+	script := `grep -q ready <<< "$STATUS"
+cat <<<EOF
+kubectl rollout status deployment/app
+`
+
+	parser := NewShellParser()
+	commands := parser.ExtractCommands(script)
+
+	assert.Equal(t, []ShellCommand{
+		{Name: "grep", Subcommand: "ready"},
+		{Name: "cat"},
+		{Name: "kubectl", Subcommand: "rollout"},
+	}, commands)
+}
+
+func TestExtractCommands_WrapperOptionArguments(t *testing.T) {
+	script := `sudo -u deploy kubectl apply -f k8s/
+sudo -E -g docker docker compose up -d
+env -u HOME NODE_ENV=production npm ci
+nice -n 10 make build
+timeout 300 terraform apply -auto-approve
+timeout -s KILL 5m helm upgrade app ./chart
+`
+
+	parser := NewShellParser()
+	commands := parser.ExtractCommands(script)
+
+	assert.Equal(t, []ShellCommand{
+		{Name: "kubectl", Subcommand: "apply"},
+		{Name: "docker", Subcommand: "compose"},
+		{Name: "npm", Subcommand: "ci"},
+		{Name: "make", Subcommand: "build"},
+		{Name: "terraform", Subcommand: "apply"},
+		{Name: "helm", Subcommand: "upgrade"},
+	}, commands)
+}
+
+func TestExtractCommands_Empty(t *testing.T) {
+	parser := NewShellParser()
+	assert.Empty(t, parser.ExtractCommands(""))
+	assert.Empty(t, parser.ExtractCommands("# only a comment\nFOO=bar\n"))
+}
+
+func TestParseMakefileRecipes(t *testing.T) {
+	content := `IMAGE ?= app
+
+.PHONY: deploy
+deploy: build
+	@kubectl apply -f k8s/
+	-helm upgrade --install $(IMAGE) ./chart
+	for f in *.sql; do psql -f $$f; done
+
+build:
+	docker buildx build -t $(IMAGE) .
+`
+
+	parser := NewShellParser()
+	script := parser.ParseMakefileRecipes(content)
+
+	assert.Equal(t, "kubectl apply -f k8s/\nhelm upgrade --install $(IMAGE) ./chart\nfor f in *.sql; do psql -f $f; done\ndocker buildx build -t $(IMAGE) .", script)
+	assert.Equal(t, []ShellCommand{
+		{Name: "kubectl", Subcommand: "apply"},
+		{Name: "helm", Subcommand: "upgrade"},
+		{Name: "psql"},
+		{Name: "docker", Subcommand: "buildx"},
+	}, parser.ExtractCommands(script))
+}
@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
@@ -9,11 +10,18 @@ import (
 )
 
 // TerraformParser handles Terraform-specific file parsing (.tf and .terraform.lock.hcl)
-type TerraformParser struct{}
+type TerraformParser struct {
+	categorizer *TerraformCategorizer
+}
 
-// NewTerraformParser creates a new Terraform parser
+// NewTerraformParser creates a new Terraform parser categorizing resources with the embedded taxonomy
 func NewTerraformParser() *TerraformParser {
-	return &TerraformParser{}
+	return NewTerraformParserWithCategorizer(DefaultTerraformCategorizer())
+}
+
+// NewTerraformParserWithCategorizer creates a new Terraform parser categorizing resources with the given categorizer
+func NewTerraformParserWithCategorizer(categorizer *TerraformCategorizer) *TerraformParser {
+	return &TerraformParser{categorizer: categorizer}
 }
 
 // TerraformProvider represents a provider in terraform.lock.hcl
@@ -37,6 +45,14 @@ type TerraformInfo struct {
 	ResourcesByProvider map[string]int `json:"resources_by_provider,omitempty"`
 	ResourcesByCategory map[string]int `json:"resources_by_category,omitempty"`
 	TotalResources      int            `json:"total_resources,omitempty"`
+
+	// Configuration from terraform {}, module and data blocks
+	RequiredVersion       string                      `json:"required_version,omitempty"`
+	Backend               string                      `json:"backend,omitempty"`
+	RequiredProviders     []TerraformRequiredProvider `json:"required_providers,omitempty"`
+	Modules               []TerraformModule           `json:"modules,omitempty"`
+	DataSourcesByProvider map[string]int              `json:"data_sources_by_provider,omitempty"`
+	TotalDataSources      int                         `json:"total_data_sources,omitempty"`
 }
 
 // ParseTerraformLock parses .terraform.lock.hcl and extracts providers
@@ -133,7 +149,7 @@ func (p *TerraformParser) ParseTerraformResources(content string) []TerraformRes
 					Type:     resourceType,
 					Name:     resourceName,
 					Provider: extractProvider(resourceType),
-					Category: categorizeResource(resourceType),
+					Category: p.categorizer.Categorize(resourceType),
 				}
 
 				resources = append(resources, resource)
@@ -155,53 +171,6 @@ func extractProvider(resourceType string) string {
 	return "unknown"
 }
 
-// categorizeResource maps resource types to categories
-func categorizeResource(resourceType string) string {
-	// Map common resource types to categories
-	categories := map[string][]string{
-		"compute": {
-			"aws_instance", "aws_autoscaling_group", "aws_launch_template",
-			"google_compute_instance", "google_compute_instance_group",
-			"azurerm_virtual_machine", "azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine",
-		},
-		"storage": {
-			"aws_s3_bucket", "aws_ebs_volume", "aws_efs_file_system",
-			"google_storage_bucket", "google_compute_disk",
-			"azurerm_storage_account", "azurerm_storage_blob", "azurerm_storage_container",
-		},
-		"database": {
-			"aws_db_instance", "aws_rds_cluster", "aws_dynamodb_table",
-			"google_sql_database_instance", "google_bigtable_instance",
-			"azurerm_sql_database", "azurerm_cosmosdb_account", "azurerm_mysql_server",
-		},
-		"networking": {
-			"aws_vpc", "aws_subnet", "aws_security_group", "aws_lb", "aws_route_table",
-			"google_compute_network", "google_compute_firewall", "google_compute_subnetwork",
-			"azurerm_virtual_network", "azurerm_network_security_group", "azurerm_subnet",
-		},
-		"container": {
-			"aws_ecs_cluster", "aws_eks_cluster", "aws_ecr_repository",
-			"google_container_cluster", "google_cloud_run_service", "google_container_registry",
-			"azurerm_kubernetes_cluster", "azurerm_container_registry", "azurerm_container_group",
-		},
-		"serverless": {
-			"aws_lambda_function", "aws_api_gateway_rest_api",
-			"google_cloudfunctions_function", "google_cloud_run_service",
-			"azurerm_function_app", "azurerm_app_service",
-		},
-	}
-
-	for category, types := range categories {
-		for _, t := range types {
-			if t == resourceType {
-				return category
-			}
-		}
-	}
-
-	return "other"
-}
-
 // AggregateTerraformResources aggregates resources into TerraformInfo
 func (p *TerraformParser) AggregateTerraformResources(resources []TerraformResource) *TerraformInfo {
 	if len(resources) == 0 {
@@ -238,3 +207,222 @@ func (p *TerraformParser) AggregateTerraformResources(resources []TerraformResou
 
 	return info
 }
+
+// TerraformModule represents a module block in a Terraform configuration
+type TerraformModule struct {
+	Name       string `json:"name"`
+	Source     string `json:"source"`
+	SourceType string `json:"source_type"` // registry, git, local, http, s3, gcs, mercurial
+	Version    string `json:"version,omitempty"`
+}
+
+// TerraformRequiredProvider represents an entry of terraform { required_providers }
+type TerraformRequiredProvider struct {
+	Name    string `json:"name"`
+	Source  string `json:"source,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// TerraformConfig represents the non-resource parts of a Terraform file
+type TerraformConfig struct {
+	RequiredVersion   string
+	Backend           string
+	RequiredProviders []TerraformRequiredProvider
+	Modules           []TerraformModule
+	DataSources       []TerraformResource
+}
+
+// IsEmpty reports whether no configuration was found
+func (c *TerraformConfig) IsEmpty() bool {
+	return c == nil || (c.RequiredVersion == "" && c.Backend == "" &&
+		len(c.RequiredProviders) == 0 && len(c.Modules) == 0 && len(c.DataSources) == 0)
+}
+
+// ParseTerraformConfig parses .tf files and extracts modules, data sources and the terraform block
+func (p *TerraformParser) ParseTerraformConfig(content string) *TerraformConfig {
+	parser := hclparse.NewParser()
+	file, diags := parser.ParseHCL([]byte(content), "config.tf")
+	if diags.HasErrors() || file.Body == nil {
+		return nil
+	}
+
+	body, _ := file.Body.Content(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "terraform"},
+			{Type: "module", LabelNames: []string{"name"}},
+			{Type: "data", LabelNames: []string{"type", "name"}},
+		},
+	})
+
+	config := &TerraformConfig{}
+
+	for _, block := range body.Blocks.OfType("terraform") {
+		p.parseTerraformBlock(block, config)
+	}
+
+	for _, block := range body.Blocks.OfType("module") {
+		attrs, _ := block.Body.JustAttributes()
+		source := stringAttribute(attrs, "source")
+		if source == "" {
+			continue
+		}
+		sourceType := classifyModuleSource(source)
+		version := stringAttribute(attrs, "version")
+		if version == "" && sourceType == "git" {
+			version = moduleSourceRef(source)
+		}
+		config.Modules = append(config.Modules, TerraformModule{
+			Name:       block.Labels[0],
+			Source:     source,
+			SourceType: sourceType,
+			Version:    version,
+		})
+	}
+
+	for _, block := range body.Blocks.OfType("data") {
+		dataType := block.Labels[0]
+		config.DataSources = append(config.DataSources, TerraformResource{
+			Type:     dataType,
+			Name:     block.Labels[1],
+			Provider: extractProvider(dataType),
+			Category: p.categorizer.Categorize(dataType),
+		})
+	}
+
+	return config
+}
+
+// parseTerraformBlock extracts required_version, required_providers and backend from a terraform block
+func (p *TerraformParser) parseTerraformBlock(block *hcl.Block, config *TerraformConfig) {
+	content, _, _ := block.Body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "required_version"},
+		},
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "required_providers"},
+			{Type: "backend", LabelNames: []string{"type"}},
+			{Type: "cloud"},
+		},
+	})
+
+	if version := stringAttribute(content.Attributes, "required_version"); version != "" {
+		config.RequiredVersion = version
+	}
+
+	for _, backend := range content.Blocks.OfType("backend") {
+		config.Backend = backend.Labels[0]
+	}
+	if len(content.Blocks.OfType("cloud")) > 0 {
+		config.Backend = "cloud"
+	}
+
+	for _, providers := range content.Blocks.OfType("required_providers") {
+		attrs, _ := providers.Body.JustAttributes()
+		for name, attr := range attrs {
+			val, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				continue
+			}
+			required := TerraformRequiredProvider{Name: name}
+			switch {
+			case val.Type() == cty.String:
+				// Legacy syntax: aws = "~> 2.0"
+				required.Version = val.AsString()
+			case val.Type().IsObjectType():
+				required.Source = ctyObjectString(val, "source")
+				required.Version = ctyObjectString(val, "version")
+			}
+			config.RequiredProviders = append(config.RequiredProviders, required)
+		}
+	}
+
+	sort.Slice(config.RequiredProviders, func(i, j int) bool {
+		return config.RequiredProviders[i].Name < config.RequiredProviders[j].Name
+	})
+}
+
+// stringAttribute returns the literal string value of an attribute, or "" if absent or not a string
+func stringAttribute(attrs hcl.Attributes, name string) string {
+	attr, exists := attrs[name]
+	if !exists {
+		return ""
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || val.Type() != cty.String {
+		return ""
+	}
+	return val.AsString()
+}
+
+// ctyObjectString returns a string attribute of a cty object value
+func ctyObjectString(val cty.Value, name string) string {
+	if !val.Type().HasAttribute(name) {
+		return ""
+	}
+	attr := val.GetAttr(name)
+	if attr.IsNull() || attr.Type() != cty.String {
+		return ""
+	}
+	return attr.AsString()
+}
+
+// classifyModuleSource determines the kind of module source
+// See https://developer.hashicorp.com/terraform/language/modules/sources
+func classifyModuleSource(source string) string {
+	switch {
+	case strings.HasPrefix(source, "./"), strings.HasPrefix(source, "../"):
+		return "local"
+	case strings.HasPrefix(source, "git::"), strings.HasPrefix(source, "git@"),
+		strings.HasPrefix(source, "github.com/"), strings.HasPrefix(source, "bitbucket.org/"):
+		return "git"
+	case strings.HasPrefix(source, "hg::"):
+		return "mercurial"
+	case strings.HasPrefix(source, "s3::"):
+		return "s3"
+	case strings.HasPrefix(source, "gcs::"):
+		return "gcs"
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		return "http"
+	default:
+		return "registry"
+	}
+}
+
+// moduleSourceRef extracts the ref query parameter from a git module source
+func moduleSourceRef(source string) string {
+	idx := strings.Index(source, "?")
+	if idx < 0 {
+		return ""
+	}
+	for _, param := range strings.Split(source[idx+1:], "&") {
+		if ref, found := strings.CutPrefix(param, "ref="); found {
+			return ref
+		}
+	}
+	return ""
+}
+
+// ApplyTerraformConfig adds configuration details to a TerraformInfo, creating it if needed
+func (p *TerraformParser) ApplyTerraformConfig(info *TerraformInfo, config *TerraformConfig) *TerraformInfo {
+	if config.IsEmpty() {
+		return info
+	}
+	if info == nil {
+		info = &TerraformInfo{}
+	}
+
+	info.RequiredVersion = config.RequiredVersion
+	info.Backend = config.Backend
+	info.RequiredProviders = config.RequiredProviders
+	info.Modules = config.Modules
+
+	if len(config.DataSources) > 0 {
+		info.DataSourcesByProvider = make(map[string]int)
+		for _, data := range config.DataSources {
+			info.DataSourcesByProvider[data.Provider]++
+		}
+		info.TotalDataSources = len(config.DataSources)
+	}
+
+	return info
+}
@@ -0,0 +1,125 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/config"
+)
+
+// TerraformCategorizer maps Terraform resource types to infrastructure categories
+// using the taxonomy from terraform_categories.yaml
+type TerraformCategorizer struct {
+	exact    map[string]string
+	patterns []terraformCategoryPattern
+	prefixes []terraformCategoryPrefix // Sorted by length, longest first
+}
+
+type terraformCategoryPattern struct {
+	regex    *regexp.Regexp
+	category string
+}
+
+type terraformCategoryPrefix struct {
+	prefix   string
+	category string
+}
+
+var (
+	defaultTerraformCategorizer     *TerraformCategorizer
+	defaultTerraformCategorizerOnce sync.Once
+)
+
+// NewTerraformCategorizer builds a categorizer from one or more taxonomies
+// Later taxonomies take precedence over earlier ones (nil entries are skipped)
+func NewTerraformCategorizer(taxonomies ...*config.TerraformCategoriesConfig) (*TerraformCategorizer, error) {
+	c := &TerraformCategorizer{exact: make(map[string]string)}
+	prefixes := make(map[string]string)
+
+	for _, taxonomy := range taxonomies {
+		if taxonomy == nil {
+			continue
+		}
+
+		// Iterate categories in a stable order so pattern evaluation is deterministic
+		names := make([]string, 0, len(taxonomy.Categories))
+		for name := range taxonomy.Categories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var patterns []terraformCategoryPattern
+		for _, name := range names {
+			category := taxonomy.Categories[name]
+			for _, resource := range category.Resources {
+				c.exact[resource] = name
+			}
+			for _, prefix := range category.Prefixes {
+				prefixes[prefix] = name
+			}
+			for _, pattern := range category.Patterns {
+				regex, err := regexp.Compile(pattern)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pattern %q in terraform category %s: %w", pattern, name, err)
+				}
+				patterns = append(patterns, terraformCategoryPattern{regex: regex, category: name})
+			}
+		}
+
+		// Patterns of later taxonomies are evaluated first
+		c.patterns = append(patterns, c.patterns...)
+	}
+
+	for prefix, category := range prefixes {
+		c.prefixes = append(c.prefixes, terraformCategoryPrefix{prefix: prefix, category: category})
+	}
+	sort.Slice(c.prefixes, func(i, j int) bool {
+		if len(c.prefixes[i].prefix) != len(c.prefixes[j].prefix) {
+			return len(c.prefixes[i].prefix) > len(c.prefixes[j].prefix)
+		}
+		return c.prefixes[i].prefix < c.prefixes[j].prefix
+	})
+
+	return c, nil
+}
+
+// Categorize returns the category of a resource type, or "other" if nothing matches
+// Matching order: exact resource names, patterns, then the longest prefix
+func (c *TerraformCategorizer) Categorize(resourceType string) string {
+	if c == nil {
+		return "other"
+	}
+	if category, exists := c.exact[resourceType]; exists {
+		return category
+	}
+
+	for _, pattern := range c.patterns {
+		if pattern.regex.MatchString(resourceType) {
+			return pattern.category
+		}
+	}
+
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(resourceType, prefix.prefix) {
+			return prefix.category
+		}
+	}
+
+	return "other"
+}
+
+// DefaultTerraformCategorizer returns the categorizer of the embedded taxonomy, built on first use.
+// Scans extending the taxonomy with .stack-analyzer.yml build their own categorizer instead
+func DefaultTerraformCategorizer() *TerraformCategorizer {
+	defaultTerraformCategorizerOnce.Do(func() {
+		taxonomy, err := config.LoadTerraformCategoriesConfig()
+		if err != nil {
+			return
+		}
+		defaultTerraformCategorizer, _ = NewTerraformCategorizer(taxonomy)
+	})
+	return defaultTerraformCategorizer
+}
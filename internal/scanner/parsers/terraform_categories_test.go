@@ -0,0 +1,144 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformCategorizer_EmbeddedTaxonomy(t *testing.T) {
+	taxonomy, err := config.LoadTerraformCategoriesConfig()
+	require.NoError(t, err)
+
+	categorizer, err := NewTerraformCategorizer(taxonomy)
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		// AWS
+		"aws_instance":                         "compute",
+		"aws_autoscaling_group":                "compute",
+		"aws_s3_bucket_versioning":             "storage",
+		"aws_db_instance":                      "database",
+		"aws_elasticache_replication_group":    "cache",
+		"aws_sqs_queue":                        "messaging",
+		"aws_cloudwatch_event_rule":            "messaging",
+		"aws_cloudwatch_log_group":             "observability",
+		"aws_iam_role_policy_attachment":       "identity",
+		"aws_secretsmanager_secret":            "secrets",
+		"aws_ssm_parameter":                    "secrets",
+		"aws_ssm_document":                     "management",
+		"aws_cloudfront_distribution":          "cdn",
+		"aws_route53_record":                   "dns",
+		"aws_route_table":                      "networking",
+		"aws_wafv2_web_acl":                    "security",
+		"aws_lambda_function":                  "serverless",
+		"aws_api_gateway_rest_api":             "api",
+		"aws_kinesis_firehose_delivery_stream": "analytics",
+		// Google
+		"google_compute_instance_template": "compute",
+		"google_compute_firewall":          "networking",
+		"google_compute_disk":              "storage",
+		"google_storage_bucket_iam_member": "identity",
+		"google_project_iam_binding":       "identity",
+		"google_pubsub_topic":              "messaging",
+		"google_bigquery_dataset":          "analytics",
+		"google_secret_manager_secret":     "secrets",
+		"google_monitoring_alert_policy":   "observability",
+		"google_container_node_pool":       "container",
+		"google_cloud_run_v2_service":      "serverless",
+		"google_project_service":           "management",
+		// Azure
+		"azurerm_linux_virtual_machine":      "compute",
+		"azurerm_virtual_network":            "networking",
+		"azurerm_network_security_group":     "networking",
+		"azurerm_servicebus_queue":           "messaging",
+		"azurerm_key_vault_secret":           "secrets",
+		"azurerm_role_assignment":            "identity",
+		"azurerm_application_insights":       "observability",
+		"azurerm_cdn_frontdoor_profile":      "cdn",
+		"azurerm_private_dns_zone":           "dns",
+		"azurerm_postgresql_flexible_server": "database",
+		"azuread_application":                "identity",
+		// Kubernetes
+		"kubernetes_deployment_v1":        "container",
+		"kubernetes_service_v1":           "networking",
+		"kubernetes_service_account_v1":   "identity",
+		"kubernetes_cluster_role_binding": "identity",
+		"kubernetes_secret":               "secrets",
+		"kubernetes_namespace":            "management",
+		// Cloudflare
+		"cloudflare_record":                        "dns",
+		"cloudflare_zone_settings_override":        "cdn",
+		"cloudflare_workers_kv_namespace":          "storage",
+		"cloudflare_worker_script":                 "serverless",
+		"cloudflare_zero_trust_access_application": "identity",
+		"cloudflare_zero_trust_tunnel_cloudflared": "networking",
+		"cloudflare_ruleset":                       "security",
+		// Datadog
+		"datadog_monitor":                  "observability",
+		"datadog_user":                     "identity",
+		"datadog_security_monitoring_rule": "security",
+		// Unknown
+		"random_password": "other",
+	}
+
+	for resourceType, expected := range tests {
+		assert.Equal(t, expected, categorizer.Categorize(resourceType), resourceType)
+	}
+}
+
+func TestTerraformCategorizer_UserOverrides(t *testing.T) {
+	base := &config.TerraformCategoriesConfig{
+		Categories: map[string]config.TerraformCategory{
+			"storage": {Prefixes: []string{"aws_s3_"}},
+		},
+	}
+	user := &config.TerraformCategoriesConfig{
+		Categories: map[string]config.TerraformCategory{
+			"compliance": {
+				Resources: []string{"aws_s3_bucket_policy"},
+				Patterns:  []string{"^aws_s3_.*_lock_"},
+			},
+			"internal": {Prefixes: []string{"mycorp_"}},
+		},
+	}
+
+	categorizer, err := NewTerraformCategorizer(base, nil, user)
+	require.NoError(t, err)
+
+	assert.Equal(t, "storage", categorizer.Categorize("aws_s3_bucket"))
+	assert.Equal(t, "compliance", categorizer.Categorize("aws_s3_bucket_policy"))
+	assert.Equal(t, "compliance", categorizer.Categorize("aws_s3_object_lock_configuration"))
+	assert.Equal(t, "internal", categorizer.Categorize("mycorp_service"))
+	assert.Equal(t, "other", categorizer.Categorize("random_id"))
+}
+
+func TestTerraformCategorizer_InvalidPattern(t *testing.T) {
+	_, err := NewTerraformCategorizer(&config.TerraformCategoriesConfig{
+		Categories: map[string]config.TerraformCategory{
+			"broken": {Patterns: []string{"("}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestTerraformParser_ResourcesByCategory(t *testing.T) {
+	parser := NewTerraformParser()
+
+	resources := parser.ParseTerraformResources(`
+resource "aws_sqs_queue" "jobs" {}
+resource "aws_sns_topic" "events" {}
+resource "aws_iam_role" "worker" {}
+resource "aws_kms_key" "data" {}
+`)
+	info := parser.AggregateTerraformResources(resources)
+	require.NotNil(t, info)
+
+	assert.Equal(t, map[string]int{
+		"messaging": 2,
+		"identity":  1,
+		"secrets":   1,
+	}, info.ResourcesByCategory)
+}
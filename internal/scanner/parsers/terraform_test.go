@@ -637,3 +637,110 @@ provider "registry.terraform.io/grafana/grafana" {
 		assert.Contains(t, providerMap, "registry.terraform.io/grafana/grafana")
 	})
 }
+
+func TestParseTerraformConfig(t *testing.T) {
+	parser := NewTerraformParser()
+
+	content := `terraform {
+  required_version = ">= 1.5"
+
+  backend "s3" {
+    bucket = "my-state"
+    key    = "prod/terraform.tfstate"
+  }
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = "~> 3.5"
+  }
+}
+
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
+
+module "network" {
+  source = "git::https://github.com/example/network.git?ref=v1.2.0"
+}
+
+module "app" {
+  source = "./modules/app"
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}
+
+data "aws_caller_identity" "current" {}
+`
+
+	config := parser.ParseTerraformConfig(content)
+	require.NotNil(t, config)
+	assert.False(t, config.IsEmpty())
+
+	assert.Equal(t, ">= 1.5", config.RequiredVersion)
+	assert.Equal(t, "s3", config.Backend)
+	assert.Equal(t, []TerraformRequiredProvider{
+		{Name: "aws", Source: "hashicorp/aws", Version: "~> 5.0"},
+		{Name: "random", Version: "~> 3.5"},
+	}, config.RequiredProviders)
+
+	assert.Equal(t, []TerraformModule{
+		{Name: "vpc", Source: "terraform-aws-modules/vpc/aws", SourceType: "registry", Version: "5.0.0"},
+		{Name: "network", Source: "git::https://github.com/example/network.git?ref=v1.2.0", SourceType: "git", Version: "v1.2.0"},
+		{Name: "app", Source: "./modules/app", SourceType: "local"},
+	}, config.Modules)
+
+	require.Len(t, config.DataSources, 2)
+	assert.Equal(t, "aws_ami", config.DataSources[0].Type)
+	assert.Equal(t, "aws", config.DataSources[0].Provider)
+
+	info := parser.ApplyTerraformConfig(nil, config)
+	require.NotNil(t, info)
+	assert.Equal(t, "s3", info.Backend)
+	assert.Equal(t, 2, info.TotalDataSources)
+	assert.Equal(t, map[string]int{"aws": 2}, info.DataSourcesByProvider)
+	assert.Len(t, info.Modules, 3)
+}
+
+func TestParseTerraformConfig_CloudAndEmpty(t *testing.T) {
+	parser := NewTerraformParser()
+
+	config := parser.ParseTerraformConfig(`terraform {
+  cloud {
+    organization = "example"
+  }
+}`)
+	require.NotNil(t, config)
+	assert.Equal(t, "cloud", config.Backend)
+
+	config = parser.ParseTerraformConfig(`resource "aws_instance" "web" {}`)
+	assert.True(t, config.IsEmpty())
+	assert.Nil(t, parser.ApplyTerraformConfig(nil, config))
+
+	assert.Nil(t, parser.ParseTerraformConfig(`module "broken" {`))
+}
+
+func TestClassifyModuleSource(t *testing.T) {
+	tests := map[string]string{
+		"./modules/vpc":                        "local",
+		"../shared":                            "local",
+		"hashicorp/consul/aws":                 "registry",
+		"app.terraform.io/example/vpc/aws":     "registry",
+		"github.com/hashicorp/example":         "git",
+		"git@github.com:hashicorp/example.git": "git",
+		"git::https://example.com/vpc.git":     "git",
+		"hg::http://example.com/vpc.hg":        "mercurial",
+		"s3::https://s3.amazonaws.com/b/vpc":   "s3",
+		"gcs::https://www.googleapis.com/b/m":  "gcs",
+		"https://example.com/vpc-module.zip":   "http",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, classifyModuleSource(source), source)
+	}
+}
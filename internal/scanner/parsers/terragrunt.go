@@ -0,0 +1,156 @@
+package parsers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerragruntParser handles Terragrunt configuration parsing (terragrunt.hcl)
+type TerragruntParser struct{}
+
+// NewTerragruntParser creates a new Terragrunt parser
+func NewTerragruntParser() *TerragruntParser {
+	return &TerragruntParser{}
+}
+
+// TerragruntInclude represents an include block
+type TerragruntInclude struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path"`
+}
+
+// TerragruntDependency represents a dependency block or an entry of dependencies { paths }
+type TerragruntDependency struct {
+	Name       string `json:"name,omitempty"`
+	ConfigPath string `json:"config_path"`
+}
+
+// TerragruntConfig represents parsed information from a terragrunt.hcl file
+type TerragruntConfig struct {
+	File          string                 `json:"file,omitempty"`
+	Source        string                 `json:"source,omitempty"`
+	SourceType    string                 `json:"source_type,omitempty"`
+	SourceVersion string                 `json:"source_version,omitempty"`
+	Includes      []TerragruntInclude    `json:"includes,omitempty"`
+	Dependencies  []TerragruntDependency `json:"dependencies,omitempty"`
+	RemoteState   string                 `json:"remote_state,omitempty"` // Backend of remote_state, e.g. "s3"
+}
+
+// IsUnit reports whether the configuration deploys a module (as opposed to a shared root configuration)
+func (c *TerragruntConfig) IsUnit() bool {
+	return c.Source != "" || len(c.Dependencies) > 0
+}
+
+// ParseTerragrunt parses terragrunt.hcl content
+// Expressions that cannot be evaluated statically (functions, locals, interpolation) are kept as source text
+func (p *TerragruntParser) ParseTerragrunt(content string) *TerragruntConfig {
+	file, diags := hclsyntax.ParseConfig([]byte(content), "terragrunt.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	config := &TerragruntConfig{}
+	src := []byte(content)
+
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "terraform":
+			if attr, exists := block.Body.Attributes["source"]; exists {
+				config.Source = expressionText(attr.Expr, src)
+			}
+		case "include":
+			include := TerragruntInclude{}
+			if len(block.Labels) > 0 {
+				include.Name = block.Labels[0]
+			}
+			if attr, exists := block.Body.Attributes["path"]; exists {
+				include.Path = expressionText(attr.Expr, src)
+			}
+			config.Includes = append(config.Includes, include)
+		case "dependency":
+			if len(block.Labels) == 0 {
+				continue
+			}
+			if attr, exists := block.Body.Attributes["config_path"]; exists {
+				config.Dependencies = append(config.Dependencies, TerragruntDependency{
+					Name:       block.Labels[0],
+					ConfigPath: expressionText(attr.Expr, src),
+				})
+			}
+		case "dependencies":
+			if attr, exists := block.Body.Attributes["paths"]; exists {
+				for _, path := range expressionStrings(attr.Expr, src) {
+					config.Dependencies = append(config.Dependencies, TerragruntDependency{ConfigPath: path})
+				}
+			}
+		case "remote_state":
+			if attr, exists := block.Body.Attributes["backend"]; exists {
+				config.RemoteState = expressionText(attr.Expr, src)
+			}
+		}
+	}
+
+	if config.Source != "" {
+		config.SourceType = classifyTerragruntSource(config.Source)
+		config.SourceVersion = terragruntSourceVersion(config.Source)
+	}
+
+	return config
+}
+
+// classifyTerragruntSource classifies a terraform source, including Terragrunt's tfr:// registry scheme
+func classifyTerragruntSource(source string) string {
+	if strings.HasPrefix(source, "tfr://") {
+		return "registry"
+	}
+	return classifyModuleSource(source)
+}
+
+// terragruntSourceVersion extracts the ref (git) or version (tfr) query parameter of a source
+func terragruntSourceVersion(source string) string {
+	if ref := moduleSourceRef(source); ref != "" {
+		return ref
+	}
+	idx := strings.Index(source, "?")
+	if idx < 0 {
+		return ""
+	}
+	for _, param := range strings.Split(source[idx+1:], "&") {
+		if version, found := strings.CutPrefix(param, "version="); found {
+			return version
+		}
+	}
+	return ""
+}
+
+// expressionText returns the string value of a static expression, or its source text otherwise
+func expressionText(expr hclsyntax.Expression, src []byte) string {
+	if val, diags := expr.Value(nil); !diags.HasErrors() && !val.IsNull() && val.Type() == cty.String {
+		return val.AsString()
+	}
+	rng := expr.Range()
+	text := strings.TrimSpace(string(src[rng.Start.Byte:rng.End.Byte]))
+	return strings.Trim(text, `"`)
+}
+
+// expressionStrings returns the elements of a list expression as strings
+func expressionStrings(expr hclsyntax.Expression, src []byte) []string {
+	tuple, ok := expr.(*hclsyntax.TupleConsExpr)
+	if !ok {
+		return []string{expressionText(expr, src)}
+	}
+
+	values := make([]string, 0, len(tuple.Exprs))
+	for _, item := range tuple.Exprs {
+		values = append(values, expressionText(item, src))
+	}
+	return values
+}
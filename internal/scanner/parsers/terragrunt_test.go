@@ -0,0 +1,119 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTerragrunt_Unit(t *testing.T) {
+	parser := NewTerragruntParser()
+
+	content := `include "root" {
+  path = find_in_parent_folders()
+}
+
+terraform {
+  source = "git::git@github.com:acme/infrastructure-modules.git//app?ref=v0.3.1"
+}
+
+dependency "vpc" {
+  config_path = "../vpc"
+}
+
+dependency "mysql" {
+  config_path = "../mysql"
+  mock_outputs = {
+    endpoint = "mock"
+  }
+}
+
+dependencies {
+  paths = ["../redis", "../dns"]
+}
+
+inputs = {
+  vpc_id = dependency.vpc.outputs.vpc_id
+}
+`
+
+	config := parser.ParseTerragrunt(content)
+	require.NotNil(t, config)
+	assert.True(t, config.IsUnit())
+
+	assert.Equal(t, "git::git@github.com:acme/infrastructure-modules.git//app?ref=v0.3.1", config.Source)
+	assert.Equal(t, "git", config.SourceType)
+	assert.Equal(t, "v0.3.1", config.SourceVersion)
+
+	assert.Equal(t, []TerragruntInclude{{Name: "root", Path: "find_in_parent_folders()"}}, config.Includes)
+	assert.Equal(t, []TerragruntDependency{
+		{Name: "vpc", ConfigPath: "../vpc"},
+		{Name: "mysql", ConfigPath: "../mysql"},
+		{ConfigPath: "../redis"},
+		{ConfigPath: "../dns"},
+	}, config.Dependencies)
+}
+
+func TestParseTerragrunt_RootConfig(t *testing.T) {
+	parser := NewTerragruntParser()
+
+	content := `remote_state {
+  backend = "s3"
+  config = {
+    bucket = "acme-terraform-state"
+    key    = "${path_relative_to_include()}/terraform.tfstate"
+  }
+}
+
+generate "provider" {
+  path      = "provider.tf"
+  if_exists = "overwrite"
+  contents  = "provider \"aws\" {}"
+}
+`
+
+	config := parser.ParseTerragrunt(content)
+	require.NotNil(t, config)
+	assert.False(t, config.IsUnit())
+	assert.Equal(t, "s3", config.RemoteState)
+}
+
+func TestParseTerragrunt_Sources(t *testing.T) {
+	parser := NewTerragruntParser()
+
+	tests := []struct {
+		source      string
+		sourceType  string
+		version     string
+		interpolate bool
+	}{
+		{source: "tfr:///terraform-aws-modules/vpc/aws?version=5.1.0", sourceType: "registry", version: "5.1.0"},
+		{source: "../../modules//vpc", sourceType: "local"},
+		{source: "github.com/acme/modules//vpc?ref=main", sourceType: "git", version: "main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			config := parser.ParseTerragrunt(`terraform {
+  source = "` + tt.source + `"
+}`)
+			require.NotNil(t, config)
+			assert.Equal(t, tt.source, config.Source)
+			assert.Equal(t, tt.sourceType, config.SourceType)
+			assert.Equal(t, tt.version, config.SourceVersion)
+		})
+	}
+
+	// Interpolated sources are kept as written
+	config := parser.ParseTerragrunt(`terraform {
+  source = "${get_repo_root()}/modules/vpc"
+}`)
+	require.NotNil(t, config)
+	assert.Equal(t, "${get_repo_root()}/modules/vpc", config.Source)
+}
+
+func TestParseTerragrunt_InvalidHCL(t *testing.T) {
+	parser := NewTerragruntParser()
+	assert.Nil(t, parser.ParseTerragrunt(`terraform {`))
+}
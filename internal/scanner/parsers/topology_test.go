@@ -0,0 +1,110 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServiceRefs(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		value    string
+		expected []types.ServiceRef
+	}{
+		{
+			name:     "database URL",
+			key:      "DATABASE_URL",
+			value:    "postgres://app:s3cr3t@db:5432/orders?sslmode=disable",
+			expected: []types.ServiceRef{{Host: "db", Tech: "postgresql", Read: true, Write: true}},
+		},
+		{
+			name:     "read replica URL",
+			key:      "DATABASE_REPLICA_URL",
+			value:    "postgres://app@db-replica:5432/orders",
+			expected: []types.ServiceRef{{Host: "db-replica", Tech: "postgresql", Read: true}},
+		},
+		{
+			name:     "read-only session",
+			key:      "spring.datasource.url",
+			value:    "jdbc:postgresql://pg:5432/orders?targetServerType=preferSecondary",
+			expected: []types.ServiceRef{{Host: "pg", Tech: "postgresql", Read: true}},
+		},
+		{
+			name:     "reader endpoint",
+			key:      "DB_URL",
+			value:    "mysql://orders.cluster-ro-abc123.eu-west-1.rds.amazonaws.com:3306/orders",
+			expected: []types.ServiceRef{{Host: "orders.cluster-ro-abc123.eu-west-1.rds.amazonaws.com", Tech: "mysql", Read: true}},
+		},
+		{
+			name:     "Kubernetes Service DNS name",
+			key:      "REDIS_URL",
+			value:    "redis://cache.platform.svc.cluster.local:6379/0",
+			expected: []types.ServiceRef{{Host: "cache", Tech: "redis", Read: true, Write: true}},
+		},
+		{
+			name:     "ADO.NET connection string",
+			key:      "ConnectionStrings:ReadOnly",
+			value:    "Server=tcp:sql.data.svc,1433;Database=orders;ApplicationIntent=ReadOnly;User Id=app;Password=x",
+			expected: []types.ServiceRef{{Host: "sql", Tech: "mssql", Read: true}},
+		},
+		{
+			name:     "StackExchange.Redis connection string",
+			key:      "ConnectionStrings:Redis",
+			value:    "redis:6379,password=secret,ssl=False",
+			expected: []types.ServiceRef{{Host: "redis", Tech: "redis"}},
+		},
+		{
+			name:  "bootstrap servers",
+			key:   "KAFKA_BOOTSTRAP_SERVERS",
+			value: "kafka-1:9092,kafka-2:9092",
+			expected: []types.ServiceRef{
+				{Host: "kafka-1", Tech: "apache_kafka"},
+				{Host: "kafka-2", Tech: "apache_kafka"},
+			},
+		},
+		{
+			name:     "host name key",
+			key:      "spring.data.redis.host",
+			value:    "redis",
+			expected: []types.ServiceRef{{Host: "redis", Tech: "redis"}},
+		},
+		{
+			name:     "consumer group",
+			key:      "spring.kafka.consumer.group-id",
+			value:    "orders",
+			expected: []types.ServiceRef{{Tech: "apache_kafka", Read: true}},
+		},
+		{
+			name:     "producer",
+			key:      "KAFKA_PRODUCER_ACKS",
+			value:    "all",
+			expected: []types.ServiceRef{{Tech: "apache_kafka", Write: true}},
+		},
+		{
+			name:     "service URL",
+			key:      "ORDERS_API_URL",
+			value:    "http://orders:8080/api",
+			expected: []types.ServiceRef{{Host: "orders"}},
+		},
+		{name: "localhost", key: "DATABASE_URL", value: "postgres://app@localhost:5432/orders"},
+		{name: "IP address", key: "REDIS_HOST", value: "10.0.0.12"},
+		{name: "placeholder", key: "DATABASE_URL", value: "postgres://app@${DB_HOST}:5432/orders"},
+		{name: "plain value", key: "LOG_LEVEL", value: "debug"},
+		{name: "tech without access", key: "KAFKA_CLIENT_ID", value: "orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseServiceRefs(tt.key, tt.value))
+		})
+	}
+}
+
+func TestTokenizeKey(t *testing.T) {
+	assert.Equal(t, []string{"connection", "strings", "read", "replica"}, tokenizeKey("ConnectionStrings:ReadReplica"))
+	assert.Equal(t, []string{"spring", "kafka", "bootstrap", "servers"}, tokenizeKey("spring.kafka.bootstrap-servers"))
+	assert.Equal(t, []string{"db", "ro", "host"}, tokenizeKey("DB_RO_HOST"))
+}
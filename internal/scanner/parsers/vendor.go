@@ -0,0 +1,129 @@
+package parsers
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// VendorParser extracts third-party code copied into a repository: Go modules listed in vendor/modules.txt and
+// JavaScript/CSS libraries identified by their license banners
+type VendorParser struct{}
+
+// NewVendorParser creates a new vendor parser
+func NewVendorParser() *VendorParser {
+	return &VendorParser{}
+}
+
+// VendoredDependency represents a third-party module or library whose code is part of the repository
+type VendoredDependency struct {
+	File     string `json:"file"`
+	Type     string `json:"type"` // Dependency type: golang, npm
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"` // Version of the vendored code (the replacement version for replaced modules)
+	Replace  string `json:"replace,omitempty"` // Replacement module path or directory
+	Indirect bool   `json:"indirect,omitempty"`
+}
+
+// libraryBanner identifies a library by the comment banner of its distribution files
+type libraryBanner struct {
+	name  string // npm package name
+	regex *regexp.Regexp
+}
+
+// libraryBanners are checked in order, more specific banners (jQuery UI) before generic ones (jQuery)
+var libraryBanners = []libraryBanner{
+	{"jquery-ui", regexp.MustCompile(`jQuery UI - v(\d+\.\d+\.\d+)`)},
+	{"jquery", regexp.MustCompile(`jQuery (?:JavaScript Library )?v(\d+\.\d+\.\d+)`)},
+	{"bootstrap", regexp.MustCompile(`Bootstrap v(\d+\.\d+\.\d+)`)},
+	{"@fortawesome/fontawesome-free", regexp.MustCompile(`Font Awesome Free (\d+\.\d+\.\d+)`)},
+	{"@popperjs/core", regexp.MustCompile(`@popperjs/core v(\d+\.\d+\.\d+)`)},
+	{"vue", regexp.MustCompile(`Vue\.js v(\d+\.\d+\.\d+)`)},
+	{"react", regexp.MustCompile(`@license React v(\d+\.\d+\.\d+)`)},
+	{"angular", regexp.MustCompile(`@license AngularJS v(\d+\.\d+\.\d+)`)},
+	{"d3", regexp.MustCompile(`https://d3js\.org v(\d+\.\d+\.\d+)`)},
+	{"chart.js", regexp.MustCompile(`Chart\.js v(\d+\.\d+\.\d+)`)},
+	{"moment", regexp.MustCompile(`//! moment\.js\s+//! version : (\d+\.\d+\.\d+)`)},
+	{"lodash", regexp.MustCompile(`@license[\s*]+Lodash\b`)},
+}
+
+// maxBannerSize limits the banner search to the beginning of a file
+const maxBannerSize = 2048
+
+// DetectLibraryBanner returns the library of a JavaScript or CSS file with a known license banner, or nil
+func (p *VendorParser) DetectLibraryBanner(fileName string, content []byte) *VendoredDependency {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".js", ".mjs", ".css":
+	default:
+		return nil
+	}
+	if len(content) > maxBannerSize {
+		content = content[:maxBannerSize]
+	}
+	header := string(content)
+
+	for _, banner := range libraryBanners {
+		match := banner.regex.FindStringSubmatch(header)
+		if match == nil {
+			continue
+		}
+		library := &VendoredDependency{Type: "npm", Name: banner.name}
+		if len(match) > 1 {
+			library.Version = match[1]
+		}
+		return library
+	}
+	return nil
+}
+
+// ParseGoVendorModules parses the modules of a Go vendor/modules.txt file
+//
+//	# github.com/redis/go-redis/v9 v9.5.1
+//	## explicit; go 1.18
+//	github.com/redis/go-redis/v9
+//	# golang.org/x/net v0.24.0 => golang.org/x/net v0.23.0
+func (p *VendorParser) ParseGoVendorModules(content string) []VendoredDependency {
+	var modules []VendoredDependency
+	current := -1 // Index of the module the following annotations belong to
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "## "):
+			// Module annotations follow their module line
+			if current >= 0 {
+				explicit := false
+				for _, annotation := range strings.Split(strings.TrimPrefix(line, "## "), ";") {
+					if strings.TrimSpace(annotation) == "explicit" {
+						explicit = true
+					}
+				}
+				modules[current].Indirect = !explicit
+			}
+		case strings.HasPrefix(line, "# "):
+			module, replacement, replaced := strings.Cut(strings.TrimPrefix(line, "# "), "=>")
+			fields := strings.Fields(module)
+			// Wildcard replacements (# example.com/lib => ../lib) repeat the replace directives of go.mod
+			if len(fields) == 0 || (len(fields) == 1 && replaced) {
+				current = -1
+				continue
+			}
+			dependency := VendoredDependency{Type: "golang", Name: fields[0], Indirect: true}
+			if len(fields) > 1 {
+				dependency.Version = fields[1]
+			}
+			if replaced {
+				// Replacements are either another module version or a local directory
+				replaceFields := strings.Fields(replacement)
+				if len(replaceFields) > 0 {
+					dependency.Replace = replaceFields[0]
+				}
+				if len(replaceFields) > 1 {
+					dependency.Version = replaceFields[1]
+				}
+			}
+			modules = append(modules, dependency)
+			current = len(modules) - 1
+		}
+	}
+	return modules
+}
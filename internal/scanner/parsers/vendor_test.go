@@ -0,0 +1,64 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendorParser_ParseGoVendorModules(t *testing.T) {
+	content := `# github.com/redis/go-redis/v9 v9.5.1
+## explicit; go 1.18
+github.com/redis/go-redis/v9
+github.com/redis/go-redis/v9/internal
+# github.com/cespare/xxhash/v2 v2.2.0
+## go 1.11
+github.com/cespare/xxhash/v2
+# golang.org/x/net v0.24.0 => golang.org/x/net v0.23.0
+## explicit; go 1.18
+golang.org/x/net/http2
+# example.com/shared v0.0.0 => ../shared
+## explicit
+example.com/shared
+# golang.org/x/net => golang.org/x/net v0.23.0
+`
+	modules := NewVendorParser().ParseGoVendorModules(content)
+	assert.Equal(t, []VendoredDependency{
+		{Type: "golang", Name: "github.com/redis/go-redis/v9", Version: "v9.5.1"},
+		{Type: "golang", Name: "github.com/cespare/xxhash/v2", Version: "v2.2.0", Indirect: true},
+		{Type: "golang", Name: "golang.org/x/net", Version: "v0.23.0", Replace: "golang.org/x/net"},
+		{Type: "golang", Name: "example.com/shared", Version: "v0.0.0", Replace: "../shared"},
+	}, modules)
+}
+
+func TestVendorParser_DetectLibraryBanner(t *testing.T) {
+	parser := NewVendorParser()
+
+	tests := []struct {
+		fileName string
+		content  string
+		name     string
+		version  string
+	}{
+		{"lib.js", "/*! jQuery v3.6.0 | (c) OpenJS Foundation and other contributors | jquery.org/license */\n!function(e,t){}", "jquery", "3.6.0"},
+		{"jquery-ui.min.js", "/*! jQuery UI - v1.13.2 - 2022-07-14\n* http://jqueryui.com */", "jquery-ui", "1.13.2"},
+		{"bootstrap.min.css", "/*!\n * Bootstrap v5.3.2 (https://getbootstrap.com/)\n */", "bootstrap", "5.3.2"},
+		{"vue.js", "/*!\n * Vue.js v2.7.16\n * (c) 2014-2023 Evan You\n */", "vue", "2.7.16"},
+		{"moment.js", "//! moment.js\n//! version : 2.29.4\n//! authors : Tim Wood", "moment", "2.29.4"},
+		{"lodash.min.js", "/**\n * @license\n * Lodash lodash.com/license | Underscore.js 1.8.3 */", "lodash", ""},
+		{"app.js", "import { h } from 'vue';\n", "", ""},
+		{"README.md", "jQuery v3.6.0", "", ""},
+	}
+	for _, tt := range tests {
+		library := parser.DetectLibraryBanner(tt.fileName, []byte(tt.content))
+		if tt.name == "" {
+			assert.Nil(t, library, tt.fileName)
+			continue
+		}
+		require.NotNil(t, library, tt.fileName)
+		assert.Equal(t, "npm", library.Type)
+		assert.Equal(t, tt.name, library.Name)
+		assert.Equal(t, tt.version, library.Version)
+	}
+}
@@ -0,0 +1,111 @@
+package parsers
+
+import "gopkg.in/yaml.v3"
+
+// parseYAMLMapping returns the top-level mapping of a YAML document, or nil
+func parseYAMLMapping(content string) *yaml.Node {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil || len(root.Content) == 0 {
+		return nil
+	}
+	if root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	return root.Content[0]
+}
+
+// parseYAMLSequence parses content whose top-level node is a sequence, returns nil otherwise
+func parseYAMLSequence(content string) *yaml.Node {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil || len(root.Content) == 0 {
+		return nil
+	}
+	if root.Content[0].Kind != yaml.SequenceNode {
+		return nil
+	}
+	return root.Content[0]
+}
+
+// yamlMappingValue returns the value node of a key in a mapping node, or nil
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlScalar returns the scalar value of a key in a mapping node, or ""
+func yamlScalar(node *yaml.Node, key string) string {
+	value := yamlMappingValue(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+// yamlSequence returns the items of a sequence node, or nil
+func yamlSequence(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+// yamlScalars returns the scalar items of a sequence node
+func yamlScalars(node *yaml.Node) []string {
+	var values []string
+	for _, item := range yamlSequence(node) {
+		if item.Kind == yaml.ScalarNode && item.Value != "" {
+			values = append(values, item.Value)
+		}
+	}
+	return values
+}
+
+// scalarValues returns the value of a scalar node or the scalar values of a sequence node, mapping items
+// contributing their Name (CloudFormation Transform)
+func scalarValues(node *yaml.Node) []string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []string{node.Value}
+	case yaml.SequenceNode:
+		var values []string
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode {
+				values = append(values, item.Value)
+			} else if item.Kind == yaml.MappingNode {
+				// Transform: [{Name: AWS::Include, Parameters: {...}}]
+				for j := 0; j+1 < len(item.Content); j += 2 {
+					if item.Content[j].Value == "Name" {
+						values = append(values, item.Content[j+1].Value)
+					}
+				}
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// appendUnique appends value to values unless already present
+func appendUnique(values []string, value string) []string {
+	if containsString(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,18 +20,37 @@ import (
 	"github.com/petrarca/tech-stack-analyzer/internal/spec"
 
 	// Import component detectors to trigger init() registration
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/ansible"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/api"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/appconfig"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/azurepipelines"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/bitbucketpipelines"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/circleci"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/cloudformation"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/datastore"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/delphi"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/deno"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/docker"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/dotnet"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/githubactions"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/gitlabci"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/golang"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/java"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/jenkins"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/kubernetes"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/migrations"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/monorepo"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/nodejs"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/notebook"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/php"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/pulumi"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/python"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/ruby"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/rust"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/serverless"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/shell"
 	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/terraform"
+	_ "github.com/petrarca/tech-stack-analyzer/internal/scanner/components/terragrunt"
 	"github.com/petrarca/tech-stack-analyzer/internal/types"
 )
 
@@ -47,6 +67,8 @@ type Scanner struct {
 	excludeDirs     []string
 	progress        *progress.Progress
 	codeStats       CodeStatsAnalyzer
+	importMatcher   *ImportMatcher // nil unless import scanning is enabled
+	vendorParser    *parsers.VendorParser
 }
 
 // CodeStatsAnalyzer interface for code statistics collection
@@ -64,16 +86,16 @@ var defaultIgnorePatterns []string
 
 // NewScanner creates a new scanner (mirroring TypeScript's analyser function)
 func NewScanner(path string) (*Scanner, error) {
-	return NewScannerWithOptions(path, nil, false, false, false, false, nil)
+	return NewScannerWithOptions(path, nil, false, false, false, false, nil, false)
 }
 
 // NewScannerWithExcludes creates a new scanner with directory exclusions
 func NewScannerWithExcludes(path string, excludeDirs []string, verbose bool, useTreeView bool, traceTimings bool, traceRules bool) (*Scanner, error) {
-	return NewScannerWithOptions(path, excludeDirs, verbose, useTreeView, traceTimings, traceRules, nil)
+	return NewScannerWithOptions(path, excludeDirs, verbose, useTreeView, traceTimings, traceRules, nil, false)
 }
 
-// NewScannerWithOptions creates a new scanner with all options including code stats
-func NewScannerWithOptions(path string, excludeDirs []string, verbose bool, useTreeView bool, traceTimings bool, traceRules bool, codeStats CodeStatsAnalyzer) (*Scanner, error) {
+// NewScannerWithOptions creates a new scanner with all options including code stats and import scanning
+func NewScannerWithOptions(path string, excludeDirs []string, verbose bool, useTreeView bool, traceTimings bool, traceRules bool, codeStats CodeStatsAnalyzer, scanImports bool) (*Scanner, error) {
 	// Create provider for the target path (like TypeScript's FSProvider)
 	provider := provider.NewFSProvider(path)
 
@@ -103,6 +125,12 @@ func NewScannerWithOptions(path string, excludeDirs []string, verbose bool, useT
 		prog.EnableRuleTracing()
 	}
 
+	// Match imports of source files against dependency rules if requested
+	var importMatcher *ImportMatcher
+	if scanImports {
+		importMatcher = NewImportMatcher(components.rules, components.depDetector)
+	}
+
 	return &Scanner{
 		provider:        provider,
 		rules:           components.rules,
@@ -115,6 +143,8 @@ func NewScannerWithOptions(path string, excludeDirs []string, verbose bool, useT
 		excludeDirs:     excludeDirs,
 		progress:        prog,
 		codeStats:       codeStats,
+		importMatcher:   importMatcher,
+		vendorParser:    parsers.NewVendorParser(),
 	}, nil
 }
 
@@ -191,6 +221,13 @@ func (s *Scanner) Scan() (*types.Payload, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
+	// Apply Terraform resource categories from config on top of the embedded taxonomy
+	categorizer, err := newTerraformCategorizer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.depDetector.terraformCategorizer = categorizer
+
 	// Create scan metadata
 	scanMeta := metadata.NewScanMetadata(basePath, spec.Version, s.excludeDirs)
 	startTime := time.Now()
@@ -209,6 +246,12 @@ func (s *Scanner) Scan() (*types.Payload, error) {
 		return nil, err
 	}
 
+	// Link components that reference each other by directory (e.g. Terragrunt dependencies)
+	resolveEdgeRefs(payload)
+
+	// Link components to the services their configuration references (connection strings, host names)
+	resolveServiceRefs(payload)
+
 	// Set scan duration
 	scanMeta.SetDuration(time.Since(startTime))
 
@@ -234,6 +277,26 @@ func (s *Scanner) Scan() (*types.Payload, error) {
 	return payload, nil
 }
 
+// newTerraformCategorizer builds the Terraform categorizer of a scan from the embedded taxonomy
+// extended by terraform_categories from .stack-analyzer.yml
+func newTerraformCategorizer(cfg *config.ScanConfig) (*parsers.TerraformCategorizer, error) {
+	taxonomy, err := config.LoadTerraformCategoriesConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load terraform categories: %w", err)
+	}
+
+	var userTaxonomy *config.TerraformCategoriesConfig
+	if len(cfg.TerraformCategories) > 0 {
+		userTaxonomy = &config.TerraformCategoriesConfig{Categories: cfg.TerraformCategories}
+	}
+
+	categorizer, err := parsers.NewTerraformCategorizer(taxonomy, userTaxonomy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build terraform categories: %w", err)
+	}
+	return categorizer, nil
+}
+
 // countFilesAndComponents recursively counts files and components in the payload tree
 func (s *Scanner) countFilesAndComponents(payload *types.Payload) (int, int) {
 	fileCount := 0
@@ -331,6 +394,17 @@ func (s *Scanner) ScanFile(fileName string) (*types.Payload, error) {
 	// We just need to pass the file name
 	basePath := s.provider.GetBasePath()
 
+	// Categorize Terraform and CloudFormation resources with the taxonomy of .stack-analyzer.yml, as Scan does
+	cfg, err := config.LoadConfig(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	categorizer, err := newTerraformCategorizer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.depDetector.terraformCategorizer = categorizer
+
 	// Create a virtual file list with just the single file
 	files := []types.File{
 		{
@@ -355,6 +429,9 @@ func (s *Scanner) ScanFile(fileName string) (*types.Payload, error) {
 	if lang := s.langDetector.DetectLanguage(fileName, content); lang != "" {
 		ctx.AddLanguage(lang)
 	}
+	if s.importMatcher != nil {
+		s.addImportedTechs(ctx, fileName, content, basePath)
+	}
 
 	// Add metadata for single file scan
 	scanMeta := metadata.NewScanMetadata(basePath, spec.Version, s.excludeDirs)
@@ -405,6 +482,12 @@ func (s *Scanner) recurse(payload *types.Payload, filePath string) error {
 			if err != nil {
 				content = []byte{} // Empty content on error
 			}
+
+			// Vendored third-party code is reported as dependencies, not counted as project code
+			if s.detectVendoredFile(ctx, file.Name, content, filePath) {
+				continue
+			}
+
 			lang := s.langDetector.DetectLanguage(file.Name, content)
 			if lang != "" {
 				ctx.AddLanguage(lang)
@@ -414,6 +497,11 @@ func (s *Scanner) recurse(payload *types.Payload, filePath string) error {
 			if s.codeStats != nil {
 				s.codeStats.ProcessFile(fileFullPath, lang, content)
 			}
+
+			// Match imports against dependency rules if enabled
+			if s.importMatcher != nil {
+				s.addImportedTechs(ctx, file.Name, content, filePath)
+			}
 			continue
 		}
 
@@ -444,19 +532,16 @@ func (s *Scanner) recurse(payload *types.Payload, filePath string) error {
 func (s *Scanner) applyRules(payload *types.Payload, files []types.File, currentPath string) *types.Payload {
 	ctx := payload
 
-	// 1. Component-based detection
+	// 1. Component-based detection (includes GitHub Actions workflows)
 	ctx = s.detectComponents(payload, ctx, files, currentPath)
 
-	// 2. GitHub Actions detection
-	s.detectGitHubActions(payload, files, currentPath)
-
-	// 3. Dotenv detection
+	// 2. Dotenv detection
 	s.detectDotenv(ctx, files, currentPath)
 
-	// 4. File and extension-based detection (includes JSON schema via content matchers)
+	// 3. File and extension-based detection (includes JSON schema via content matchers)
 	matchedTechs := s.detectByFilesAndExtensions(ctx, files, currentPath)
 
-	// 6. Legacy file-based detection
+	// 4. Legacy file-based detection
 	s.detectLegacyFiles(ctx, files, matchedTechs)
 
 	return ctx
@@ -478,8 +563,14 @@ func (s *Scanner) detectComponents(payload, ctx *types.Payload, files []types.Fi
 		}
 	}
 
-	// Merge virtual components first
+	// Merge virtual components first. Endpoints declared next to a component (EXPOSE of its Dockerfile) belong
+	// to that component rather than to the parent
+	var endpoints []interface{}
 	for _, virtual := range virtualComponents {
+		if entries, exists := virtual.Properties["endpoints"].([]interface{}); exists && len(namedComponents) > 0 {
+			endpoints = append(endpoints, entries...)
+			delete(virtual.Properties, "endpoints")
+		}
 		s.mergeVirtualPayload(payload, virtual, currentPath)
 	}
 
@@ -494,6 +585,11 @@ func (s *Scanner) detectComponents(payload, ctx *types.Payload, files []types.Fi
 		merged := s.mergeComponents(namedComponents)
 		ctx = s.addNamedComponent(payload, merged, currentPath)
 	}
+	for _, endpoint := range endpoints {
+		if endpoint, ok := endpoint.(types.Endpoint); ok {
+			ctx.AddEndpoint(endpoint)
+		}
+	}
 
 	return ctx
 }
@@ -537,16 +633,40 @@ func (s *Scanner) mergeComponents(components []*types.Payload) *types.Payload {
 
 		// Merge reasons
 		base.Reason = append(base.Reason, comp.Reason...)
+
+		// Merge properties (e.g. runtime versions of package.json and pyproject.toml)
+		base.MergeProperties(comp.Properties)
+
+		// Merge pending edge references
+		for _, ref := range comp.EdgeRefs {
+			base.AddEdgeRef(ref)
+		}
+		for _, ref := range comp.ComponentRefs {
+			base.AddComponentRef(ref)
+		}
+		for _, ref := range comp.ServiceRefs {
+			base.AddServiceRef(ref)
+		}
 	}
 
 	return base
 }
 
 func (s *Scanner) mergeVirtualPayload(target, virtual *types.Payload, currentPath string) {
+	// Children may be merged into existing ones, so remember where they ended up
+	merged := make(map[*types.Payload]*types.Payload, len(virtual.Childs))
 	for _, child := range virtual.Childs {
-		target.AddChild(child)
+		merged[child] = target.AddChild(child)
 	}
 	target.Combine(virtual)
+
+	// Carry over edges declared by the virtual payload (e.g. Terraform local modules)
+	for _, edge := range virtual.Edges {
+		if existing, ok := merged[edge.Target]; ok {
+			edge.Target = existing
+		}
+		target.AddEdge(edge)
+	}
 	for _, tech := range virtual.Techs {
 		s.findImplicitComponentByTech(target, tech, currentPath, false)
 	}
@@ -566,14 +686,10 @@ func (s *Scanner) addNamedComponent(payload, component *types.Payload, currentPa
 	return component
 }
 
-func (s *Scanner) detectGitHubActions(payload *types.Payload, files []types.File, currentPath string) {
-	githubActionsComponents := s.compDetector.DetectGitHubActionsComponent(files, currentPath, s.provider.GetBasePath())
-	s.processDetectedComponent(payload, githubActionsComponents, currentPath)
-}
-
 func (s *Scanner) detectDotenv(ctx *types.Payload, files []types.File, currentPath string) {
-	dotenvPayload := s.dotenvDetector.DetectInDotEnv(files, currentPath, s.provider.GetBasePath())
-	s.processDetectedComponent(ctx, dotenvPayload, currentPath)
+	for _, dotenvPayload := range s.dotenvDetector.DetectInDotEnv(files, currentPath, s.provider.GetBasePath()) {
+		s.processDetectedComponent(ctx, dotenvPayload, currentPath)
+	}
 }
 
 // processDetectedComponent handles the common pattern of processing detected components
@@ -771,6 +887,20 @@ func (s *Scanner) findImplicitComponent(payload *types.Payload, rule types.Rule,
 	}
 }
 
+// addImportedTechs adds the techs of the modules imported by a source file to the current context
+// Implicit components are created for techs not yet known to the context, without edges
+func (s *Scanner) addImportedTechs(ctx *types.Payload, fileName string, content []byte, currentPath string) {
+	for tech, reasons := range s.importMatcher.MatchFile(fileName, content) {
+		known := slices.Contains(ctx.Techs, tech)
+		for _, reason := range reasons {
+			ctx.AddTech(tech, reason)
+		}
+		if !known {
+			s.findImplicitComponentByTech(ctx, tech, currentPath, false)
+		}
+	}
+}
+
 // addTechWithPrimaryCheck adds technology and checks if it should be primary tech
 func (s *Scanner) addTechWithPrimaryCheck(payload *types.Payload, tech string, reason string, currentPath string) {
 	// Always add to techs array
@@ -0,0 +1,134 @@
+package scanner
+
+import (
+	"slices"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// serviceLink is a pair of components linked by service references, with the access inferred from them
+type serviceLink struct {
+	source, target *types.Payload
+	read, write    bool
+	known          bool // At least one reference had a known access
+}
+
+// resolveServiceRefs turns the service references collected from configuration (Payload.ServiceRefs) into edges
+// from the referencing component to the referenced one. Host names are resolved to the component of that name
+// (compose services, Kubernetes Service names), other references to a component of their tech, see resolveTechTarget.
+// The access of an edge is the union of the known access of its references (read for replicas and consumers, write
+// for producers), read/write otherwise. Existing edges, e.g. to implicit components, take the inferred access
+func resolveServiceRefs(root *types.Payload) {
+	componentsByDir := make(map[string]*types.Payload)
+	indexComponentPaths(root, componentsByDir)
+	indexComponentDirs(root, componentsByDir)
+
+	componentsByName := make(map[string]*types.Payload)
+	for _, child := range root.Childs {
+		indexComponentNames(child, componentsByName)
+	}
+
+	componentsByTech := make(map[string][]*types.Payload)
+	indexComponentTechs(root, componentsByTech)
+
+	var links []*serviceLink
+	collectServiceLinks(root, componentsByDir, componentsByName, componentsByTech, &links)
+
+	for _, link := range links {
+		read, write := link.read, link.write
+		if !link.known {
+			read, write = true, true
+		}
+		setEdgeAccess(link.source, link.target, read, write)
+	}
+}
+
+// indexComponentTechs maps the primary techs of each component to the components
+func indexComponentTechs(payload *types.Payload, index map[string][]*types.Payload) {
+	for _, tech := range payload.Tech {
+		index[tech] = append(index[tech], payload)
+	}
+
+	for _, child := range payload.Childs {
+		indexComponentTechs(child, index)
+	}
+}
+
+// collectServiceLinks resolves the service references of a payload and its children into links, in tree order.
+// References resolved by host name come first, so that references by tech join the links of their source
+func collectServiceLinks(payload *types.Payload, dirIndex, nameIndex map[string]*types.Payload, techIndex map[string][]*types.Payload, links *[]*serviceLink) {
+	var unresolved []types.ServiceRef
+	for _, ref := range payload.ServiceRefs {
+		if target, exists := nameIndex[ref.Host]; ref.Host != "" && exists {
+			addServiceLink(links, referencingComponent(payload, ref, dirIndex), target, ref)
+		} else if ref.Tech != "" {
+			unresolved = append(unresolved, ref)
+		}
+	}
+	for _, ref := range unresolved {
+		source := referencingComponent(payload, ref, dirIndex)
+		addServiceLink(links, source, resolveTechTarget(source, ref.Tech, *links, techIndex), ref)
+	}
+
+	for _, child := range payload.Childs {
+		collectServiceLinks(child, dirIndex, nameIndex, techIndex, links)
+	}
+}
+
+// referencingComponent returns the component a service reference belongs to: the component of the directory it
+// was recorded for (compose services built from a directory), otherwise the payload holding it
+func referencingComponent(payload *types.Payload, ref types.ServiceRef, dirIndex map[string]*types.Payload) *types.Payload {
+	if component, exists := dirIndex[ref.From]; ref.From != "" && exists {
+		return component
+	}
+	return payload
+}
+
+// resolveTechTarget returns the component of a tech a source refers to: a component of that tech already linked
+// to the source, a child of that tech (implicit component) or the only component of that tech in the tree
+func resolveTechTarget(source *types.Payload, tech string, links []*serviceLink, techIndex map[string][]*types.Payload) *types.Payload {
+	for _, link := range links {
+		if link.source == source && slices.Contains(link.target.Tech, tech) {
+			return link.target
+		}
+	}
+	for _, child := range source.Childs {
+		if slices.Contains(child.Tech, tech) {
+			return child
+		}
+	}
+	if candidates := techIndex[tech]; len(candidates) == 1 {
+		return candidates[0]
+	}
+	return nil
+}
+
+// addServiceLink adds the access of a reference to the link between two components
+func addServiceLink(links *[]*serviceLink, source, target *types.Payload, ref types.ServiceRef) {
+	if target == nil || target == source {
+		return
+	}
+
+	index := slices.IndexFunc(*links, func(link *serviceLink) bool { return link.source == source && link.target == target })
+	if index < 0 {
+		*links = append(*links, &serviceLink{source: source, target: target})
+		index = len(*links) - 1
+	}
+	if link := (*links)[index]; ref.Read || ref.Write {
+		link.read = link.read || ref.Read
+		link.write = link.write || ref.Write
+		link.known = true
+	}
+}
+
+// setEdgeAccess sets the access of the edge between two components, adding the edge if needed
+func setEdgeAccess(source, target *types.Payload, read, write bool) {
+	for i := range source.Edges {
+		if source.Edges[i].Target == target {
+			source.Edges[i].Read = read
+			source.Edges[i].Write = write
+			return
+		}
+	}
+	source.AddEdge(types.Edge{Target: target, Read: read, Write: write})
+}
@@ -0,0 +1,56 @@
+package scanner
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/go-enry/go-enry/v2"
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// projectPathRegex matches paths flagged by enry's vendor heuristics that hold project files rather than
+// third-party code: CI configuration, build wrappers, caches, fixtures, build output and type declarations
+var projectPathRegex = regexp.MustCompile(`(?:^|/)(?:cache|env|dist|testdata|\.github|\.vscode|\.teamcity|\.obsidian|gradle/wrapper|\.mvn/wrapper|[Tt]ests?/fixtures|[Ss]pecs?/fixtures)/|` +
+	`(?:^|/)(?:Jenkinsfile|Vagrantfile|gradlew|gradlew\.bat|mvnw|mvnw\.cmd|configure|\.gitignore|\.gitattributes|\.gitmodules)$|\.d\.ts$`)
+
+// isVendoredPath reports whether a file (relative to the scan root, "/"-separated) is vendored third-party code
+// (vendor/, third_party/, node_modules/, ...). Only enry's directory heuristics count: its file name heuristics
+// (bootstrap*.js, *.min.js) also flag project files named after the library they customize
+func isVendoredPath(relPath string) bool {
+	if !enry.IsVendor(relPath) || projectPathRegex.MatchString(relPath) {
+		return false
+	}
+	dir := path.Dir(relPath)
+	return dir != "." && enry.IsVendor(dir+"/")
+}
+
+// detectVendoredFile reports whether a file is vendored third-party code. Libraries identified by their
+// license banner are added to the context as npm dependencies, even outside of vendor directories (static/js)
+func (s *Scanner) detectVendoredFile(ctx *types.Payload, fileName string, content []byte, currentPath string) bool {
+	relPath, err := filepath.Rel(s.provider.GetBasePath(), filepath.Join(currentPath, fileName))
+	if err != nil {
+		relPath = fileName
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	library := s.vendorParser.DetectLibraryBanner(fileName, content)
+	if library == nil {
+		return isVendoredPath(relPath)
+	}
+
+	library.File = "/" + relPath
+	ctx.MergeProperties(map[string]interface{}{"vendored": []interface{}{library}})
+	dependency := types.Dependency{Type: library.Type, Name: library.Name, Example: library.Version}
+	ctx.AddDependency(dependency)
+
+	for tech := range s.depDetector.MatchDependencies([]string{library.Name}, library.Type) {
+		known := slices.Contains(ctx.Techs, tech)
+		ctx.AddTech(tech, tech+" matched vendored file: "+relPath)
+		if !known {
+			s.findImplicitComponentByTech(ctx, tech, currentPath, false)
+		}
+	}
+	return true
+}
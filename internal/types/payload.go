@@ -23,9 +23,54 @@ type Payload struct {
 	InComponent  *Payload               `json:"inComponent"` // Added missing field
 	Licenses     []string               `json:"licenses"`    // Added missing field
 	Reason       []string               `json:"reason"`
-	Properties   map[string]interface{} `json:"properties,omitempty"` // Tech-specific metadata (Docker, Kubernetes, Terraform, etc.)
-	CodeStats    interface{}            `json:"code_stats,omitempty"` // Code statistics (LOC, comments, blanks, complexity)
-	Metadata     interface{}            `json:"metadata,omitempty"`   // Scan metadata (only in root payload)
+	Properties   map[string]interface{} `json:"properties,omitempty"`  // Tech-specific metadata (Docker, Kubernetes, Terraform, etc.)
+	CodeStats    interface{}            `json:"code_stats,omitempty"`  // Code statistics (LOC, comments, blanks, complexity)
+	EOLSummary   interface{}            `json:"eol_summary,omitempty"` // End-of-life summary (only in root payload)
+	Metadata     interface{}            `json:"metadata,omitempty"`    // Scan metadata (only in root payload)
+
+	// EdgeRefs holds directories (relative to the scan root, e.g. "/live/vpc") of components this payload
+	// depends on. They are resolved into Edges once the whole tree has been scanned.
+	EdgeRefs []string `json:"-"`
+
+	// ComponentRefs holds names of components this payload depends on (e.g. Nx implicitDependencies).
+	// Like EdgeRefs, they are resolved into Edges once the whole tree has been scanned.
+	ComponentRefs []string `json:"-"`
+
+	// ServiceRefs holds services this payload connects to according to its configuration (connection
+	// strings, host names). They are resolved into Edges with the inferred access once the whole tree has been scanned.
+	ServiceRefs []ServiceRef `json:"-"`
+}
+
+// ServiceRef is a reference to another service found in configuration. Host is the host name the service is
+// reached by (compose service or Kubernetes Service name), Tech the tech of the connection if known. Read and
+// Write hold the inferred access intent, both false when unknown. From is the directory of the referencing
+// component when the reference is not held by the component itself (compose services built from that directory).
+type ServiceRef struct {
+	Host  string
+	Tech  string
+	Read  bool
+	Write bool
+	From  string
+}
+
+// Endpoint exposure: public endpoints are reachable from outside the host or cluster (published compose ports,
+// LoadBalancer and NodePort Services, host ports), internal ones only from other containers or pods
+const (
+	EndpointPublic   = "public"
+	EndpointInternal = "internal"
+)
+
+// Endpoint is a port a component listens on, as declared by a Dockerfile, compose file, Kubernetes manifest or
+// application configuration. Port is the port inside the container or process, PublishedPort the port it is
+// reachable on from outside (compose host port, Kubernetes Service port or node port) when declared.
+type Endpoint struct {
+	Port          int    `json:"port"`
+	Protocol      string `json:"protocol"` // tcp, udp or sctp, http or https for application configuration
+	Exposure      string `json:"exposure"` // public or internal
+	Source        string `json:"source"`   // dockerfile, compose, kubernetes, spring or aspnet
+	File          string `json:"file"`
+	Service       string `json:"service,omitempty"` // Compose service, Kubernetes workload or Service name
+	PublishedPort int    `json:"published_port,omitempty"`
 }
 
 // Edge represents a relationship between components
@@ -113,7 +158,18 @@ func (p *Payload) AddChild(service *Payload) *Payload {
 		}
 
 		// Merge properties
-		exist.mergeProperties(service.Properties)
+		exist.MergeProperties(service.Properties)
+
+		// Merge pending edge references
+		for _, ref := range service.EdgeRefs {
+			exist.AddEdgeRef(ref)
+		}
+		for _, ref := range service.ComponentRefs {
+			exist.AddComponentRef(ref)
+		}
+		for _, ref := range service.ServiceRefs {
+			exist.AddServiceRef(ref)
+		}
 
 		return exist
 	}
@@ -147,7 +203,16 @@ func (p *Payload) Combine(other *Payload) {
 	p.mergeTechField(other.Tech)
 	p.mergeDependencies(other.Dependencies)
 	p.mergeLicenses(other.Licenses)
-	p.mergeProperties(other.Properties)
+	p.MergeProperties(other.Properties)
+	for _, ref := range other.EdgeRefs {
+		p.AddEdgeRef(ref)
+	}
+	for _, ref := range other.ComponentRefs {
+		p.AddComponentRef(ref)
+	}
+	for _, ref := range other.ServiceRefs {
+		p.AddServiceRef(ref)
+	}
 }
 
 // Helper functions to reduce cognitive complexity
@@ -201,7 +266,36 @@ func (p *Payload) mergeLicenses(licenses []string) {
 	}
 }
 
-func (p *Payload) mergeProperties(properties map[string]interface{}) {
+// arrayProperties are property keys holding one entry per file, which are merged as arrays
+var arrayProperties = map[string]bool{
+	"docker":              true,
+	"terraform":           true,
+	"terragrunt":          true,
+	"cloudformation":      true,
+	"ansible":             true,
+	"gitlab_ci":           true,
+	"github_actions":      true,
+	"azure_pipelines":     true,
+	"circleci":            true,
+	"bitbucket_pipelines": true,
+	"jenkins":             true,
+	"api":                 true,
+	"app_config":          true,
+	"migrations":          true,
+	"monorepo":            true,
+	"notebooks":           true,
+	"vendored":            true,
+	"datastores":          true,
+	"endpoints":           true,
+}
+
+// mapProperties are property keys holding entries by name (e.g. runtime versions), which are merged key by key
+var mapProperties = map[string]bool{
+	"runtime": true,
+}
+
+// MergeProperties merges properties into the payload
+func (p *Payload) MergeProperties(properties map[string]interface{}) {
 	if len(properties) == 0 {
 		return
 	}
@@ -209,8 +303,8 @@ func (p *Payload) mergeProperties(properties map[string]interface{}) {
 		p.Properties = make(map[string]interface{})
 	}
 	for key, value := range properties {
-		// Special handling for array properties (docker, terraform) - merge arrays
-		if key == "docker" || key == "terraform" {
+		// Special handling for array properties - merge arrays
+		if arrayProperties[key] {
 			existing, existsInP := p.Properties[key]
 			newArray, isArray := value.([]interface{})
 
@@ -226,6 +320,8 @@ func (p *Payload) mergeProperties(properties map[string]interface{}) {
 				// Just set the value
 				p.Properties[key] = value
 			}
+		} else if mapProperties[key] {
+			p.Properties[key] = mergeMapProperty(p.Properties[key], value)
 		} else {
 			// For other properties, later values override earlier ones
 			p.Properties[key] = value
@@ -233,6 +329,24 @@ func (p *Payload) mergeProperties(properties map[string]interface{}) {
 	}
 }
 
+// mergeMapProperty merges the entries of a map property, later entries override earlier ones with the same name
+func mergeMapProperty(existing, value interface{}) interface{} {
+	existingEntries, existingIsMap := existing.(map[string]interface{})
+	entries, isMap := value.(map[string]interface{})
+	if !existingIsMap || !isMap {
+		return value
+	}
+
+	merged := make(map[string]interface{}, len(existingEntries)+len(entries))
+	for name, entry := range existingEntries {
+		merged[name] = entry
+	}
+	for name, entry := range entries {
+		merged[name] = entry
+	}
+	return merged
+}
+
 func (p *Payload) containsString(slice []string, str string) bool {
 	for _, item := range slice {
 		if item == str {
@@ -343,8 +457,64 @@ func (p *Payload) AddEdges(target *Payload) {
 	p.Edges = append(p.Edges, edge)
 }
 
-// AddDependency adds a dependency
+// AddEdge adds an edge, skipping it if an edge to the same target already exists
+func (p *Payload) AddEdge(edge Edge) {
+	for _, existing := range p.Edges {
+		if existing.Target == edge.Target {
+			return
+		}
+	}
+	p.Edges = append(p.Edges, edge)
+}
+
+// AddEdgeRef records a dependency on the component in the given directory, to be resolved into an edge after scanning
+func (p *Payload) AddEdgeRef(dir string) {
+	if p.containsString(p.EdgeRefs, dir) {
+		return
+	}
+	p.EdgeRefs = append(p.EdgeRefs, dir)
+}
+
+// AddComponentRef records a dependency on the component with the given name, to be resolved into an edge after scanning
+func (p *Payload) AddComponentRef(name string) {
+	if p.containsString(p.ComponentRefs, name) {
+		return
+	}
+	p.ComponentRefs = append(p.ComponentRefs, name)
+}
+
+// AddServiceRef records a reference to another service, to be resolved into an edge after scanning
+func (p *Payload) AddServiceRef(ref ServiceRef) {
+	for _, existing := range p.ServiceRefs {
+		if existing == ref {
+			return
+		}
+	}
+	p.ServiceRefs = append(p.ServiceRefs, ref)
+}
+
+// AddEndpoint records an endpoint in the endpoints property, once
+func (p *Payload) AddEndpoint(endpoint Endpoint) {
+	existing, _ := p.Properties["endpoints"].([]interface{})
+	for _, entry := range existing {
+		if entry == endpoint {
+			return
+		}
+	}
+	if p.Properties == nil {
+		p.Properties = make(map[string]interface{})
+	}
+	p.Properties["endpoints"] = append(existing, endpoint)
+}
+
+// AddDependency adds a dependency unless it is already recorded. A dependency without version adds nothing
+// to one of the same type and name already recorded with a version
 func (p *Payload) AddDependency(dep Dependency) {
+	for _, existing := range p.Dependencies {
+		if existing.Type == dep.Type && existing.Name == dep.Name && (existing.Example == dep.Example || dep.Example == "") {
+			return
+		}
+	}
 	p.Dependencies = append(p.Dependencies, dep)
 }
 
@@ -55,6 +55,22 @@ func TestPayload_AddPrimaryTech(t *testing.T) {
 	assert.Equal(t, []string{"nodejs", "typescript"}, payload.Tech, "Should not duplicate existing tech")
 }
 
+func TestPayload_AddDependency(t *testing.T) {
+	payload := &Payload{ID: "test", Name: "Test Component"}
+
+	payload.AddDependency(Dependency{Type: "python", Name: "pandas", Example: "2.2.1"})
+	payload.AddDependency(Dependency{Type: "python", Name: "pandas", Example: "2.2.1"})
+	payload.AddDependency(Dependency{Type: "python", Name: "pandas"})
+	payload.AddDependency(Dependency{Type: "python", Name: "pandas", Example: "1.5.3"})
+	payload.AddDependency(Dependency{Type: "npm", Name: "pandas"})
+
+	assert.Equal(t, []Dependency{
+		{Type: "python", Name: "pandas", Example: "2.2.1"},
+		{Type: "python", Name: "pandas", Example: "1.5.3"},
+		{Type: "npm", Name: "pandas"},
+	}, payload.Dependencies, "Should skip duplicates and unversioned repeats of a dependency")
+}
+
 func TestPayload_HasPrimaryTech(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -359,3 +375,22 @@ func TestPayload_EdgeCases(t *testing.T) {
 		assert.NotEqual(t, "", payload.String())
 	})
 }
+
+func TestPayload_MergeProperties(t *testing.T) {
+	payload := NewPayload("app", []string{"/"})
+	payload.MergeProperties(map[string]interface{}{
+		"docker":  []interface{}{"Dockerfile"},
+		"runtime": map[string]interface{}{"node": "20", "pnpm": "9"},
+		"pulumi":  "first",
+	})
+	payload.MergeProperties(map[string]interface{}{
+		"docker":  []interface{}{"Dockerfile.dev"},
+		"runtime": map[string]interface{}{"python": "3.12", "pnpm": "9.1"},
+		"pulumi":  "second",
+	})
+
+	// Array properties are appended, map properties merged by key, other properties overridden
+	assert.Equal(t, []interface{}{"Dockerfile", "Dockerfile.dev"}, payload.Properties["docker"])
+	assert.Equal(t, map[string]interface{}{"node": "20", "pnpm": "9.1", "python": "3.12"}, payload.Properties["runtime"])
+	assert.Equal(t, "second", payload.Properties["pulumi"])
+}
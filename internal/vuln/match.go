@@ -0,0 +1,196 @@
+package vuln
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+)
+
+// dependencyEcosystems maps dependency types to OSV ecosystems
+var dependencyEcosystems = map[string]string{
+	"npm":    EcosystemNPM,
+	"python": EcosystemPyPI,
+	"maven":  EcosystemMaven,
+	"gradle": EcosystemMaven,
+	"golang": EcosystemGo,
+	"nuget":  EcosystemNuGet,
+	"rust":   EcosystemCratesIO,
+	"cargo":  EcosystemCratesIO,
+	"ruby":   EcosystemRubyGems,
+	"php":    EcosystemPackagist,
+}
+
+// severityRanks orders vulnerabilities from the most to the least severe
+var severityRanks = map[string]int{
+	SeverityCritical: 0,
+	SeverityHigh:     1,
+	SeverityMedium:   2,
+	SeverityLow:      3,
+	SeverityNone:     4,
+	SeverityUnknown:  5,
+}
+
+// Confidence of a match: exact for pinned versions, constraint for versions taken from the lower bound of
+// a declared constraint, which the installed version may exceed
+const (
+	ConfidenceExact      = "exact"
+	ConfidenceConstraint = "constraint"
+)
+
+var (
+	// Concrete versions: 1.2.3, v1.2.3, 2.0.0-rc.1, 1.0.post1, 5.3.20.RELEASE
+	concreteVersionRegex = regexp.MustCompile(`^v?\d+(?:[.\-+_]?[0-9A-Za-z]+)*$`)
+	// Operators selecting the lower bound of a constraint, longest first
+	lowerBoundOperators = []string{"===", "==", "~=", "~>", ">=", "^", "~", "="}
+	// Operators pinning an exact version
+	exactOperators = map[string]bool{"===": true, "==": true, "=": true}
+)
+
+// Vulnerability is an advisory affecting a dependency of a component
+type Vulnerability struct {
+	ID         string   `json:"id"`
+	Aliases    []string `json:"aliases,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Severity   string   `json:"severity"`
+	Score      float64  `json:"score,omitempty"` // CVSS v3 base score
+	Type       string   `json:"type"`            // Dependency type (npm, python, maven, ...)
+	Package    string   `json:"package"`
+	Version    string   `json:"version"`    // Version the dependency was matched with
+	Confidence string   `json:"confidence"` // exact or constraint
+	Fixed      []string `json:"fixed,omitempty"`
+}
+
+// Matcher annotates components with the vulnerabilities of their dependencies
+type Matcher struct {
+	db *Database
+}
+
+// NewMatcher creates a matcher for a loaded database
+func NewMatcher(db *Database) *Matcher {
+	return &Matcher{db: db}
+}
+
+// Annotate sets the vulnerabilities property of every component of the tree and returns the number of
+// vulnerabilities found for pinned versions. Matches of constraint lower bounds are reported but not counted
+func (m *Matcher) Annotate(payload *types.Payload) int {
+	vulnerabilities := m.Match(payload.Dependencies)
+	if len(vulnerabilities) > 0 {
+		if payload.Properties == nil {
+			payload.Properties = make(map[string]interface{})
+		}
+		payload.Properties["vulnerabilities"] = vulnerabilities
+	}
+
+	count := 0
+	for _, vulnerability := range vulnerabilities {
+		if vulnerability.Confidence == ConfidenceExact {
+			count++
+		}
+	}
+	for _, child := range payload.Childs {
+		count += m.Annotate(child)
+	}
+	return count
+}
+
+// Match returns the vulnerabilities affecting a list of dependencies, most severe first
+// Dependencies without a concrete version or lower bound (latest, *, 1.x) are skipped
+func (m *Matcher) Match(dependencies []types.Dependency) []Vulnerability {
+	var vulnerabilities []Vulnerability
+	seen := make(map[string]bool)
+
+	for _, dependency := range dependencies {
+		ecosystem, supported := dependencyEcosystems[dependency.Type]
+		if !supported {
+			continue
+		}
+		name, version, exact := dependencyVersion(dependency)
+		if version == "" {
+			continue
+		}
+		confidence := ConfidenceConstraint
+		if exact {
+			confidence = ConfidenceExact
+		}
+
+		for _, match := range m.db.Query(ecosystem, name, version) {
+			key := match.Advisory.ID + "|" + dependency.Type + "|" + name + "|" + version
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			severity, score := match.Advisory.severity()
+			vulnerabilities = append(vulnerabilities, Vulnerability{
+				ID:         match.Advisory.ID,
+				Aliases:    match.Advisory.Aliases,
+				Summary:    match.Advisory.Summary,
+				Severity:   severity,
+				Score:      score,
+				Type:       dependency.Type,
+				Package:    name,
+				Version:    version,
+				Confidence: confidence,
+				Fixed:      match.Fixed,
+			})
+		}
+	}
+
+	sort.SliceStable(vulnerabilities, func(i, j int) bool {
+		if rankI, rankJ := severityRanks[vulnerabilities[i].Severity], severityRanks[vulnerabilities[j].Severity]; rankI != rankJ {
+			return rankI < rankJ
+		}
+		if vulnerabilities[i].Package != vulnerabilities[j].Package {
+			return vulnerabilities[i].Package < vulnerabilities[j].Package
+		}
+		return vulnerabilities[i].ID < vulnerabilities[j].ID
+	})
+	return vulnerabilities
+}
+
+// dependencyVersion returns the package name, the version a dependency is matched with and whether that
+// version is pinned. Go dependencies carry the version in the name (module@v1.2.3). Constraints are evaluated
+// at their lower bound (^1.2.3, >=1.2, ~> 7.1.0, [1.0,2.0) use 1.2.3, 1.2, 7.1.0 and 1.0)
+func dependencyVersion(dependency types.Dependency) (string, string, bool) {
+	name, version := dependency.Name, dependency.Example
+	if dependency.Type == "golang" {
+		if at := strings.LastIndex(name, "@"); at > 0 {
+			name, version = name[:at], name[at+1:]
+		}
+	}
+
+	version = strings.Trim(strings.TrimSpace(version), `"'`)
+	version, _, alternatives := strings.Cut(version, "||")
+	version = strings.TrimSpace(version)
+	if strings.HasPrefix(version, "[") {
+		version = strings.TrimSuffix(strings.TrimPrefix(version, "["), "]")
+	}
+	version, _, bounded := strings.Cut(version, ",")
+
+	// Bare versions are pinned except in Cargo, where they are caret requirements
+	exact := dependency.Type != "rust" && dependency.Type != "cargo"
+	for _, operator := range lowerBoundOperators {
+		if strings.HasPrefix(version, operator) {
+			version = strings.TrimSpace(version[len(operator):])
+			exact = exactOperators[operator]
+			break
+		}
+	}
+	fields := strings.Fields(version)
+	if len(fields) > 0 {
+		version = fields[0]
+	}
+	exact = exact && !alternatives && !bounded && len(fields) == 1
+
+	if !concreteVersionRegex.MatchString(version) {
+		return name, "", false
+	}
+	for _, segment := range strings.Split(version, ".") {
+		if segment == "x" || segment == "X" {
+			return name, "", false
+		}
+	}
+	return name, version, exact
+}
@@ -0,0 +1,241 @@
+package vuln
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/petrarca/tech-stack-analyzer/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAdvisories = map[string]string{
+	"npm/GHSA-p6mc-m468-83gw.json": `{
+		"id": "GHSA-p6mc-m468-83gw",
+		"aliases": ["CVE-2020-8203"],
+		"summary": "Prototype Pollution in lodash",
+		"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:H/A:H"}],
+		"affected": [{
+			"package": {"ecosystem": "npm", "name": "lodash"},
+			"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.19"}]}]
+		}]
+	}`,
+	"npm/GHSA-withdrawn.json": `{
+		"id": "GHSA-withdrawn",
+		"withdrawn": "2023-01-01T00:00:00Z",
+		"affected": [{"package": {"ecosystem": "npm", "name": "lodash"}, "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}]}]}]
+	}`,
+	"Maven/GHSA-jfh8-c2jp-5v3q.json": `{
+		"id": "GHSA-jfh8-c2jp-5v3q",
+		"aliases": ["CVE-2021-44228"],
+		"summary": "Remote code injection in Log4j",
+		"database_specific": {"severity": "CRITICAL"},
+		"affected": [{
+			"package": {"ecosystem": "Maven", "name": "org.apache.logging.log4j:log4j-core"},
+			"ranges": [{"type": "ECOSYSTEM", "events": [
+				{"introduced": "2.13.0"}, {"fixed": "2.15.0"},
+				{"introduced": "2.0-beta9"}, {"fixed": "2.12.2"}
+			]}]
+		}]
+	}`,
+	"Go/GO-2022-0969.json": `{
+		"id": "GO-2022-0969",
+		"summary": "HTTP/2 server connections can hang forever waiting for a clean shutdown",
+		"affected": [{
+			"package": {"ecosystem": "Go", "name": "golang.org/x/net"},
+			"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"last_affected": "0.0.0-20220906165146-f3363e06e74c"}]}]
+		}]
+	}`,
+}
+
+var testPyPIAdvisory = `{
+	"id": "PYSEC-2023-100",
+	"aliases": ["CVE-2023-36053"],
+	"summary": "Django ReDoS in EmailValidator",
+	"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"}],
+	"affected": [{
+		"package": {"ecosystem": "PyPI", "name": "Django"},
+		"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "4.2"}, {"fixed": "4.2.3"}, {"introduced": "4.0"}, {"fixed": "4.1.10"}]}],
+		"versions": ["4.2", "4.2.1", "4.2.2"]
+	}]
+}`
+
+// writeTestDatabase writes the advisories as all.zip archives per ecosystem and one extracted JSON file
+func writeTestDatabase(t *testing.T) string {
+	dir := t.TempDir()
+
+	archives := make(map[string]*zip.Writer)
+	for name, content := range testAdvisories {
+		ecosystem, file := filepath.Split(name)
+		writer, exists := archives[ecosystem]
+		if !exists {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, ecosystem), 0755))
+			out, err := os.Create(filepath.Join(dir, ecosystem, "all.zip"))
+			require.NoError(t, err)
+			t.Cleanup(func() { out.Close() })
+			writer = zip.NewWriter(out)
+			archives[ecosystem] = writer
+		}
+		entry, err := writer.Create(file)
+		require.NoError(t, err)
+		_, err = entry.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	for _, writer := range archives {
+		require.NoError(t, writer.Close())
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "PyPI"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "PyPI", "PYSEC-2023-100.json"), []byte(testPyPIAdvisory), 0644))
+	return dir
+}
+
+func TestLoadDatabase(t *testing.T) {
+	dir := writeTestDatabase(t)
+
+	db, err := LoadDatabase(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, db.Advisories(), "withdrawn advisories are skipped")
+
+	single, err := LoadDatabase(filepath.Join(dir, "npm", "all.zip"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, single.Advisories())
+
+	_, err = LoadDatabase(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+
+	// Malformed advisories are skipped
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte(`{"id":`), 0644))
+	db, err = LoadDatabase(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, db.Advisories())
+
+	_, err = LoadDatabase(filepath.Join(dir, "broken.json"))
+	assert.Error(t, err)
+}
+
+func TestDatabase_Query(t *testing.T) {
+	db, err := LoadDatabase(writeTestDatabase(t))
+	require.NoError(t, err)
+
+	tests := []struct {
+		ecosystem string
+		name      string
+		version   string
+		expected  []string // Advisory IDs
+		fixed     []string
+	}{
+		{EcosystemNPM, "lodash", "4.17.15", []string{"GHSA-p6mc-m468-83gw"}, []string{"4.17.19"}},
+		{EcosystemNPM, "lodash", "4.17.19", nil, nil},
+		{EcosystemMaven, "org.apache.logging.log4j:log4j-core", "2.14.1", []string{"GHSA-jfh8-c2jp-5v3q"}, []string{"2.15.0"}},
+		{EcosystemMaven, "org.apache.logging.log4j:log4j-core", "2.12.1", []string{"GHSA-jfh8-c2jp-5v3q"}, []string{"2.12.2"}},
+		{EcosystemMaven, "org.apache.logging.log4j:log4j-core", "2.12.2", nil, nil},
+		{EcosystemMaven, "org.apache.logging.log4j:log4j-core", "2.0-alpha1", nil, nil},
+		{EcosystemGo, "golang.org/x/net", "v0.0.0-20220722155237-a158d28d115b", []string{"GO-2022-0969"}, nil},
+		{EcosystemGo, "golang.org/x/net", "v0.1.0", nil, nil},
+		{EcosystemPyPI, "django", "4.2.1", []string{"PYSEC-2023-100"}, []string{"4.2.3"}},
+		{EcosystemPyPI, "Django", "4.1.9", []string{"PYSEC-2023-100"}, []string{"4.1.10"}},
+		{EcosystemPyPI, "django", "3.2", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.version, func(t *testing.T) {
+			matches := db.Query(tt.ecosystem, tt.name, tt.version)
+			var ids []string
+			var fixed []string
+			for _, match := range matches {
+				ids = append(ids, match.Advisory.ID)
+				fixed = append(fixed, match.Fixed...)
+			}
+			assert.Equal(t, tt.expected, ids)
+			assert.Equal(t, tt.fixed, fixed)
+		})
+	}
+}
+
+func TestDependencyVersion(t *testing.T) {
+	tests := []struct {
+		dependency types.Dependency
+		name       string
+		version    string
+		exact      bool
+	}{
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "4.17.15"}, "lodash", "4.17.15", true},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "^4.17.15"}, "lodash", "4.17.15", false},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "~4.17.0 || ^5.0.0"}, "lodash", "4.17.0", false},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "4.17.0 - 4.17.21"}, "lodash", "4.17.0", false},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "4.x"}, "lodash", "", false},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "latest"}, "lodash", "", false},
+		{types.Dependency{Type: "npm", Name: "lodash", Example: "<5"}, "lodash", "", false},
+		{types.Dependency{Type: "python", Name: "django", Example: ">=4.2,<5"}, "django", "4.2", false},
+		{types.Dependency{Type: "python", Name: "django", Example: "==4.2.1"}, "django", "4.2.1", true},
+		{types.Dependency{Type: "ruby", Name: "rails", Example: "~> 7.1.0"}, "rails", "7.1.0", false},
+		{types.Dependency{Type: "ruby", Name: "rails", Example: "= 7.1.2"}, "rails", "7.1.2", true},
+		{types.Dependency{Type: "cargo", Name: "serde", Example: "1.0.190"}, "serde", "1.0.190", false},
+		{types.Dependency{Type: "cargo", Name: "serde", Example: "=1.0.190"}, "serde", "1.0.190", true},
+		{types.Dependency{Type: "maven", Name: "org.apache.logging.log4j:log4j-core", Example: "[2.14.0,2.16.0)"}, "org.apache.logging.log4j:log4j-core", "2.14.0", false},
+		{types.Dependency{Type: "maven", Name: "org.apache.logging.log4j:log4j-core", Example: "[2.14.1]"}, "org.apache.logging.log4j:log4j-core", "2.14.1", true},
+		{types.Dependency{Type: "maven", Name: "org.springframework:spring-core", Example: "${spring.version}"}, "org.springframework:spring-core", "", false},
+		{types.Dependency{Type: "golang", Name: "golang.org/x/net@v0.17.0"}, "golang.org/x/net", "v0.17.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dependency.Name+" "+tt.dependency.Example, func(t *testing.T) {
+			name, version, exact := dependencyVersion(tt.dependency)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.version, version)
+			assert.Equal(t, tt.exact, exact)
+		})
+	}
+}
+
+func TestMatcher_Annotate(t *testing.T) {
+	db, err := LoadDatabase(writeTestDatabase(t))
+	require.NoError(t, err)
+
+	root := types.NewPayloadWithPath("main", "/")
+	web := types.NewPayloadWithPath("web", "/web/package.json")
+	web.Dependencies = []types.Dependency{
+		{Type: "npm", Name: "lodash", Example: "^4.17.15"},
+		{Type: "npm", Name: "lodash", Example: "^4.17.15"},
+		{Type: "npm", Name: "react", Example: "^18.2.0"},
+	}
+	root.AddChild(web)
+
+	api := types.NewPayloadWithPath("api", "/api/pom.xml")
+	api.Dependencies = []types.Dependency{
+		{Type: "maven", Name: "org.apache.logging.log4j:log4j-core", Example: "2.14.1"},
+		{Type: "golang", Name: "golang.org/x/net@v0.0.0-20220722155237-a158d28d115b"},
+	}
+	root.AddChild(api)
+
+	count := NewMatcher(db).Annotate(root)
+
+	// The lodash match comes from the lower bound of ^4.17.15 and is not counted
+	assert.Equal(t, 2, count)
+	assert.NotContains(t, root.Properties, "vulnerabilities")
+	assert.Equal(t, []Vulnerability{{
+		ID:         "GHSA-p6mc-m468-83gw",
+		Aliases:    []string{"CVE-2020-8203"},
+		Summary:    "Prototype Pollution in lodash",
+		Severity:   SeverityHigh,
+		Score:      7.4,
+		Type:       "npm",
+		Package:    "lodash",
+		Version:    "4.17.15",
+		Confidence: ConfidenceConstraint,
+		Fixed:      []string{"4.17.19"},
+	}}, web.Properties["vulnerabilities"])
+
+	vulnerabilities := api.Properties["vulnerabilities"].([]Vulnerability)
+	require.Len(t, vulnerabilities, 2)
+	assert.Equal(t, "GHSA-jfh8-c2jp-5v3q", vulnerabilities[0].ID)
+	assert.Equal(t, ConfidenceExact, vulnerabilities[0].Confidence)
+	assert.Equal(t, SeverityCritical, vulnerabilities[0].Severity)
+	assert.Equal(t, []string{"2.15.0"}, vulnerabilities[0].Fixed)
+	assert.Equal(t, "GO-2022-0969", vulnerabilities[1].ID)
+	assert.Equal(t, SeverityUnknown, vulnerabilities[1].Severity)
+	assert.Equal(t, "golang.org/x/net", vulnerabilities[1].Package)
+}
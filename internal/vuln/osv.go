@@ -0,0 +1,349 @@
+// Package vuln matches dependencies against a local export of the OSV vulnerability database
+package vuln
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Advisory is an OSV vulnerability record (https://ossf.github.io/osv-schema/), only the fields used for
+// matching and reporting are decoded
+type Advisory struct {
+	ID               string                 `json:"id"`
+	Aliases          []string               `json:"aliases"`
+	Summary          string                 `json:"summary"`
+	Withdrawn        string                 `json:"withdrawn"`
+	Severity         []SeverityScore        `json:"severity"`
+	Affected         []Affected             `json:"affected"`
+	DatabaseSpecific map[string]interface{} `json:"database_specific"`
+}
+
+// SeverityScore is a severity vector of an advisory (CVSS_V3, CVSS_V4, ...)
+type SeverityScore struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected lists the affected versions of one package
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges"`
+	Versions []string `json:"versions"`
+}
+
+// Package identifies a package within an ecosystem
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// Range is a list of events delimiting affected versions (SEMVER, ECOSYSTEM or GIT)
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event opens or closes an affected range, exactly one field is set
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// Database indexes the affected packages of the loaded advisories by ecosystem and normalized name
+type Database struct {
+	packages   map[string]map[string][]affectedEntry
+	advisories int
+}
+
+type affectedEntry struct {
+	advisory *Advisory
+	affected *Affected
+}
+
+// pypiNameRegex matches the separators normalized by PEP 503
+var pypiNameRegex = regexp.MustCompile(`[-_.]+`)
+
+// LoadDatabase loads an OSV export: an all.zip of an ecosystem, a directory holding such archives
+// (e.g. npm/all.zip, PyPI/all.zip) or extracted advisory JSON files. Within a directory or archive,
+// unreadable and malformed advisories are logged and skipped
+func LoadDatabase(path string) (*Database, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open vulnerability database: %w", err)
+	}
+
+	db := &Database{packages: make(map[string]map[string][]affectedEntry)}
+	if !info.IsDir() {
+		if err := db.loadFile(path); err != nil {
+			return nil, err
+		}
+		return db, nil
+	}
+
+	err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			log.Printf("Warning: Skipping %s: %v", file, err)
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if err := db.loadFile(file); err != nil {
+			log.Printf("Warning: Skipping %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Advisories returns the number of loaded advisories
+func (db *Database) Advisories() int {
+	return db.advisories
+}
+
+func (db *Database) loadFile(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".zip":
+		return db.loadZip(path)
+	case ".json":
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read advisory %s: %w", path, err)
+		}
+		return db.add(path, content)
+	}
+	return nil
+}
+
+func (db *Database) loadZip(path string) error {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("failed to open vulnerability archive %s: %w", path, err)
+	}
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		if !strings.HasSuffix(strings.ToLower(file.Name), ".json") {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			log.Printf("Warning: Skipping advisory %s in %s: %v", file.Name, path, err)
+			continue
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			log.Printf("Warning: Skipping advisory %s in %s: %v", file.Name, path, err)
+			continue
+		}
+		if err := db.add(path+"#"+file.Name, content); err != nil {
+			log.Printf("Warning: Skipping %v", err)
+		}
+	}
+	return nil
+}
+
+func (db *Database) add(name string, content []byte) error {
+	var advisory Advisory
+	if err := json.Unmarshal(content, &advisory); err != nil {
+		return fmt.Errorf("failed to parse advisory %s: %w", name, err)
+	}
+	if advisory.Withdrawn != "" {
+		return nil
+	}
+
+	db.advisories++
+	for i := range advisory.Affected {
+		affected := &advisory.Affected[i]
+		ecosystem := affected.Package.Ecosystem
+		if db.packages[ecosystem] == nil {
+			db.packages[ecosystem] = make(map[string][]affectedEntry)
+		}
+		key := normalizePackageName(ecosystem, affected.Package.Name)
+		db.packages[ecosystem][key] = append(db.packages[ecosystem][key], affectedEntry{advisory: &advisory, affected: affected})
+	}
+	return nil
+}
+
+// Match is an advisory affecting a package version, with the versions fixing it
+type Match struct {
+	Advisory *Advisory
+	Fixed    []string
+}
+
+// Query returns the advisories affecting a version of a package, one per advisory
+func (db *Database) Query(ecosystem, name, version string) []Match {
+	var matches []Match
+	index := make(map[string]int)
+
+	for _, entry := range db.packages[ecosystem][normalizePackageName(ecosystem, name)] {
+		affected, fixed := affects(ecosystem, entry.affected, version)
+		if !affected {
+			continue
+		}
+		if i, seen := index[entry.advisory.ID]; seen {
+			matches[i].Fixed = mergeVersions(ecosystem, matches[i].Fixed, fixed)
+			continue
+		}
+		index[entry.advisory.ID] = len(matches)
+		matches = append(matches, Match{Advisory: entry.advisory, Fixed: mergeVersions(ecosystem, nil, fixed)})
+	}
+	return matches
+}
+
+// affects tells whether a version is listed by or within the ranges of an affected entry
+// The fixed versions of the matching ranges are returned, or all later fixed versions for listed versions
+func affects(ecosystem string, affected *Affected, version string) (bool, []string) {
+	var fixed []string
+	inRange := false
+	for _, r := range affected.Ranges {
+		if r.Type != "SEMVER" && r.Type != "ECOSYSTEM" {
+			continue
+		}
+		if within, rangeFixed := withinRange(ecosystem, r.Events, version); within {
+			inRange = true
+			fixed = append(fixed, rangeFixed...)
+		}
+	}
+	if inRange {
+		return true, fixed
+	}
+
+	for _, listed := range affected.Versions {
+		if listed == version || compareVersions(ecosystem, listed, version) == 0 {
+			for _, r := range affected.Ranges {
+				for _, event := range r.Events {
+					if event.Fixed != "" && compareVersions(ecosystem, event.Fixed, version) > 0 {
+						fixed = append(fixed, event.Fixed)
+					}
+				}
+			}
+			return true, fixed
+		}
+	}
+	return false, nil
+}
+
+// withinRange replays the events of a range in version order: introduced opens it, fixed closes it
+// at the fixed version and last_affected after the last affected version
+func withinRange(ecosystem string, events []Event, version string) (bool, []string) {
+	sorted := make([]Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareEvents(ecosystem, sorted[i], sorted[j]) < 0
+	})
+
+	affected := false
+	var fixed []string
+	for _, event := range sorted {
+		switch {
+		case event.Introduced != "":
+			if event.Introduced == "0" || compareVersions(ecosystem, event.Introduced, version) <= 0 {
+				affected = true
+				fixed = nil
+			}
+		case event.Fixed != "":
+			if compareVersions(ecosystem, event.Fixed, version) <= 0 {
+				affected = false
+			} else if affected && fixed == nil {
+				fixed = []string{event.Fixed}
+			}
+		case event.LastAffected != "":
+			if compareVersions(ecosystem, event.LastAffected, version) < 0 {
+				affected = false
+			}
+		}
+	}
+	return affected, fixed
+}
+
+func compareEvents(ecosystem string, a, b Event) int {
+	versionA, versionB := eventVersion(a), eventVersion(b)
+	switch {
+	case a.Introduced == "0" && b.Introduced == "0":
+		return 0
+	case a.Introduced == "0":
+		return -1
+	case b.Introduced == "0":
+		return 1
+	}
+	return compareVersions(ecosystem, versionA, versionB)
+}
+
+func eventVersion(event Event) string {
+	switch {
+	case event.Introduced != "":
+		return event.Introduced
+	case event.Fixed != "":
+		return event.Fixed
+	case event.LastAffected != "":
+		return event.LastAffected
+	}
+	return event.Limit
+}
+
+// mergeVersions adds versions to a sorted list without duplicates
+func mergeVersions(ecosystem string, versions []string, added []string) []string {
+	for _, version := range added {
+		duplicate := false
+		for _, existing := range versions {
+			if existing == version {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			versions = append(versions, version)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareVersions(ecosystem, versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// normalizePackageName applies the name equivalence of an ecosystem: PEP 503 for PyPI, case insensitive
+// names for NuGet, Packagist and crates.io
+func normalizePackageName(ecosystem, name string) string {
+	switch ecosystem {
+	case EcosystemPyPI:
+		return pypiNameRegex.ReplaceAllString(strings.ToLower(name), "-")
+	case EcosystemNuGet, EcosystemPackagist, EcosystemCratesIO:
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// severity returns the severity level and the CVSS v3 base score of an advisory
+// The CVSS v3 vector wins, the severity published by the database (GitHub advisories) is the fallback
+func (a *Advisory) severity() (string, float64) {
+	for _, severity := range a.Severity {
+		if severity.Type != "CVSS_V3" {
+			continue
+		}
+		if score, ok := cvss3BaseScore(severity.Score); ok {
+			return severityFromScore(score), score
+		}
+	}
+	if value, ok := a.DatabaseSpecific["severity"].(string); ok {
+		if severity := normalizeSeverity(value); severity != "" {
+			return severity, 0
+		}
+	}
+	return SeverityUnknown, 0
+}
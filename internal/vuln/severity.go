@@ -0,0 +1,118 @@
+package vuln
+
+import (
+	"math"
+	"strings"
+)
+
+// Severity levels, derived from the CVSS base score or the severity published by the database
+const (
+	SeverityCritical = "critical"
+	SeverityHigh     = "high"
+	SeverityMedium   = "medium"
+	SeverityLow      = "low"
+	SeverityNone     = "none"
+	SeverityUnknown  = "unknown"
+)
+
+// cvss3Weights holds the metric values of the CVSS v3 base score, Privileges Required depends on the scope
+var cvss3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+var cvss3PrivilegesRequired = map[bool]map[string]float64{
+	false: {"N": 0.85, "L": 0.62, "H": 0.27},
+	true:  {"N": 0.85, "L": 0.68, "H": 0.5},
+}
+
+// cvss3BaseScore computes the base score of a CVSS v3.0 or v3.1 vector
+// ("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H" is 9.8), false for other or incomplete vectors
+func cvss3BaseScore(vector string) (float64, bool) {
+	parts := strings.Split(vector, "/")
+	if len(parts) == 0 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return 0, false
+	}
+
+	metrics := make(map[string]string)
+	for _, part := range parts[1:] {
+		if name, value, found := strings.Cut(part, ":"); found {
+			metrics[name] = value
+		}
+	}
+
+	changed := metrics["S"] == "C"
+	if metrics["S"] != "C" && metrics["S"] != "U" {
+		return 0, false
+	}
+	values := make(map[string]float64)
+	for name, weights := range cvss3Weights {
+		value, known := weights[metrics[name]]
+		if !known {
+			return 0, false
+		}
+		values[name] = value
+	}
+	privileges, known := cvss3PrivilegesRequired[changed][metrics["PR"]]
+	if !known {
+		return 0, false
+	}
+
+	iss := 1 - (1-values["C"])*(1-values["I"])*(1-values["A"])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, true
+	}
+
+	exploitability := 8.22 * values["AV"] * values["AC"] * privileges * values["UI"]
+	if changed {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), true
+	}
+	return roundUp(math.Min(impact+exploitability, 10)), true
+}
+
+// roundUp rounds up to one decimal as defined by CVSS v3.1 (appendix A), avoiding floating point artifacts
+func roundUp(value float64) float64 {
+	scaled := int64(math.Round(value * 100000))
+	if scaled%10000 == 0 {
+		return float64(scaled) / 100000
+	}
+	return float64(scaled/10000+1) / 10
+}
+
+// severityFromScore returns the qualitative rating of a CVSS score
+func severityFromScore(score float64) string {
+	switch {
+	case score >= 9:
+		return SeverityCritical
+	case score >= 7:
+		return SeverityHigh
+	case score >= 4:
+		return SeverityMedium
+	case score > 0:
+		return SeverityLow
+	}
+	return SeverityNone
+}
+
+// normalizeSeverity maps database severities (GitHub's MODERATE, ...) to the severity levels
+func normalizeSeverity(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "critical":
+		return SeverityCritical
+	case "high", "important":
+		return SeverityHigh
+	case "moderate", "medium":
+		return SeverityMedium
+	case "low":
+		return SeverityLow
+	}
+	return ""
+}
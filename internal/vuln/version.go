@@ -0,0 +1,401 @@
+package vuln
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// OSV ecosystems supported by the version comparators
+const (
+	EcosystemNPM       = "npm"
+	EcosystemPyPI      = "PyPI"
+	EcosystemMaven     = "Maven"
+	EcosystemGo        = "Go"
+	EcosystemNuGet     = "NuGet"
+	EcosystemCratesIO  = "crates.io"
+	EcosystemRubyGems  = "RubyGems"
+	EcosystemPackagist = "Packagist"
+)
+
+// compareVersions compares two versions with the ordering of an ecosystem: npm, Go, crates.io, NuGet and
+// Packagist use semantic versioning, PyPI PEP 440, Maven the ComparableVersion rules and RubyGems Gem::Version
+func compareVersions(ecosystem, a, b string) int {
+	switch ecosystem {
+	case EcosystemPyPI:
+		return comparePEP440(a, b)
+	case EcosystemMaven:
+		return compareMaven(a, b)
+	case EcosystemRubyGems:
+		return compareRubyGems(a, b)
+	default:
+		return compareSemver(a, b)
+	}
+}
+
+// compareSemver compares semantic versions, with any number of release segments (NuGet uses four)
+// and an optional "v" prefix (Go). Pre-releases sort before their release, build metadata is ignored
+func compareSemver(a, b string) int {
+	releaseA, preA := splitSemver(a)
+	releaseB, preB := splitSemver(b)
+
+	if c := compareNumericSegments(releaseA, releaseB); c != 0 {
+		return c
+	}
+
+	switch {
+	case preA == "" && preB == "":
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	}
+
+	identsA := strings.Split(preA, ".")
+	identsB := strings.Split(preB, ".")
+	for i := 0; i < len(identsA) && i < len(identsB); i++ {
+		if c := comparePrereleaseIdentifier(identsA[i], identsB[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(identsA), len(identsB))
+}
+
+func splitSemver(version string) ([]string, string) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	version, _, _ = strings.Cut(version, "+")
+	release, pre, _ := strings.Cut(version, "-")
+	return strings.Split(release, "."), strings.ToLower(pre)
+}
+
+// comparePrereleaseIdentifier orders numeric identifiers numerically and below alphanumeric ones
+func comparePrereleaseIdentifier(a, b string) int {
+	numA, isNumA := parseNumber(a)
+	numB, isNumB := parseNumber(b)
+	switch {
+	case isNumA && isNumB:
+		return numA.Cmp(numB)
+	case isNumA:
+		return -1
+	case isNumB:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareNumericSegments compares dotted release segments, missing segments count as 0
+func compareNumericSegments(a, b []string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		segA, segB := "0", "0"
+		if i < len(a) {
+			segA = a[i]
+		}
+		if i < len(b) {
+			segB = b[i]
+		}
+		numA, okA := parseNumber(segA)
+		numB, okB := parseNumber(segB)
+		if okA && okB {
+			if c := numA.Cmp(numB); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// pep440Regex follows the canonical version pattern of PEP 440 (appendix B), case insensitive
+var pep440Regex = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+[a-z0-9]+(?:[-_.][a-z0-9]+)*)?$`)
+
+// pep440Version holds the comparable parts of a PEP 440 version
+type pep440Version struct {
+	epoch   string
+	release []string
+	pre     int // Phase of the pre-release: 0 alpha, 1 beta, 2 release candidate, 3 none
+	preNum  string
+	post    string
+	dev     string
+	hasPost bool
+	hasDev  bool
+}
+
+func parsePEP440(version string) (pep440Version, bool) {
+	match := pep440Regex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(version)))
+	if match == nil {
+		return pep440Version{}, false
+	}
+
+	parsed := pep440Version{epoch: match[1], release: strings.Split(match[2], "."), pre: 3}
+	switch match[3] {
+	case "a", "alpha":
+		parsed.pre = 0
+	case "b", "beta":
+		parsed.pre = 1
+	case "c", "rc", "pre", "preview":
+		parsed.pre = 2
+	}
+	parsed.preNum = match[4]
+
+	if match[5] != "" || match[6] != "" {
+		parsed.hasPost = true
+		parsed.post = match[5] + match[7]
+	}
+	if match[8] != "" {
+		parsed.hasDev = true
+		parsed.dev = match[9]
+	}
+	return parsed, true
+}
+
+// comparePEP440 compares Python versions: 1.0.dev1 < 1.0a1 < 1.0rc1 < 1.0 < 1.0.post1
+// Versions that are not PEP 440 compliant fall back to the semantic versioning order
+func comparePEP440(a, b string) int {
+	versionA, okA := parsePEP440(a)
+	versionB, okB := parsePEP440(b)
+	if !okA || !okB {
+		return compareSemver(a, b)
+	}
+
+	if c := compareNumericSegments([]string{orZero(versionA.epoch)}, []string{orZero(versionB.epoch)}); c != 0 {
+		return c
+	}
+	if c := compareNumericSegments(versionA.release, versionB.release); c != 0 {
+		return c
+	}
+
+	// A development release without pre or post release sorts before all pre-releases
+	phaseA, phaseB := versionA.pre, versionB.pre
+	if versionA.hasDev && phaseA == 3 && !versionA.hasPost {
+		phaseA = -1
+	}
+	if versionB.hasDev && phaseB == 3 && !versionB.hasPost {
+		phaseB = -1
+	}
+	if c := compareInts(phaseA, phaseB); c != 0 {
+		return c
+	}
+	if c := compareNumericSegments([]string{orZero(versionA.preNum)}, []string{orZero(versionB.preNum)}); c != 0 {
+		return c
+	}
+
+	// No post-release sorts before any post-release
+	if versionA.hasPost != versionB.hasPost {
+		if versionA.hasPost {
+			return 1
+		}
+		return -1
+	}
+	if c := compareNumericSegments([]string{orZero(versionA.post)}, []string{orZero(versionB.post)}); c != 0 {
+		return c
+	}
+
+	// No development release sorts after any development release
+	if versionA.hasDev != versionB.hasDev {
+		if versionA.hasDev {
+			return -1
+		}
+		return 1
+	}
+	return compareNumericSegments([]string{orZero(versionA.dev)}, []string{orZero(versionB.dev)})
+}
+
+// mavenQualifiers ranks the well-known qualifiers of Maven versions, the release is ""
+var mavenQualifiers = map[string]int{
+	"alpha":     1,
+	"a":         1,
+	"beta":      2,
+	"b":         2,
+	"milestone": 3,
+	"m":         3,
+	"rc":        4,
+	"cr":        4,
+	"snapshot":  5,
+	"":          6,
+	"ga":        6,
+	"final":     6,
+	"release":   6,
+	"sp":        7,
+}
+
+// versionToken is a numeric or alphabetic part of a Maven or RubyGems version
+type versionToken struct {
+	number  *big.Int
+	text    string
+	numeric bool
+}
+
+// tokenizeVersion splits a version on separators and on transitions between digits and letters
+func tokenizeVersion(version string, separators string) []versionToken {
+	var tokens []versionToken
+	var current strings.Builder
+	currentDigits := false
+
+	flush := func() {
+		if current.Len() == 0 {
+			return
+		}
+		text := current.String()
+		if number, ok := parseNumber(text); ok {
+			tokens = append(tokens, versionToken{number: number, numeric: true})
+		} else {
+			tokens = append(tokens, versionToken{text: text})
+		}
+		current.Reset()
+	}
+
+	for _, r := range strings.ToLower(strings.TrimSpace(version)) {
+		if strings.ContainsRune(separators, r) {
+			flush()
+			continue
+		}
+		isDigit := r >= '0' && r <= '9'
+		if current.Len() > 0 && isDigit != currentDigits {
+			flush()
+		}
+		currentDigits = isDigit
+		current.WriteRune(r)
+	}
+	flush()
+	return tokens
+}
+
+// compareMaven compares Maven versions: 1.0-alpha < 1.0-beta < 1.0-rc < 1.0-SNAPSHOT < 1.0 < 1.0-sp < 1.0.1
+// Missing tokens count as 0 or as the release, unknown qualifiers sort after the known ones
+func compareMaven(a, b string) int {
+	tokensA := trimMavenTokens(tokenizeVersion(a, ".-_"))
+	tokensB := trimMavenTokens(tokenizeVersion(b, ".-_"))
+
+	for i := 0; i < len(tokensA) || i < len(tokensB); i++ {
+		var tokenA, tokenB *versionToken
+		if i < len(tokensA) {
+			tokenA = &tokensA[i]
+		}
+		if i < len(tokensB) {
+			tokenB = &tokensB[i]
+		}
+		if c := compareMavenTokens(tokenA, tokenB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// trimMavenTokens drops trailing zeros and release qualifiers, so 1.0.0 and 1-final equal 1
+func trimMavenTokens(tokens []versionToken) []versionToken {
+	for len(tokens) > 0 {
+		last := tokens[len(tokens)-1]
+		if (last.numeric && last.number.Sign() == 0) || (!last.numeric && mavenQualifierRank(last.text) == mavenQualifiers[""]) {
+			tokens = tokens[:len(tokens)-1]
+			continue
+		}
+		break
+	}
+	return tokens
+}
+
+func compareMavenTokens(a, b *versionToken) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -compareMavenTokens(b, nil)
+	case b == nil:
+		if a.numeric {
+			return a.number.Sign()
+		}
+		return compareMavenQualifiers(a.text, "")
+	case a.numeric && b.numeric:
+		return a.number.Cmp(b.number)
+	case a.numeric:
+		return 1
+	case b.numeric:
+		return -1
+	}
+	return compareMavenQualifiers(a.text, b.text)
+}
+
+func compareMavenQualifiers(a, b string) int {
+	if c := compareInts(mavenQualifierRank(a), mavenQualifierRank(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+func mavenQualifierRank(qualifier string) int {
+	if rank, known := mavenQualifiers[qualifier]; known {
+		return rank
+	}
+	return len(mavenQualifiers)
+}
+
+// compareRubyGems compares gem versions: segments are compared numerically, letters mark pre-releases
+// which sort before numbers (1.0.a < 1.0 < 1.0.1)
+func compareRubyGems(a, b string) int {
+	tokensA := tokenizeVersion(a, ".-")
+	tokensB := tokenizeVersion(b, ".-")
+
+	for i := 0; i < len(tokensA) || i < len(tokensB); i++ {
+		tokenA, tokenB := versionToken{number: big.NewInt(0), numeric: true}, versionToken{number: big.NewInt(0), numeric: true}
+		if i < len(tokensA) {
+			tokenA = tokensA[i]
+		}
+		if i < len(tokensB) {
+			tokenB = tokensB[i]
+		}
+		switch {
+		case tokenA.numeric && tokenB.numeric:
+			if c := tokenA.number.Cmp(tokenB.number); c != 0 {
+				return c
+			}
+		case tokenA.numeric:
+			return 1
+		case tokenB.numeric:
+			return -1
+		default:
+			if c := strings.Compare(tokenA.text, tokenB.text); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+// parseNumber parses a non-negative integer of any size
+func parseNumber(text string) (*big.Int, bool) {
+	if text == "" {
+		return nil, false
+	}
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+	}
+	number, ok := new(big.Int).SetString(text, 10)
+	return number, ok
+}
+
+func orZero(text string) string {
+	if text == "" {
+		return "0"
+	}
+	return text
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		a         string
+		b         string
+		expected  int
+	}{
+		// Semantic versioning
+		{EcosystemNPM, "1.2.3", "1.2.10", -1},
+		{EcosystemNPM, "1.2.3", "1.2.3", 0},
+		{EcosystemNPM, "2.0.0-rc.1", "2.0.0", -1},
+		{EcosystemNPM, "2.0.0-alpha.2", "2.0.0-alpha.10", -1},
+		{EcosystemNPM, "2.0.0-alpha.1", "2.0.0-beta", -1},
+		{EcosystemNPM, "1.0.0+build.5", "1.0.0", 0},
+		{EcosystemGo, "v0.17.0", "0.17.0", 0},
+		{EcosystemGo, "v0.0.0-20230101000000-abcdef", "0.1.0", -1},
+		{EcosystemNuGet, "4.7.0.1", "4.7.0", 1},
+		{EcosystemCratesIO, "1.10.0", "1.9.9", 1},
+
+		// PEP 440
+		{EcosystemPyPI, "1.0.dev1", "1.0a1", -1},
+		{EcosystemPyPI, "1.0a1", "1.0b1", -1},
+		{EcosystemPyPI, "1.0rc1", "1.0", -1},
+		{EcosystemPyPI, "1.0", "1.0.post1", -1},
+		{EcosystemPyPI, "1.0.post1.dev1", "1.0.post1", -1},
+		{EcosystemPyPI, "2.0", "1!1.0", -1},
+		{EcosystemPyPI, "4.2", "4.2.0", 0},
+		{EcosystemPyPI, "1.0-1", "1.0.post1", 0},
+
+		// Maven
+		{EcosystemMaven, "1.0-alpha-1", "1.0-beta", -1},
+		{EcosystemMaven, "1.0-rc1", "1.0-SNAPSHOT", -1},
+		{EcosystemMaven, "1.0-SNAPSHOT", "1.0", -1},
+		{EcosystemMaven, "1.0", "1.0.0.Final", 0},
+		{EcosystemMaven, "5.3.20.RELEASE", "5.3.20", 0},
+		{EcosystemMaven, "1.0", "1.0-sp1", -1},
+		{EcosystemMaven, "1.0-sp1", "1.0.1", -1},
+		{EcosystemMaven, "2.17.1", "2.15.0", 1},
+
+		// RubyGems
+		{EcosystemRubyGems, "7.1.0.rc1", "7.1.0", -1},
+		{EcosystemRubyGems, "7.1.0", "7.1", 0},
+		{EcosystemRubyGems, "1.10", "1.9.5", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ecosystem+" "+tt.a+" "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareVersions(tt.ecosystem, tt.a, tt.b))
+			assert.Equal(t, -tt.expected, compareVersions(tt.ecosystem, tt.b, tt.a))
+		})
+	}
+}
+
+func TestCVSS3BaseScore(t *testing.T) {
+	tests := []struct {
+		vector   string
+		score    float64
+		severity string
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8, SeverityCritical},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", 10, SeverityCritical},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H", 7.5, SeverityHigh},
+		{"CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", 6.1, SeverityMedium},
+		{"CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N", 1.8, SeverityLow},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0, SeverityNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.vector, func(t *testing.T) {
+			score, ok := cvss3BaseScore(tt.vector)
+			assert.True(t, ok)
+			assert.Equal(t, tt.score, score)
+			assert.Equal(t, tt.severity, severityFromScore(score))
+		})
+	}
+
+	_, ok := cvss3BaseScore("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+	assert.False(t, ok)
+	_, ok = cvss3BaseScore("CVSS:3.1/AV:N/AC:L")
+	assert.False(t, ok)
+}